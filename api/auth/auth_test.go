@@ -0,0 +1,258 @@
+package auth
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// whoami answer with the id of the authenticated user, or 204 when the
+// request is not authenticated
+func whoami(repo *cache.RepoCache) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, err := UserFromCtx(r.Context(), repo)
+		if err == ErrNotAuthenticated {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(user.Id().String()))
+	})
+}
+
+func serve(t *testing.T, handler http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func requireUser(t *testing.T, w *httptest.ResponseRecorder, id entity.Id) {
+	t.Helper()
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, id.String(), w.Body.String())
+}
+
+func setupRepo(t *testing.T) (repository.TestedRepo, *cache.RepoCache, *cache.IdentityCache, *cache.IdentityCache) {
+	repo := repository.CreateGoGitTestRepo(false)
+
+	repoCache, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+
+	alice, err := repoCache.NewIdentityFull("Alice", "alice@example.com", "alice", "")
+	require.NoError(t, err)
+	bob, err := repoCache.NewIdentityFull("Bob", "bob@example.com", "bob", "")
+	require.NoError(t, err)
+
+	return repo, repoCache, alice, bob
+}
+
+func TestTokenAuthenticator(t *testing.T) {
+	repo, repoCache, alice, bob := setupRepo(t)
+	defer repository.CleanupTestRepos(repo)
+
+	keyring := repoCache.Keyring()
+
+	aliceToken, aliceValue, err := NewToken(keyring, alice.Id())
+	require.NoError(t, err)
+	defer RemoveToken(keyring, aliceToken.Id)
+
+	bobToken, bobValue, err := NewToken(keyring, bob.Id())
+	require.NoError(t, err)
+	defer RemoveToken(keyring, bobToken.Id)
+
+	tokens, err := ListTokens(keyring)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+
+	loaded, err := LoadTokenWithPrefix(keyring, aliceToken.Id.Human())
+	require.NoError(t, err)
+	require.Equal(t, alice.Id(), loaded.IdentityId)
+	require.Equal(t, aliceToken.CreateTime.Unix(), loaded.CreateTime.Unix())
+
+	handler := Middleware(NewTokenAuthenticator(repoCache))(whoami(repoCache))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+aliceValue)
+	requireUser(t, serve(t, handler, r), alice.Id())
+
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "bearer "+bobValue)
+	requireUser(t, serve(t, handler, r), bob.Id())
+
+	// no credentials
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	require.Equal(t, http.StatusNoContent, serve(t, handler, r).Code)
+
+	// the token id is not the secret
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+aliceToken.Id.String())
+	require.Equal(t, http.StatusUnauthorized, serve(t, handler, r).Code)
+
+	// revoked
+	require.NoError(t, RemoveToken(keyring, aliceToken.Id))
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer "+aliceValue)
+	require.Equal(t, http.StatusUnauthorized, serve(t, handler, r).Code)
+
+	_, err = LoadTokenWithPrefix(keyring, aliceToken.Id.Human())
+	require.Equal(t, ErrTokenNotExist, err)
+}
+
+func TestBasicAuthenticator(t *testing.T) {
+	repo, repoCache, alice, bob := setupRepo(t)
+	defer repository.CleanupTestRepos(repo)
+
+	keyring := repoCache.Keyring()
+
+	require.NoError(t, SetPassword(keyring, alice.Id(), "secret"))
+	defer RemovePassword(keyring, alice.Id())
+
+	require.True(t, HasPassword(keyring, alice.Id()))
+	require.False(t, HasPassword(keyring, bob.Id()))
+
+	handler := Middleware(NewBasicAuthenticator(repoCache))(whoami(repoCache))
+
+	// by login or by email
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.SetBasicAuth("alice", "secret")
+	requireUser(t, serve(t, handler, r), alice.Id())
+
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.SetBasicAuth("Alice@example.com", "secret")
+	requireUser(t, serve(t, handler, r), alice.Id())
+
+	// wrong password
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.SetBasicAuth("alice", "wrong")
+	w := serve(t, handler, r)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Equal(t, `Basic realm="git-bug"`, w.Header().Get("WWW-Authenticate"))
+
+	// no password set
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.SetBasicAuth("bob", "")
+	require.Equal(t, http.StatusUnauthorized, serve(t, handler, r).Code)
+
+	// unknown user
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.SetBasicAuth("mallory", "secret")
+	require.Equal(t, http.StatusUnauthorized, serve(t, handler, r).Code)
+}
+
+func TestOAuth(t *testing.T) {
+	repo, repoCache, alice, _ := setupRepo(t)
+	defer repository.CleanupTestRepos(repo)
+
+	router := mux.NewRouter()
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	standIn := NewStandInProvider("git-bug", "client-secret", []OAuthUser{
+		{Login: "alice", Name: "Alice"},
+		{Login: "mallory", Email: "mallory@example.com", Name: "Mallory"},
+	})
+	oauth := NewOAuth(repoCache, standIn.Provider(srv.URL+"/auth/idp", ""))
+
+	router.PathPrefix("/auth/idp/").Handler(standIn)
+	router.Path("/auth/login").Handler(oauth.LoginHandler())
+	router.Path("/auth/callback").Handler(oauth.CallbackHandler())
+	router.Path("/auth/logout").Handler(oauth.LogoutHandler())
+	router.Path("/").Handler(Middleware(oauth)(whoami(repoCache)))
+
+	// log in as login on the authorization page of the stand-in
+	login := func(login string) (*http.Client, *http.Response) {
+		jar, err := cookiejar.New(nil)
+		require.NoError(t, err)
+
+		client := &http.Client{
+			Jar: jar,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if req.URL.Path == "/auth/idp/authorize" {
+					q := req.URL.Query()
+					q.Set("login", login)
+					req.URL.RawQuery = q.Encode()
+				}
+				return nil
+			},
+		}
+
+		resp, err := client.Get(srv.URL + "/auth/login")
+		require.NoError(t, err)
+		return client, resp
+	}
+
+	client, resp := login("alice")
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, alice.Id(), ctxUser(t, client, srv.URL))
+
+	// without session
+	resp, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// logged out
+	resp, err = client.Get(srv.URL + "/auth/logout")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// expired session
+	client, resp = login("alice")
+	_ = resp.Body.Close()
+	require.Equal(t, alice.Id(), ctxUser(t, client, srv.URL))
+	oauth.mu.Lock()
+	for s, session := range oauth.sessions {
+		session.expiration = time.Now().Add(-time.Second)
+		oauth.sessions[s] = session
+	}
+	oauth.mu.Unlock()
+	resp, err = client.Get(srv.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.Empty(t, oauth.sessions)
+
+	// the session cookie is only sent over HTTPS when served over TLS
+	require.False(t, oauth.secure(httptest.NewRequest(http.MethodGet, "http://example.com/auth/callback", nil)))
+	require.True(t, oauth.secure(httptest.NewRequest(http.MethodGet, "https://example.com/auth/callback", nil)))
+
+	// no identity for this user
+	_, resp = login("mallory")
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// replayed callback
+	resp, err = http.Get(srv.URL + "/auth/callback?state=foo&code=bar")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func ctxUser(t *testing.T, client *http.Client, url string) entity.Id {
+	t.Helper()
+
+	resp, err := client.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return entity.Id(body)
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const keyringKeyPasswordPrefix = "api-password-"
+
+// SetPassword set the password of an identity for the HTTP basic auth. Only
+// a bcrypt hash of the password is stored in the keyring.
+func SetPassword(keyring repository.Keyring, identityId entity.Id, password string) error {
+	if password == "" {
+		return fmt.Errorf("empty password")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	return keyring.Set(repository.Item{
+		Key:  keyringKeyPasswordPrefix + identityId.String(),
+		Data: hash,
+	})
+}
+
+// RemovePassword remove the password of an identity, which can't use the
+// HTTP basic auth anymore
+func RemovePassword(keyring repository.Keyring, identityId entity.Id) error {
+	return keyring.Remove(keyringKeyPasswordPrefix + identityId.String())
+}
+
+// HasPassword tell if an identity has a password for the HTTP basic auth
+func HasPassword(keyring repository.Keyring, identityId entity.Id) bool {
+	_, err := keyring.Get(keyringKeyPasswordPrefix + identityId.String())
+	return err == nil
+}
+
+func checkPassword(keyring repository.Keyring, identityId entity.Id, password string) error {
+	item, err := keyring.Get(keyringKeyPasswordPrefix + identityId.String())
+	if err == repository.ErrKeyringKeyNotFound {
+		return ErrInvalidCredentials
+	}
+	if err != nil {
+		return err
+	}
+
+	if bcrypt.CompareHashAndPassword(item.Data, []byte(password)) != nil {
+		return ErrInvalidCredentials
+	}
+
+	return nil
+}
+
+var _ Authenticator = &basicAuthenticator{}
+
+type basicAuthenticator struct {
+	repo *cache.RepoCache
+}
+
+// NewBasicAuthenticator return an Authenticator identifying the requests with
+// the HTTP basic auth. The user name is the login or the email of an
+// identity having a password in the keyring.
+func NewBasicAuthenticator(repo *cache.RepoCache) Authenticator {
+	return &basicAuthenticator{repo: repo}
+}
+
+func (a *basicAuthenticator) Authenticate(r *http.Request) (*cache.IdentityCache, error) {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+
+	user, err := resolveUser(a.repo, username, username)
+	if err == identity.ErrIdentityNotExist {
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkPassword(a.repo.Keyring(), user.Id(), password)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (a *basicAuthenticator) challenge() string {
+	return `Basic realm="git-bug"`
+}
@@ -11,11 +11,30 @@ import (
 // identityCtxKey is a unique context key, accessible only in this package.
 var identityCtxKey = &struct{}{}
 
+// localCtxKey is a unique context key, accessible only in this package. It
+// has its own type, as the pointers to zero-sized values can be equal.
+type localCtxKeyType struct{}
+
+var localCtxKey = localCtxKeyType{}
+
 // CtxWithUser attaches an Identity to a context.
 func CtxWithUser(ctx context.Context, userId entity.Id) context.Context {
 	return context.WithValue(ctx, identityCtxKey, userId)
 }
 
+// CtxWithLocalUser attaches the user identity of the repository to a context,
+// for a local and single-user usage.
+func CtxWithLocalUser(ctx context.Context, userId entity.Id) context.Context {
+	return context.WithValue(CtxWithUser(ctx, userId), localCtxKey, true)
+}
+
+// IsLocalUser tell if the context is the one of the local user of the
+// repository, the only one allowed to change its user identity.
+func IsLocalUser(ctx context.Context) bool {
+	local, _ := ctx.Value(localCtxKey).(bool)
+	return local
+}
+
 // UserFromCtx retrieves an IdentityCache from the context.
 // If there is no identity in the context, ErrNotAuthenticated is returned.
 // If an error occurs while resolving the identity (e.g. I/O error), then it will be returned.
@@ -4,3 +4,15 @@ import "errors"
 
 // ErrNotAuthenticated is returned to the client if the user requests an action requiring authentication, and they are not authenticated.
 var ErrNotAuthenticated = errors.New("not authenticated or read-only")
+
+// ErrNotLocalUser is returned to the client if it requests a change of the user identity of the repository, which is shared by all the users, without being its local user.
+var ErrNotLocalUser = errors.New("only the local user can change the user identity of the repository")
+
+// ErrUserTaken is returned to the client if it requests the creation of an identity with the login or email of another one, which would then authenticate as either of them.
+var ErrUserTaken = errors.New("an identity with this login or email already exists")
+
+// ErrNoCredentials is returned by an Authenticator when a request doesn't carry the credentials it handles.
+var ErrNoCredentials = errors.New("no credentials")
+
+// ErrInvalidCredentials is returned by an Authenticator when the credentials of a request are wrong.
+var ErrInvalidCredentials = errors.New("invalid credentials")
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+// resolveUser find the identity of a user by its login, or by its email when
+// no login match
+func resolveUser(repo *cache.RepoCache, login string, email string) (*cache.IdentityCache, error) {
+	if login != "" {
+		i, err := repo.ResolveIdentityMatcher(func(excerpt *cache.IdentityExcerpt) bool {
+			return strings.EqualFold(excerpt.Login, login)
+		})
+		if err != identity.ErrIdentityNotExist {
+			return i, err
+		}
+	}
+
+	if email == "" {
+		return nil, identity.ErrIdentityNotExist
+	}
+
+	var matching []*cache.IdentityCache
+	for _, id := range repo.AllIdentityIds() {
+		i, err := repo.ResolveIdentity(id)
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(i.Email(), email) {
+			matching = append(matching, i)
+		}
+	}
+
+	switch len(matching) {
+	case 0:
+		return nil, identity.ErrIdentityNotExist
+	case 1:
+		return matching[0], nil
+	default:
+		ids := make([]entity.Id, len(matching))
+		for i, match := range matching {
+			ids[i] = match.Id()
+		}
+		return nil, identity.NewErrMultipleMatch(ids)
+	}
+}
+
+// CheckUserAvailable return ErrUserTaken if an identity already has this login
+// or email, as a new identity with them would make the authentication of its
+// user ambiguous
+func CheckUserAvailable(repo *cache.RepoCache, login string, email string) error {
+	for _, id := range repo.AllIdentityIds() {
+		i, err := repo.ResolveIdentity(id)
+		if err != nil {
+			return err
+		}
+		if login != "" && strings.EqualFold(i.Login(), login) {
+			return ErrUserTaken
+		}
+		if email != "" && strings.EqualFold(i.Email(), email) {
+			return ErrUserTaken
+		}
+	}
+	return nil
+}
@@ -3,14 +3,75 @@ package auth
 import (
 	"net/http"
 
-	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/cache"
 )
 
-func Middleware(fixedUserId entity.Id) func(http.Handler) http.Handler {
+// Authenticator identify the user making a request
+type Authenticator interface {
+	// Authenticate return the identity making the request. It returns
+	// ErrNoCredentials if the request doesn't carry the credentials handled
+	// by this authenticator, or ErrInvalidCredentials if they are wrong.
+	Authenticate(r *http.Request) (*cache.IdentityCache, error)
+}
+
+// challenger is implemented by the authenticators able to tell a client how
+// to authenticate, with a WWW-Authenticate header
+type challenger interface {
+	challenge() string
+}
+
+// Middleware authenticate the requests with the first authenticator
+// recognizing their credentials. The requests without credentials are served
+// unauthenticated, that is read-only. The ones with invalid credentials are
+// refused. The repository user authenticator accepts every request, so it is
+// not to be combined with the other authenticators.
+func Middleware(authenticators ...Authenticator) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ctx := CtxWithUser(r.Context(), fixedUserId)
-			next.ServeHTTP(w, r.WithContext(ctx))
+			for _, authenticator := range authenticators {
+				user, err := authenticator.Authenticate(r)
+				if err == ErrNoCredentials {
+					continue
+				}
+				if err != nil {
+					if c, ok := authenticator.(challenger); ok {
+						w.Header().Set("WWW-Authenticate", c.challenge())
+					}
+					http.Error(w, err.Error(), http.StatusUnauthorized)
+					return
+				}
+
+				ctx := CtxWithUser(r.Context(), user.Id())
+				if _, ok := authenticator.(*repoUserAuthenticator); ok {
+					ctx = CtxWithLocalUser(r.Context(), user.Id())
+				}
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			next.ServeHTTP(w, r)
 		})
 	}
 }
+
+var _ Authenticator = &repoUserAuthenticator{}
+
+type repoUserAuthenticator struct {
+	repo *cache.RepoCache
+}
+
+// NewRepoUserAuthenticator return an Authenticator identifying every request
+// as the current user identity of a repository, for a local and single-user
+// usage. As the user identity can be changed when an identity is adopted, it's
+// resolved for each request.
+func NewRepoUserAuthenticator(repo *cache.RepoCache) Authenticator {
+	return &repoUserAuthenticator{repo: repo}
+}
+
+func (a *repoUserAuthenticator) Authenticate(_ *http.Request) (*cache.IdentityCache, error) {
+	excerpt, err := a.repo.GetUserIdentityExcerpt()
+	if err != nil {
+		return nil, ErrNoCredentials
+	}
+	return a.repo.ResolveIdentity(excerpt.Id)
+}
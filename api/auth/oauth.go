@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+const (
+	sessionCookieName = "git-bug-session"
+
+	// time allowed to complete a login on the provider
+	oauthStateTTL = 10 * time.Minute
+
+	// lifetime of a session, the user has to log in again afterward
+	oauthSessionTTL = 7 * 24 * time.Hour
+)
+
+// OAuthProvider describe the identity provider of an OAuth login flow. When
+// the RedirectURL of the config is empty, the callback is derived from the
+// address the login is requested on, which is only safe when served on a
+// loopback host.
+type OAuthProvider struct {
+	oauth2.Config
+
+	// the URL returning the user authenticated with an access token, as a
+	// json object with a "login" and an "email"
+	UserInfoURL string
+}
+
+// OAuthUser is the user returned by the UserInfoURL of an OAuthProvider
+type OAuthUser struct {
+	Login string `json:"login"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+var _ Authenticator = &OAuth{}
+
+// OAuth implement the OAuth login flow against an identity provider. Once
+// logged in, the users are identified by a session cookie mapped to the
+// identity with the same login or email. The sessions are kept in memory and
+// expire after a while, an unknown or expired session is simply not
+// authenticated.
+type OAuth struct {
+	repo     *cache.RepoCache
+	provider OAuthProvider
+
+	mu sync.Mutex
+	// the pending logins
+	states map[string]oauthState
+	// the open sessions
+	sessions map[string]oauthSession
+}
+
+type oauthState struct {
+	expiration  time.Time
+	redirectURL string
+}
+
+type oauthSession struct {
+	expiration time.Time
+	identity   entity.Id
+}
+
+func NewOAuth(repo *cache.RepoCache, provider OAuthProvider) *OAuth {
+	return &OAuth{
+		repo:     repo,
+		provider: provider,
+		states:   make(map[string]oauthState),
+		sessions: make(map[string]oauthSession),
+	}
+}
+
+func (o *OAuth) Authenticate(r *http.Request) (*cache.IdentityCache, error) {
+	cookie, err := r.Cookie(sessionCookieName)
+	if err != nil {
+		return nil, ErrNoCredentials
+	}
+
+	o.mu.Lock()
+	session, ok := o.sessions[cookie.Value]
+	if ok && time.Now().After(session.expiration) {
+		delete(o.sessions, cookie.Value)
+		ok = false
+	}
+	o.mu.Unlock()
+
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+
+	return o.repo.ResolveIdentity(session.identity)
+}
+
+// LoginHandler start the login flow by redirecting to the identity provider
+func (o *OAuth) LoginHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state, err := randomString()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		redirectURL := o.provider.RedirectURL
+		if redirectURL == "" {
+			scheme := "http"
+			if r.TLS != nil {
+				scheme = "https"
+			}
+			redirectURL = fmt.Sprintf("%s://%s/auth/callback", scheme, r.Host)
+		}
+
+		o.mu.Lock()
+		now := time.Now()
+		for s, pending := range o.states {
+			if now.After(pending.expiration) {
+				delete(o.states, s)
+			}
+		}
+		o.states[state] = oauthState{
+			expiration:  now.Add(oauthStateTTL),
+			redirectURL: redirectURL,
+		}
+		o.mu.Unlock()
+
+		authURL := o.provider.AuthCodeURL(state, oauth2.SetAuthURLParam("redirect_uri", redirectURL))
+		http.Redirect(w, r, authURL, http.StatusFound)
+	})
+}
+
+// CallbackHandler complete the login flow when the identity provider
+// redirect back, and open a session
+func (o *OAuth) CallbackHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := r.URL.Query().Get("state")
+
+		o.mu.Lock()
+		pending, ok := o.states[state]
+		delete(o.states, state)
+		o.mu.Unlock()
+
+		if !ok || time.Now().After(pending.expiration) {
+			http.Error(w, "invalid or expired login", http.StatusBadRequest)
+			return
+		}
+
+		token, err := o.provider.Exchange(r.Context(), r.URL.Query().Get("code"),
+			oauth2.SetAuthURLParam("redirect_uri", pending.redirectURL))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		user, err := o.userInfo(r, token)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		i, err := resolveUser(o.repo, user.Login, user.Email)
+		if err == identity.ErrIdentityNotExist {
+			http.Error(w, fmt.Sprintf("no identity matching the user %s", user.Login), http.StatusForbidden)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		session, err := randomString()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		o.mu.Lock()
+		now := time.Now()
+		for s, open := range o.sessions {
+			if now.After(open.expiration) {
+				delete(o.sessions, s)
+			}
+		}
+		o.sessions[session] = oauthSession{
+			expiration: now.Add(oauthSessionTTL),
+			identity:   i.Id(),
+		}
+		o.mu.Unlock()
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     sessionCookieName,
+			Value:    session,
+			Path:     "/",
+			MaxAge:   int(oauthSessionTTL / time.Second),
+			HttpOnly: true,
+			Secure:   o.secure(r),
+			SameSite: http.SameSiteLaxMode,
+		})
+		http.Redirect(w, r, "/", http.StatusFound)
+	})
+}
+
+// LogoutHandler close the session of the user
+func (o *OAuth) LogoutHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie(sessionCookieName); err == nil {
+			o.mu.Lock()
+			delete(o.sessions, cookie.Value)
+			o.mu.Unlock()
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:   sessionCookieName,
+			Path:   "/",
+			MaxAge: -1,
+			Secure: o.secure(r),
+		})
+		http.Redirect(w, r, "/", http.StatusFound)
+	})
+}
+
+// secure tell if the session cookie must only be sent over HTTPS, when served
+// over TLS or behind a proxy terminating it, as told by the redirect URL
+func (o *OAuth) secure(r *http.Request) bool {
+	return r.TLS != nil || strings.HasPrefix(o.provider.RedirectURL, "https://")
+}
+
+func (o *OAuth) userInfo(r *http.Request, token *oauth2.Token) (*OAuthUser, error) {
+	client := o.provider.Client(r.Context(), token)
+
+	resp, err := client.Get(o.provider.UserInfoURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("user info: unexpected status %s", resp.Status)
+	}
+
+	var user OAuthUser
+	err = json.NewDecoder(resp.Body).Decode(&user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func randomString() (string, error) {
+	raw := make([]byte, 32)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(raw), nil
+}
@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+var standInLoginPage = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head><title>git-bug login</title></head>
+<body>
+<h1>Log in as</h1>
+<ul>
+{{range .Users}}<li><a href="{{$.Base}}?{{.Query}}">{{.Name}} ({{.Login}})</a></li>
+{{end}}</ul>
+</body>
+</html>
+`))
+
+// StandInProvider is a minimal OAuth identity provider, standing in for a
+// real one to use the OAuth login flow locally or in tests. Any of its users
+// can log in without password, by picking it on the authorization page.
+//
+// It serve under its base URL:
+// - /authorize: the authorization page
+// - /token: the exchange of an authorization code for an access token
+// - /userinfo: the user of an access token
+type StandInProvider struct {
+	clientId     string
+	clientSecret string
+	users        map[string]OAuthUser
+
+	mu sync.Mutex
+	// authorization codes and access tokens, mapped to the login of the user
+	codes  map[string]string
+	tokens map[string]string
+}
+
+func NewStandInProvider(clientId string, clientSecret string, users []OAuthUser) *StandInProvider {
+	p := &StandInProvider{
+		clientId:     clientId,
+		clientSecret: clientSecret,
+		users:        make(map[string]OAuthUser, len(users)),
+		codes:        make(map[string]string),
+		tokens:       make(map[string]string),
+	}
+	for _, user := range users {
+		p.users[user.Login] = user
+	}
+	return p
+}
+
+// Provider return the description of the provider served at baseURL, for a
+// client redirected to redirectURL
+func (p *StandInProvider) Provider(baseURL string, redirectURL string) OAuthProvider {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	return OAuthProvider{
+		Config: oauth2.Config{
+			ClientID:     p.clientId,
+			ClientSecret: p.clientSecret,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   baseURL + "/authorize",
+				TokenURL:  baseURL + "/token",
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+			RedirectURL: redirectURL,
+		},
+		UserInfoURL: baseURL + "/userinfo",
+	}
+}
+
+func (p *StandInProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/authorize"):
+		p.authorize(w, r)
+	case strings.HasSuffix(r.URL.Path, "/token"):
+		p.token(w, r)
+	case strings.HasSuffix(r.URL.Path, "/userinfo"):
+		p.userInfo(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (p *StandInProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("client_id") != p.clientId {
+		http.Error(w, "unknown client", http.StatusBadRequest)
+		return
+	}
+
+	redirect, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || query.Get("redirect_uri") == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	login := query.Get("login")
+
+	// without login, let the user pick one
+	if login == "" {
+		type entry struct {
+			OAuthUser
+			Query string
+		}
+		data := struct {
+			Base  string
+			Users []entry
+		}{Base: r.URL.Path}
+		for _, user := range p.users {
+			q := url.Values{}
+			for key, values := range query {
+				q[key] = values
+			}
+			q.Set("login", user.Login)
+			data.Users = append(data.Users, entry{OAuthUser: user, Query: q.Encode()})
+		}
+		sort.Slice(data.Users, func(i, j int) bool {
+			return data.Users[i].Login < data.Users[j].Login
+		})
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = standInLoginPage.Execute(w, data)
+		return
+	}
+
+	if _, ok := p.users[login]; !ok {
+		http.Error(w, "unknown user", http.StatusBadRequest)
+		return
+	}
+
+	code, err := randomString()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	p.mu.Lock()
+	p.codes[code] = login
+	p.mu.Unlock()
+
+	q := redirect.Query()
+	q.Set("code", code)
+	q.Set("state", query.Get("state"))
+	redirect.RawQuery = q.Encode()
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *StandInProvider) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if r.PostFormValue("client_id") != p.clientId || r.PostFormValue("client_secret") != p.clientSecret {
+		http.Error(w, "invalid client", http.StatusUnauthorized)
+		return
+	}
+
+	if r.PostFormValue("grant_type") != "authorization_code" {
+		http.Error(w, "unsupported grant type", http.StatusBadRequest)
+		return
+	}
+
+	code := r.PostFormValue("code")
+
+	p.mu.Lock()
+	login, ok := p.codes[code]
+	delete(p.codes, code)
+	p.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "invalid code", http.StatusBadRequest)
+		return
+	}
+
+	token, err := randomString()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	p.mu.Lock()
+	p.tokens[token] = login
+	p.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"access_token": token,
+		"token_type":   "bearer",
+	})
+}
+
+func (p *StandInProvider) userInfo(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	p.mu.Lock()
+	login, ok := p.tokens[token]
+	p.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(p.users[login])
+}
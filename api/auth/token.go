@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	keyringKeyTokenPrefix     = "api-token-"
+	keyringKeyTokenIdentity   = "identity"
+	keyringKeyTokenCreateTime = "createtime"
+)
+
+var ErrTokenNotExist = fmt.Errorf("API token doesn't exist")
+
+// Token is an API token authenticating an identity. Only a hash of the
+// secret value is stored in the keyring, which serves as the token id.
+type Token struct {
+	Id         entity.Id
+	IdentityId entity.Id
+	CreateTime time.Time
+}
+
+// tokenId return the id of a token, from its secret value
+func tokenId(value string) entity.Id {
+	h := sha256.Sum256([]byte(value))
+	return entity.Id(hex.EncodeToString(h[:]))
+}
+
+// NewToken generate a new API token for an identity and store it in the
+// keyring. The returned value is the only copy of the secret.
+func NewToken(keyring repository.Keyring, identityId entity.Id) (*Token, string, error) {
+	value, err := randomString()
+	if err != nil {
+		return nil, "", err
+	}
+
+	token := &Token{
+		Id:         tokenId(value),
+		IdentityId: identityId,
+		CreateTime: time.Now(),
+	}
+
+	data, err := json.Marshal(map[string]string{
+		keyringKeyTokenIdentity:   identityId.String(),
+		keyringKeyTokenCreateTime: strconv.FormatInt(token.CreateTime.Unix(), 10),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = keyring.Set(repository.Item{
+		Key:  keyringKeyTokenPrefix + token.Id.String(),
+		Data: data,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return token, value, nil
+}
+
+// LoadTokenWithPrefix load an API token from the keyring with an id prefix
+func LoadTokenWithPrefix(keyring repository.Keyring, prefix string) (*Token, error) {
+	tokens, err := ListTokens(keyring)
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []entity.Id
+	var result *Token
+	for _, token := range tokens {
+		if token.Id.HasPrefix(prefix) {
+			matching = append(matching, token.Id)
+			result = token
+		}
+	}
+
+	if len(matching) > 1 {
+		return nil, entity.NewErrMultipleMatch("API token", matching)
+	}
+
+	if len(matching) == 0 {
+		return nil, ErrTokenNotExist
+	}
+
+	return result, nil
+}
+
+// ListTokens load all the API tokens of the keyring
+func ListTokens(keyring repository.Keyring) ([]*Token, error) {
+	keys, err := keyring.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Token
+	for _, key := range keys {
+		if !strings.HasPrefix(key, keyringKeyTokenPrefix) {
+			continue
+		}
+
+		token, err := loadToken(keyring, entity.Id(strings.TrimPrefix(key, keyringKeyTokenPrefix)))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, token)
+	}
+
+	return result, nil
+}
+
+// RemoveToken revoke an API token
+func RemoveToken(keyring repository.Keyring, id entity.Id) error {
+	return keyring.Remove(keyringKeyTokenPrefix + id.String())
+}
+
+func loadToken(keyring repository.Keyring, id entity.Id) (*Token, error) {
+	item, err := keyring.Get(keyringKeyTokenPrefix + id.String())
+	if err == repository.ErrKeyringKeyNotFound {
+		return nil, ErrTokenNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]string)
+	err = json.Unmarshal(item.Data, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	createTime, err := strconv.ParseInt(data[keyringKeyTokenCreateTime], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API token create time: %v", err)
+	}
+
+	return &Token{
+		Id:         id,
+		IdentityId: entity.Id(data[keyringKeyTokenIdentity]),
+		CreateTime: time.Unix(createTime, 0),
+	}, nil
+}
+
+var _ Authenticator = &tokenAuthenticator{}
+
+type tokenAuthenticator struct {
+	repo *cache.RepoCache
+}
+
+// NewTokenAuthenticator return an Authenticator identifying the requests
+// with an API token from the keyring, given in an "Authorization: Bearer"
+// header
+func NewTokenAuthenticator(repo *cache.RepoCache) Authenticator {
+	return &tokenAuthenticator{repo: repo}
+}
+
+func (a *tokenAuthenticator) Authenticate(r *http.Request) (*cache.IdentityCache, error) {
+	header := r.Header.Get("Authorization")
+	if len(header) < len("Bearer ") || !strings.EqualFold(header[:len("Bearer ")], "Bearer ") {
+		return nil, ErrNoCredentials
+	}
+	value := strings.TrimSpace(header[len("Bearer "):])
+
+	token, err := loadToken(a.repo.Keyring(), tokenId(value))
+	if err == ErrTokenNotExist {
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return a.repo.ResolveIdentity(token.IdentityId)
+}
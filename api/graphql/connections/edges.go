@@ -23,3 +23,14 @@ type LazyIdentityEdge struct {
 func (lbe LazyIdentityEdge) GetCursor() string {
 	return lbe.Cursor
 }
+
+// LazyMilestoneEdge is a special relay edge used to implement a lazy loading connection
+type LazyMilestoneEdge struct {
+	Id     entity.Id
+	Cursor string
+}
+
+// GetCursor return the cursor of a LazyMilestoneEdge
+func (lbe LazyMilestoneEdge) GetCursor() string {
+	return lbe.Cursor
+}
@@ -1,12 +1,15 @@
 package graphql
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/MichaelMure/git-bug/api/auth"
 	"github.com/MichaelMure/git-bug/api/graphql/models"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/misc/random_bugs"
@@ -218,3 +221,231 @@ func TestQueries(t *testing.T) {
 	err = c.Post(query, &resp)
 	assert.NoError(t, err)
 }
+
+func TestSubscriptions(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	mrc := cache.NewMultiRepoCache()
+	repoCache, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+
+	rene, err := repoCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = repoCache.SetUserIdentity(rene)
+	require.NoError(t, err)
+
+	b, _, err := repoCache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	handler := NewHandler(mrc)
+
+	c := client.New(handler)
+
+	// the subscription is registered asynchronously, so the changes are
+	// repeated until the first notification
+	repeat := func(change func()) func() {
+		done := make(chan struct{})
+		go func() {
+			for {
+				change()
+				select {
+				case <-done:
+					return
+				case <-time.After(50 * time.Millisecond):
+				}
+			}
+		}()
+		return func() { close(done) }
+	}
+
+	sub := c.Websocket(`subscription { bugChanged(query: "status:closed") { id status } }`)
+
+	stop := repeat(func() {
+		_, _, err := repoCache.NewBug("ignored", "open bug not matching the query")
+		assert.NoError(t, err)
+		_, err = b.Close()
+		assert.NoError(t, err)
+		_, err = b.Open()
+		assert.NoError(t, err)
+	})
+
+	var changed struct {
+		BugChanged struct {
+			Id     string
+			Status string
+		}
+	}
+	err = sub.Next(&changed)
+	stop()
+	require.NoError(t, err)
+	require.Equal(t, b.Id().String(), changed.BugChanged.Id)
+	require.Equal(t, "CLOSED", changed.BugChanged.Status)
+	require.NoError(t, sub.Close())
+
+	sub = c.Websocket(`subscription { timelineItemAdded(bugPrefix: "` + b.Id().Human() + `") {
+		__typename
+		... on AddCommentTimelineItem { message }
+	} }`)
+
+	stop = repeat(func() {
+		_, err := b.AddComment("new comment")
+		assert.NoError(t, err)
+	})
+
+	var added struct {
+		TimelineItemAdded struct {
+			Typename string `json:"__typename"`
+			Message  string
+		}
+	}
+	err = sub.Next(&added)
+	stop()
+	require.NoError(t, err)
+	require.Equal(t, "AddCommentTimelineItem", added.TimelineItemAdded.Typename)
+	require.Equal(t, "new comment", added.TimelineItemAdded.Message)
+	require.NoError(t, sub.Close())
+
+	sub = c.Websocket(`subscription { timelineItemAdded(bugPrefix: "unknown") { id } }`)
+	require.Error(t, sub.Next(&added))
+	require.NoError(t, sub.Close())
+}
+
+func TestMutations(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	mrc := cache.NewMultiRepoCache()
+	repoCache, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+
+	rene, err := repoCache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = repoCache.SetUserIdentity(rene)
+	require.NoError(t, err)
+
+	b, createOp, err := repoCache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	handler := NewHandler(mrc)
+
+	// without user, the mutations are refused
+	readOnly := client.New(handler)
+	var resp interface{}
+	err = readOnly.Post(`mutation { createIdentity(input: {name: "Blaise Pascal", email: "blaise@pascal.fr"}) { identity { id } } }`, &resp)
+	require.Error(t, err)
+
+	c := client.New(auth.Middleware(auth.NewRepoUserAuthenticator(repoCache))(handler))
+
+	var edited struct {
+		EditComment struct {
+			Bug struct {
+				Comments struct {
+					Nodes []struct {
+						Message string
+					}
+				}
+			}
+		}
+	}
+	err = c.Post(`mutation { editComment(input: {prefix: "`+b.Id().Human()+`", target: "`+createOp.Id().Human()+`", message: "edited"}) {
+		bug { comments { nodes { message } } }
+	} }`, &edited)
+	require.NoError(t, err)
+	require.Len(t, edited.EditComment.Bug.Comments.Nodes, 1)
+	require.Equal(t, "edited", edited.EditComment.Bug.Comments.Nodes[0].Message)
+
+	var metadata struct {
+		SetMetadata struct {
+			Operation struct {
+				Target      string
+				NewMetadata []struct {
+					Key   string
+					Value string
+				}
+			}
+		}
+	}
+	err = c.Post(`mutation { setMetadata(input: {prefix: "`+b.Id().Human()+`", target: "`+createOp.Id().Human()+`", metadata: [{key: "b", value: "2"}, {key: "a", value: "1"}]}) {
+		operation { target newMetadata { key value } }
+	} }`, &metadata)
+	require.NoError(t, err)
+	require.Equal(t, createOp.Id().String(), metadata.SetMetadata.Operation.Target)
+	require.Len(t, metadata.SetMetadata.Operation.NewMetadata, 2)
+	require.Equal(t, "a", metadata.SetMetadata.Operation.NewMetadata[0].Key)
+	require.Equal(t, "1", metadata.SetMetadata.Operation.NewMetadata[0].Value)
+
+	value, ok := b.Snapshot().Operations[0].GetMetadata("b")
+	require.True(t, ok)
+	require.Equal(t, "2", value)
+
+	var created struct {
+		CreateIdentity struct {
+			Identity struct {
+				Id    string
+				Name  string
+				Login string
+			}
+		}
+	}
+	err = c.Post(`mutation { createIdentity(input: {name: "Blaise Pascal", email: "blaise@pascal.fr", login: "blaise"}) {
+		identity { id name login }
+	} }`, &created)
+	require.NoError(t, err)
+	require.Equal(t, "Blaise Pascal", created.CreateIdentity.Identity.Name)
+	require.Equal(t, "blaise", created.CreateIdentity.Identity.Login)
+
+	// a user identity was already set
+	user, err := repoCache.GetUserIdentity()
+	require.NoError(t, err)
+	require.Equal(t, rene.Id(), user.Id())
+
+	var adopted struct {
+		AdoptIdentity struct {
+			Identity struct {
+				Id string
+			}
+		}
+	}
+	err = c.Post(`mutation { adoptIdentity(input: {prefix: "`+created.CreateIdentity.Identity.Id+`"}) { identity { id } } }`, &adopted)
+	require.NoError(t, err)
+	require.Equal(t, created.CreateIdentity.Identity.Id, adopted.AdoptIdentity.Identity.Id)
+
+	// the next mutations are authored by the adopted identity
+	var comment struct {
+		AddComment struct {
+			Operation struct {
+				Author struct {
+					Name string
+				}
+			}
+		}
+	}
+	err = c.Post(`mutation { addComment(input: {prefix: "`+b.Id().Human()+`", message: "hello"}) { operation { author { name } } } }`, &comment)
+	require.NoError(t, err)
+	require.Equal(t, "Blaise Pascal", comment.AddComment.Operation.Author.Name)
+
+	// the other users can't change the user identity shared by everyone
+	other := client.New(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r.WithContext(auth.CtxWithUser(r.Context(), rene.Id())))
+	}))
+	err = other.Post(`mutation { adoptIdentity(input: {prefix: "`+rene.Id().Human()+`"}) { identity { id } } }`, &adopted)
+	require.Error(t, err)
+
+	// without user identity, the created one is not adopted either
+	require.NoError(t, repo.LocalConfig().RemoveAll("git-bug.identity"))
+	err = other.Post(`mutation { createIdentity(input: {name: "Pierre de Fermat", email: "pierre@fermat.fr"}) {
+		identity { id name login }
+	} }`, &created)
+	require.NoError(t, err)
+
+	// nor can they create an identity authenticated as another user
+	err = other.Post(`mutation { createIdentity(input: {name: "Blaise", email: "pascal@example.com", login: "BLAISE"}) { identity { id } } }`, &created)
+	require.Error(t, err)
+	err = other.Post(`mutation { createIdentity(input: {name: "René", email: "rene@descartes.fr"}) { identity { id } } }`, &created)
+	require.Error(t, err)
+
+	set, err := repoCache.IsUserIdentitySet()
+	require.NoError(t, err)
+	require.False(t, set)
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/repository"
 )
 
@@ -38,6 +39,22 @@ type AddCommentPayload struct {
 	Operation *bug.AddCommentOperation `json:"operation"`
 }
 
+type AdoptIdentityInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The identity ID's prefix.
+	Prefix string `json:"prefix"`
+}
+
+type AdoptIdentityPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The adopted identity.
+	Identity IdentityWrapper `json:"identity"`
+}
+
 // The connection type for Bug.
 type BugConnection struct {
 	// A list of edges.
@@ -57,6 +74,28 @@ type BugEdge struct {
 	Node BugWrapper `json:"node"`
 }
 
+type ChangeAssigneesInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The list of identity ID's prefix to assign.
+	Added []string `json:"added"`
+	// The list of identity ID's prefix to unassign.
+	Removed []string `json:"removed"`
+}
+
+type ChangeAssigneesPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation.
+	Operation *bug.SetAssigneesOperation `json:"operation"`
+}
+
 type ChangeLabelInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -88,6 +127,8 @@ type CloseBugInput struct {
 	RepoRef *string `json:"repoRef"`
 	// The bug ID's prefix.
 	Prefix string `json:"prefix"`
+	// The reason the bug is closed for.
+	Resolution *Resolution `json:"resolution"`
 }
 
 type CloseBugPayload struct {
@@ -111,6 +152,50 @@ type CommentEdge struct {
 	Node   *bug.Comment `json:"node"`
 }
 
+type CreateIdentityInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The name of the person.
+	Name string `json:"name"`
+	// The email of the person.
+	Email string `json:"email"`
+	// The login of the person on a remote platform.
+	Login *string `json:"login"`
+	// An URL pointing to the avatar of the person.
+	AvatarURL *string `json:"avatarUrl"`
+}
+
+type CreateIdentityPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The created identity.
+	Identity IdentityWrapper `json:"identity"`
+}
+
+type EditCommentInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The ID's prefix of the comment to edit, including the first message of the bug.
+	Target string `json:"target"`
+	// The new message.
+	Message string `json:"message"`
+}
+
+type EditCommentPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation
+	Operation *bug.EditCommentOperation `json:"operation"`
+}
+
 type IdentityConnection struct {
 	Edges      []*IdentityEdge   `json:"edges"`
 	Nodes      []IdentityWrapper `json:"nodes"`
@@ -135,6 +220,29 @@ type LabelEdge struct {
 	Node   bug.Label `json:"node"`
 }
 
+// A key/value metadata attached to an operation
+type Metadata struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type MetadataInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type MilestoneConnection struct {
+	Edges      []*MilestoneEdge        `json:"edges"`
+	Nodes      []*cache.MilestoneCache `json:"nodes"`
+	PageInfo   *PageInfo               `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
+}
+
+type MilestoneEdge struct {
+	Cursor string                `json:"cursor"`
+	Node   *cache.MilestoneCache `json:"node"`
+}
+
 type NewBugInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -146,6 +254,9 @@ type NewBugInput struct {
 	Message string `json:"message"`
 	// The collection of file's hash required for the first message.
 	Files []repository.Hash `json:"files"`
+	// The name of a template to apply. Its title and message are used if the
+	//     given ones are empty, and its labels are set on the new bug.
+	Template *string `json:"template"`
 }
 
 type NewBugPayload struct {
@@ -201,6 +312,90 @@ type PageInfo struct {
 	EndCursor string `json:"endCursor"`
 }
 
+type RedactCommentInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The ID's prefix of the comment to redact.
+	Target string `json:"target"`
+}
+
+type RedactCommentPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation
+	Operation *bug.RedactCommentOperation `json:"operation"`
+}
+
+type SetMetadataInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The ID's prefix of the operation receiving the metadata.
+	Target string `json:"target"`
+	// The metadata to add. The existing values can't be overwritten.
+	Metadata []*MetadataInput `json:"metadata"`
+}
+
+type SetMetadataPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation
+	Operation *bug.SetMetadataOperation `json:"operation"`
+}
+
+type SetMilestoneInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The milestone ID's prefix. If not set, the bug is detached from its milestone.
+	Milestone *string `json:"milestone"`
+}
+
+type SetMilestonePayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation.
+	Operation *bug.SetMilestoneOperation `json:"operation"`
+}
+
+type SetStatusInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The bug ID's prefix.
+	Prefix string `json:"prefix"`
+	// The name of the workflow status.
+	Status string `json:"status"`
+	// The reason the bug is closed for, for a status in the closed category.
+	Resolution *Resolution `json:"resolution"`
+}
+
+type SetStatusPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The affected bug.
+	Bug BugWrapper `json:"bug"`
+	// The resulting operation.
+	Operation *bug.SetStatusOperation `json:"operation"`
+}
+
 type SetTitleInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -282,6 +477,104 @@ func (e LabelChangeStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// The kind of relationship between two bugs.
+type LinkType string
+
+const (
+	LinkTypeDuplicateOf  LinkType = "DUPLICATE_OF"
+	LinkTypeDuplicatedBy LinkType = "DUPLICATED_BY"
+	LinkTypeBlocks       LinkType = "BLOCKS"
+	LinkTypeBlockedBy    LinkType = "BLOCKED_BY"
+	LinkTypeParentOf     LinkType = "PARENT_OF"
+	LinkTypeChildOf      LinkType = "CHILD_OF"
+	LinkTypeRelatesTo    LinkType = "RELATES_TO"
+)
+
+var AllLinkType = []LinkType{
+	LinkTypeDuplicateOf,
+	LinkTypeDuplicatedBy,
+	LinkTypeBlocks,
+	LinkTypeBlockedBy,
+	LinkTypeParentOf,
+	LinkTypeChildOf,
+	LinkTypeRelatesTo,
+}
+
+func (e LinkType) IsValid() bool {
+	switch e {
+	case LinkTypeDuplicateOf, LinkTypeDuplicatedBy, LinkTypeBlocks, LinkTypeBlockedBy, LinkTypeParentOf, LinkTypeChildOf, LinkTypeRelatesTo:
+		return true
+	}
+	return false
+}
+
+func (e LinkType) String() string {
+	return string(e)
+}
+
+func (e *LinkType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LinkType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LinkType", str)
+	}
+	return nil
+}
+
+func (e LinkType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The reason a bug was closed for.
+type Resolution string
+
+const (
+	ResolutionFixed     Resolution = "FIXED"
+	ResolutionWontfix   Resolution = "WONTFIX"
+	ResolutionDuplicate Resolution = "DUPLICATE"
+	ResolutionInvalid   Resolution = "INVALID"
+)
+
+var AllResolution = []Resolution{
+	ResolutionFixed,
+	ResolutionWontfix,
+	ResolutionDuplicate,
+	ResolutionInvalid,
+}
+
+func (e Resolution) IsValid() bool {
+	switch e {
+	case ResolutionFixed, ResolutionWontfix, ResolutionDuplicate, ResolutionInvalid:
+		return true
+	}
+	return false
+}
+
+func (e Resolution) String() string {
+	return string(e)
+}
+
+func (e *Resolution) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Resolution(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Resolution", str)
+	}
+	return nil
+}
+
+func (e Resolution) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Status string
 
 const (
@@ -7,6 +7,7 @@ import (
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/milestone"
 )
 
 // BugWrapper is an interface used by the GraphQL resolvers to handle a bug.
@@ -16,12 +17,17 @@ type BugWrapper interface {
 	Id() entity.Id
 	LastEdit() time.Time
 	Status() bug.Status
+	StatusName() string
+	Resolution() bug.Resolution
 	Title() string
 	Comments() ([]bug.Comment, error)
 	Labels() []bug.Label
 	Author() (IdentityWrapper, error)
 	Actors() ([]IdentityWrapper, error)
 	Participants() ([]IdentityWrapper, error)
+	Assignees() ([]IdentityWrapper, error)
+	Milestone() (*cache.MilestoneCache, error)
+	Links() ([]*BugLink, error)
 	CreatedAt() time.Time
 	Timeline() ([]bug.TimelineItem, error)
 	Operations() ([]bug.Operation, error)
@@ -88,6 +94,14 @@ func (lb *lazyBug) Status() bug.Status {
 	return lb.excerpt.Status
 }
 
+func (lb *lazyBug) StatusName() string {
+	return lb.excerpt.StatusName()
+}
+
+func (lb *lazyBug) Resolution() bug.Resolution {
+	return lb.excerpt.Resolution
+}
+
 func (lb *lazyBug) Title() string {
 	return lb.excerpt.Title
 }
@@ -132,6 +146,18 @@ func (lb *lazyBug) Participants() ([]IdentityWrapper, error) {
 	return result, nil
 }
 
+func (lb *lazyBug) Assignees() ([]IdentityWrapper, error) {
+	result := make([]IdentityWrapper, len(lb.excerpt.Assignees))
+	for i, assigneeId := range lb.excerpt.Assignees {
+		assignee, err := lb.identity(assigneeId)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = assignee
+	}
+	return result, nil
+}
+
 func (lb *lazyBug) CreatedAt() time.Time {
 	return lb.excerpt.CreateTime()
 }
@@ -144,6 +170,14 @@ func (lb *lazyBug) Timeline() ([]bug.TimelineItem, error) {
 	return lb.snap.Timeline, nil
 }
 
+func (lb *lazyBug) Milestone() (*cache.MilestoneCache, error) {
+	return resolveMilestone(lb.cache, lb.excerpt.Milestone)
+}
+
+func (lb *lazyBug) Links() ([]*BugLink, error) {
+	return allLinks(lb.cache, lb.excerpt.Id, lb.excerpt.Links), nil
+}
+
 func (lb *lazyBug) Operations() ([]bug.Operation, error) {
 	err := lb.load()
 	if err != nil {
@@ -155,11 +189,12 @@ func (lb *lazyBug) Operations() ([]bug.Operation, error) {
 var _ BugWrapper = &loadedBug{}
 
 type loadedBug struct {
+	cache *cache.RepoCache
 	*bug.Snapshot
 }
 
-func NewLoadedBug(snap *bug.Snapshot) *loadedBug {
-	return &loadedBug{Snapshot: snap}
+func NewLoadedBug(cache *cache.RepoCache, snap *bug.Snapshot) *loadedBug {
+	return &loadedBug{cache: cache, Snapshot: snap}
 }
 
 func (l *loadedBug) LastEdit() time.Time {
@@ -170,6 +205,14 @@ func (l *loadedBug) Status() bug.Status {
 	return l.Snapshot.Status
 }
 
+func (l *loadedBug) StatusName() string {
+	return l.Snapshot.StatusName()
+}
+
+func (l *loadedBug) Resolution() bug.Resolution {
+	return l.Snapshot.Resolution
+}
+
 func (l *loadedBug) Title() string {
 	return l.Snapshot.Title
 }
@@ -202,6 +245,14 @@ func (l *loadedBug) Participants() ([]IdentityWrapper, error) {
 	return res, nil
 }
 
+func (l *loadedBug) Assignees() ([]IdentityWrapper, error) {
+	res := make([]IdentityWrapper, len(l.Snapshot.Assignees))
+	for i, assignee := range l.Snapshot.Assignees {
+		res[i] = NewLoadedIdentity(assignee)
+	}
+	return res, nil
+}
+
 func (l *loadedBug) CreatedAt() time.Time {
 	return l.Snapshot.CreateTime
 }
@@ -213,3 +264,24 @@ func (l *loadedBug) Timeline() ([]bug.TimelineItem, error) {
 func (l *loadedBug) Operations() ([]bug.Operation, error) {
 	return l.Snapshot.Operations, nil
 }
+
+func (l *loadedBug) Milestone() (*cache.MilestoneCache, error) {
+	return resolveMilestone(l.cache, l.Snapshot.Milestone)
+}
+
+func (l *loadedBug) Links() ([]*BugLink, error) {
+	return allLinks(l.cache, l.Snapshot.Id(), l.Snapshot.Links), nil
+}
+
+// resolveMilestone load the milestone of a bug, if any. A milestone not
+// available locally (not pulled yet) is treated as no milestone.
+func resolveMilestone(repo *cache.RepoCache, id entity.Id) (*cache.MilestoneCache, error) {
+	if id == "" {
+		return nil, nil
+	}
+	m, err := repo.ResolveMilestone(id)
+	if err == milestone.ErrMilestoneNotExist {
+		return nil, nil
+	}
+	return m, err
+}
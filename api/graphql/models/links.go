@@ -0,0 +1,41 @@
+package models
+
+import (
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// BugLink is a typed link from a bug to another one. The cache is used to
+// resolve the target bug, and can be nil when it's not available.
+type BugLink struct {
+	bug.Link
+	Cache *cache.RepoCache
+}
+
+// NewBugLinks wrap links to be used by the GraphQL resolvers
+func NewBugLinks(cache *cache.RepoCache, links []bug.Link) []*BugLink {
+	result := make([]*BugLink, len(links))
+	for i, link := range links {
+		result[i] = &BugLink{Link: link, Cache: cache}
+	}
+	return result
+}
+
+// allLinks return the links of a bug, including the ones recorded on the other bugs
+func allLinks(repo *cache.RepoCache, id entity.Id, links []bug.Link) []*BugLink {
+	result := make([]bug.Link, len(links))
+	copy(result, links)
+
+AddLoop:
+	for _, reverse := range repo.LinksTo(id) {
+		for _, link := range links {
+			if link == reverse {
+				continue AddLoop
+			}
+		}
+		result = append(result, reverse)
+	}
+
+	return NewBugLinks(repo, result)
+}
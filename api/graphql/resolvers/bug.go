@@ -7,6 +7,7 @@ import (
 	"github.com/MichaelMure/git-bug/api/graphql/graph"
 	"github.com/MichaelMure/git-bug/api/graphql/models"
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
 )
 
 var _ graph.BugResolver = &bugResolver{}
@@ -25,6 +26,10 @@ func (bugResolver) Status(_ context.Context, obj models.BugWrapper) (models.Stat
 	return convertStatus(obj.Status())
 }
 
+func (bugResolver) Resolution(_ context.Context, obj models.BugWrapper) (*models.Resolution, error) {
+	return convertResolution(obj.Resolution())
+}
+
 func (bugResolver) Comments(_ context.Context, obj models.BugWrapper, after *string, before *string, first *int, last *int) (*models.CommentConnection, error) {
 	input := models.ConnectionInput{
 		Before: before,
@@ -188,3 +193,43 @@ func (bugResolver) Participants(_ context.Context, obj models.BugWrapper, after
 
 	return connections.IdentityCon(participants, edger, conMaker, input)
 }
+
+func (bugResolver) Assignees(_ context.Context, obj models.BugWrapper, after *string, before *string, first *int, last *int) (*models.IdentityConnection, error) {
+	input := models.ConnectionInput{
+		Before: before,
+		After:  after,
+		First:  first,
+		Last:   last,
+	}
+
+	edger := func(assignee models.IdentityWrapper, offset int) connections.Edge {
+		return models.IdentityEdge{
+			Node:   assignee,
+			Cursor: connections.OffsetToCursor(offset),
+		}
+	}
+
+	conMaker := func(edges []*models.IdentityEdge, nodes []models.IdentityWrapper, info *models.PageInfo, totalCount int) (*models.IdentityConnection, error) {
+		return &models.IdentityConnection{
+			Edges:      edges,
+			Nodes:      nodes,
+			PageInfo:   info,
+			TotalCount: totalCount,
+		}, nil
+	}
+
+	assignees, err := obj.Assignees()
+	if err != nil {
+		return nil, err
+	}
+
+	return connections.IdentityCon(assignees, edger, conMaker, input)
+}
+
+func (bugResolver) Milestone(_ context.Context, obj models.BugWrapper) (*cache.MilestoneCache, error) {
+	return obj.Milestone()
+}
+
+func (bugResolver) Links(_ context.Context, obj models.BugWrapper) ([]*models.BugLink, error) {
+	return obj.Links()
+}
@@ -0,0 +1,60 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/api/graphql/graph"
+	"github.com/MichaelMure/git-bug/api/graphql/models"
+	"github.com/MichaelMure/git-bug/bug"
+)
+
+var _ graph.BugLinkResolver = &bugLinkResolver{}
+
+type bugLinkResolver struct{}
+
+func (bugLinkResolver) Type(_ context.Context, obj *models.BugLink) (models.LinkType, error) {
+	return convertLinkType(obj.Type)
+}
+
+func (bugLinkResolver) TargetID(_ context.Context, obj *models.BugLink) (string, error) {
+	return obj.Target.String(), nil
+}
+
+func (bugLinkResolver) Target(_ context.Context, obj *models.BugLink) (models.BugWrapper, error) {
+	if obj.Cache == nil {
+		return nil, nil
+	}
+
+	excerpt, err := obj.Cache.ResolveBugExcerpt(obj.Target)
+	if err == bug.ErrBugNotExist {
+		// the target might not have been pulled yet
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewLazyBug(obj.Cache, excerpt), nil
+}
+
+func convertLinkType(linkType bug.LinkType) (models.LinkType, error) {
+	switch linkType {
+	case bug.LinkDuplicateOf:
+		return models.LinkTypeDuplicateOf, nil
+	case bug.LinkDuplicatedBy:
+		return models.LinkTypeDuplicatedBy, nil
+	case bug.LinkBlocks:
+		return models.LinkTypeBlocks, nil
+	case bug.LinkBlockedBy:
+		return models.LinkTypeBlockedBy, nil
+	case bug.LinkParentOf:
+		return models.LinkTypeParentOf, nil
+	case bug.LinkChildOf:
+		return models.LinkTypeChildOf, nil
+	case bug.LinkRelatesTo:
+		return models.LinkTypeRelatesTo, nil
+	}
+
+	return "", fmt.Errorf("unknown link type")
+}
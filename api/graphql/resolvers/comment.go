@@ -15,3 +15,15 @@ type commentResolver struct{}
 func (c commentResolver) Author(_ context.Context, obj *bug.Comment) (models.IdentityWrapper, error) {
 	return models.NewLoadedIdentity(obj.Author), nil
 }
+
+var _ graph.ReactionGroupResolver = &reactionGroupResolver{}
+
+type reactionGroupResolver struct{}
+
+func (reactionGroupResolver) Reaction(_ context.Context, obj *bug.ReactionGroup) (string, error) {
+	return obj.Reaction.String(), nil
+}
+
+func (reactionGroupResolver) Users(_ context.Context, obj *bug.ReactionGroup) ([]models.IdentityWrapper, error) {
+	return convertIdentities(obj.Users), nil
+}
@@ -0,0 +1,48 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/MichaelMure/git-bug/api/graphql/graph"
+	"github.com/MichaelMure/git-bug/api/graphql/models"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+var _ graph.MilestoneResolver = &milestoneResolver{}
+
+type milestoneResolver struct{}
+
+func (milestoneResolver) ID(_ context.Context, obj *cache.MilestoneCache) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (milestoneResolver) HumanID(_ context.Context, obj *cache.MilestoneCache) (string, error) {
+	return obj.Id().Human(), nil
+}
+
+func (milestoneResolver) Status(_ context.Context, obj *cache.MilestoneCache) (models.Status, error) {
+	return convertStatus(obj.Status())
+}
+
+func (milestoneResolver) DueDate(_ context.Context, obj *cache.MilestoneCache) (*time.Time, error) {
+	due := obj.DueDate()
+	if due.IsZero() {
+		return nil, nil
+	}
+	return &due, nil
+}
+
+func (milestoneResolver) Author(_ context.Context, obj *cache.MilestoneCache) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author()), nil
+}
+
+func (milestoneResolver) CreatedAt(_ context.Context, obj *cache.MilestoneCache) (*time.Time, error) {
+	t := obj.CreateTime().Time()
+	return &t, nil
+}
+
+func (milestoneResolver) LastEdit(_ context.Context, obj *cache.MilestoneCache) (*time.Time, error) {
+	t := obj.LastEdit().Time()
+	return &t, nil
+}
@@ -49,14 +49,37 @@ func (r mutationResolver) NewBug(ctx context.Context, input models.NewBugInput)
 		return nil, err
 	}
 
-	b, op, err := repo.NewBugRaw(author, time.Now().Unix(), input.Title, input.Message, input.Files, nil)
-	if err != nil {
-		return nil, err
+	var b *cache.BugCache
+	var op *bug.CreateOperation
+
+	if input.Template != nil {
+		template, err := bug.LoadTemplate(repo, *input.Template)
+		if err != nil {
+			return nil, err
+		}
+
+		title, message := input.Title, input.Message
+		if title == "" {
+			title = template.Title
+		}
+		if message == "" {
+			message = template.Message
+		}
+
+		b, op, err = repo.NewBugFromTemplateRaw(author, time.Now().Unix(), template, title, message, input.Files, nil)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		b, op, err = repo.NewBugRaw(author, time.Now().Unix(), input.Title, input.Message, input.Files, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &models.NewBugPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
@@ -84,7 +107,7 @@ func (r mutationResolver) AddComment(ctx context.Context, input models.AddCommen
 
 	return &models.AddCommentPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
@@ -117,12 +140,98 @@ func (r mutationResolver) ChangeLabels(ctx context.Context, input *models.Change
 
 	return &models.ChangeLabelPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 		Results:          resultsPtr,
 	}, nil
 }
 
+func (r mutationResolver) ChangeAssignees(ctx context.Context, input models.ChangeAssigneesInput) (*models.ChangeAssigneesPayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := resolveIdentities(repo, input.Added)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := resolveIdentities(repo, input.Removed)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := b.ChangeAssigneesRaw(author, time.Now().Unix(), added, removed, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ChangeAssigneesPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) SetMilestone(ctx context.Context, input models.SetMilestoneInput) (*models.SetMilestonePayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var m *cache.MilestoneCache
+	if input.Milestone != nil {
+		m, err = repo.ResolveMilestonePrefix(*input.Milestone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	op, err := b.SetMilestoneRaw(author, time.Now().Unix(), m, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SetMilestonePayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func resolveIdentities(repo *cache.RepoCache, prefixes []string) ([]*cache.IdentityCache, error) {
+	result := make([]*cache.IdentityCache, len(prefixes))
+	for i, prefix := range prefixes {
+		id, err := repo.ResolveIdentityPrefix(prefix)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = id
+	}
+	return result, nil
+}
+
 func (r mutationResolver) OpenBug(ctx context.Context, input models.OpenBugInput) (*models.OpenBugPayload, error) {
 	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
 	if err != nil {
@@ -146,7 +255,7 @@ func (r mutationResolver) OpenBug(ctx context.Context, input models.OpenBugInput
 
 	return &models.OpenBugPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
@@ -162,7 +271,7 @@ func (r mutationResolver) CloseBug(ctx context.Context, input models.CloseBugInp
 		return nil, err
 	}
 
-	op, err := b.CloseRaw(author, time.Now().Unix(), nil)
+	op, err := b.CloseWithResolutionRaw(author, time.Now().Unix(), resolutionFromInput(input.Resolution), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -174,7 +283,35 @@ func (r mutationResolver) CloseBug(ctx context.Context, input models.CloseBugInp
 
 	return &models.CloseBugPayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) SetStatus(ctx context.Context, input models.SetStatusInput) (*models.SetStatusPayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := b.SetStatusRaw(author, time.Now().Unix(), input.Status, resolutionFromInput(input.Resolution), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SetStatusPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
@@ -202,7 +339,203 @@ func (r mutationResolver) SetTitle(ctx context.Context, input models.SetTitleInp
 
 	return &models.SetTitlePayload{
 		ClientMutationID: input.ClientMutationID,
-		Bug:              models.NewLoadedBug(b.Snapshot()),
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) RedactComment(ctx context.Context, input models.RedactCommentInput) (*models.RedactCommentPayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := b.ResolveCommentPrefix(input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := b.RedactCommentRaw(author, time.Now().Unix(), target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.RedactCommentPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) EditComment(ctx context.Context, input models.EditCommentInput) (*models.EditCommentPayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := b.ResolveCommentPrefix(input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := b.EditCommentRaw(author, time.Now().Unix(), target, input.Message, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.EditCommentPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
+		Operation:        op,
+	}, nil
+}
+
+func (r mutationResolver) SetMetadata(ctx context.Context, input models.SetMetadataInput) (*models.SetMetadataPayload, error) {
+	repo, b, err := r.getBug(input.RepoRef, input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := b.ResolveOperationPrefix(input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string]string, len(input.Metadata))
+	for _, m := range input.Metadata {
+		metadata[m.Key] = m.Value
+	}
+
+	op, err := b.SetMetadataRaw(author, time.Now().Unix(), target, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SetMetadataPayload{
+		ClientMutationID: input.ClientMutationID,
+		Bug:              models.NewLoadedBug(repo, b.Snapshot()),
 		Operation:        op,
 	}, nil
 }
+
+func (r mutationResolver) CreateIdentity(ctx context.Context, input models.CreateIdentityInput) (*models.CreateIdentityPayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// a read-only client can't create identities
+	_, err = auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var login, avatarUrl string
+	if input.Login != nil {
+		login = *input.Login
+	}
+	if input.AvatarURL != nil {
+		avatarUrl = *input.AvatarURL
+	}
+
+	// the other users are authenticated by their login or email, which must
+	// stay unambiguous
+	if !auth.IsLocalUser(ctx) {
+		err = auth.CheckUserAvailable(repo, login, input.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	i, err := repo.NewIdentityRaw(input.Name, input.Email, login, avatarUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.CommitAsNeeded()
+	if err != nil {
+		return nil, err
+	}
+
+	// the user identity is shared by all the users, only the local one can
+	// set it
+	set, err := repo.IsUserIdentitySet()
+	if err != nil {
+		return nil, err
+	}
+
+	if !set && auth.IsLocalUser(ctx) {
+		err = repo.SetUserIdentity(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.CreateIdentityPayload{
+		ClientMutationID: input.ClientMutationID,
+		Identity:         models.NewLoadedIdentity(i.Identity),
+	}, nil
+}
+
+func (r mutationResolver) AdoptIdentity(ctx context.Context, input models.AdoptIdentityInput) (*models.AdoptIdentityPayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// a read-only client can't change the user identity
+	_, err = auth.UserFromCtx(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// the user identity is shared by all the users
+	if !auth.IsLocalUser(ctx) {
+		return nil, auth.ErrNotLocalUser
+	}
+
+	i, err := repo.ResolveIdentityPrefix(input.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repo.SetUserIdentity(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AdoptIdentityPayload{
+		ClientMutationID: input.ClientMutationID,
+		Identity:         models.NewLoadedIdentity(i.Identity),
+	}, nil
+}
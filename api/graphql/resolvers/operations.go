@@ -3,11 +3,15 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/MichaelMure/git-bug/api/graphql/graph"
 	"github.com/MichaelMure/git-bug/api/graphql/models"
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
 )
 
 var _ graph.CreateOperationResolver = createOperationResolver{}
@@ -103,6 +107,10 @@ func (setStatusOperationResolver) Status(_ context.Context, obj *bug.SetStatusOp
 	return convertStatus(obj.Status)
 }
 
+func (setStatusOperationResolver) Resolution(_ context.Context, obj *bug.SetStatusOperation) (*models.Resolution, error) {
+	return convertResolution(obj.Resolution)
+}
+
 var _ graph.SetTitleOperationResolver = setTitleOperationResolver{}
 
 type setTitleOperationResolver struct{}
@@ -120,6 +128,186 @@ func (setTitleOperationResolver) Date(_ context.Context, obj *bug.SetTitleOperat
 	return &t, nil
 }
 
+var _ graph.SetAssigneesOperationResolver = setAssigneesOperationResolver{}
+
+type setAssigneesOperationResolver struct{}
+
+func (setAssigneesOperationResolver) ID(_ context.Context, obj *bug.SetAssigneesOperation) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (setAssigneesOperationResolver) Author(_ context.Context, obj *bug.SetAssigneesOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (setAssigneesOperationResolver) Date(_ context.Context, obj *bug.SetAssigneesOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+func (setAssigneesOperationResolver) Added(_ context.Context, obj *bug.SetAssigneesOperation) ([]models.IdentityWrapper, error) {
+	return convertIdentities(obj.Added), nil
+}
+
+func (setAssigneesOperationResolver) Removed(_ context.Context, obj *bug.SetAssigneesOperation) ([]models.IdentityWrapper, error) {
+	return convertIdentities(obj.Removed), nil
+}
+
+var _ graph.SetMilestoneOperationResolver = setMilestoneOperationResolver{}
+
+type setMilestoneOperationResolver struct{}
+
+func (setMilestoneOperationResolver) ID(_ context.Context, obj *bug.SetMilestoneOperation) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (setMilestoneOperationResolver) Author(_ context.Context, obj *bug.SetMilestoneOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (setMilestoneOperationResolver) Date(_ context.Context, obj *bug.SetMilestoneOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+func (setMilestoneOperationResolver) Milestone(_ context.Context, obj *bug.SetMilestoneOperation) (*string, error) {
+	return convertOptionalId(obj.Milestone), nil
+}
+
+func (setMilestoneOperationResolver) Was(_ context.Context, obj *bug.SetMilestoneOperation) (*string, error) {
+	return convertOptionalId(obj.Was), nil
+}
+
+var _ graph.SetLinksOperationResolver = setLinksOperationResolver{}
+
+type setLinksOperationResolver struct{}
+
+func (setLinksOperationResolver) ID(_ context.Context, obj *bug.SetLinksOperation) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (setLinksOperationResolver) Author(_ context.Context, obj *bug.SetLinksOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (setLinksOperationResolver) Date(_ context.Context, obj *bug.SetLinksOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+func (setLinksOperationResolver) Added(_ context.Context, obj *bug.SetLinksOperation) ([]*models.BugLink, error) {
+	return models.NewBugLinks(nil, obj.Added), nil
+}
+
+func (setLinksOperationResolver) Removed(_ context.Context, obj *bug.SetLinksOperation) ([]*models.BugLink, error) {
+	return models.NewBugLinks(nil, obj.Removed), nil
+}
+
+var _ graph.AddReactionOperationResolver = addReactionOperationResolver{}
+
+type addReactionOperationResolver struct{}
+
+func (addReactionOperationResolver) ID(_ context.Context, obj *bug.AddReactionOperation) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (addReactionOperationResolver) Author(_ context.Context, obj *bug.AddReactionOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (addReactionOperationResolver) Date(_ context.Context, obj *bug.AddReactionOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+func (addReactionOperationResolver) Target(_ context.Context, obj *bug.AddReactionOperation) (string, error) {
+	return obj.Target.String(), nil
+}
+
+func (addReactionOperationResolver) Reaction(_ context.Context, obj *bug.AddReactionOperation) (string, error) {
+	return obj.Reaction.String(), nil
+}
+
+var _ graph.RemoveReactionOperationResolver = removeReactionOperationResolver{}
+
+type removeReactionOperationResolver struct{}
+
+func (removeReactionOperationResolver) ID(_ context.Context, obj *bug.RemoveReactionOperation) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (removeReactionOperationResolver) Author(_ context.Context, obj *bug.RemoveReactionOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (removeReactionOperationResolver) Date(_ context.Context, obj *bug.RemoveReactionOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+func (removeReactionOperationResolver) Target(_ context.Context, obj *bug.RemoveReactionOperation) (string, error) {
+	return obj.Target.String(), nil
+}
+
+func (removeReactionOperationResolver) Reaction(_ context.Context, obj *bug.RemoveReactionOperation) (string, error) {
+	return obj.Reaction.String(), nil
+}
+
+var _ graph.RedactCommentOperationResolver = redactCommentOperationResolver{}
+
+type redactCommentOperationResolver struct{}
+
+func (redactCommentOperationResolver) ID(_ context.Context, obj *bug.RedactCommentOperation) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (redactCommentOperationResolver) Author(_ context.Context, obj *bug.RedactCommentOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (redactCommentOperationResolver) Date(_ context.Context, obj *bug.RedactCommentOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+func (redactCommentOperationResolver) Target(_ context.Context, obj *bug.RedactCommentOperation) (string, error) {
+	return obj.Target.String(), nil
+}
+
+var _ graph.SetMetadataOperationResolver = setMetadataOperationResolver{}
+
+type setMetadataOperationResolver struct{}
+
+func (setMetadataOperationResolver) ID(_ context.Context, obj *bug.SetMetadataOperation) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (setMetadataOperationResolver) Author(_ context.Context, obj *bug.SetMetadataOperation) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (setMetadataOperationResolver) Date(_ context.Context, obj *bug.SetMetadataOperation) (*time.Time, error) {
+	t := obj.Time()
+	return &t, nil
+}
+
+func (setMetadataOperationResolver) Target(_ context.Context, obj *bug.SetMetadataOperation) (string, error) {
+	return obj.Target.String(), nil
+}
+
+func (setMetadataOperationResolver) NewMetadata(_ context.Context, obj *bug.SetMetadataOperation) ([]*models.Metadata, error) {
+	result := make([]*models.Metadata, 0, len(obj.NewMetadata))
+	for key, value := range obj.NewMetadata {
+		result = append(result, &models.Metadata{Key: key, Value: value})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+
+	return result, nil
+}
+
 func convertStatus(status bug.Status) (models.Status, error) {
 	switch status {
 	case bug.OpenStatus:
@@ -130,3 +318,50 @@ func convertStatus(status bug.Status) (models.Status, error) {
 
 	return "", fmt.Errorf("unknown status")
 }
+
+// convertResolution convert a resolution to its GraphQL enum, nil if there is none
+func convertResolution(resolution bug.Resolution) (*models.Resolution, error) {
+	var result models.Resolution
+
+	switch resolution {
+	case "":
+		return nil, nil
+	case bug.ResolutionFixed:
+		result = models.ResolutionFixed
+	case bug.ResolutionWontFix:
+		result = models.ResolutionWontfix
+	case bug.ResolutionDuplicate:
+		result = models.ResolutionDuplicate
+	case bug.ResolutionInvalid:
+		result = models.ResolutionInvalid
+	default:
+		return nil, fmt.Errorf("unknown resolution")
+	}
+
+	return &result, nil
+}
+
+// resolutionFromInput convert an optional GraphQL resolution to a resolution
+func resolutionFromInput(resolution *models.Resolution) bug.Resolution {
+	if resolution == nil {
+		return ""
+	}
+	return bug.Resolution(strings.ToLower(resolution.String()))
+}
+
+func convertIdentities(identities []identity.Interface) []models.IdentityWrapper {
+	result := make([]models.IdentityWrapper, len(identities))
+	for i, id := range identities {
+		result[i] = models.NewLoadedIdentity(id)
+	}
+	return result
+}
+
+// convertOptionalId return nil for an unset id
+func convertOptionalId(id entity.Id) *string {
+	if id == "" {
+		return nil
+	}
+	str := id.String()
+	return &str
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/MichaelMure/git-bug/api/graphql/graph"
 	"github.com/MichaelMure/git-bug/api/graphql/models"
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/query"
 )
@@ -31,7 +32,7 @@ func (repoResolver) AllBugs(_ context.Context, obj *models.Repository, after *st
 
 	var q *query.Query
 	if queryStr != nil {
-		query2, err := query.Parse(*queryStr)
+		query2, err := query.ParseWithSaved(obj.Repo, *queryStr)
 		if err != nil {
 			return nil, err
 		}
@@ -150,6 +151,63 @@ func (repoResolver) Identity(_ context.Context, obj *models.Repository, prefix s
 	return models.NewLazyIdentity(obj.Repo, excerpt), nil
 }
 
+func (repoResolver) AllMilestones(_ context.Context, obj *models.Repository, after *string, before *string, first *int, last *int) (*models.MilestoneConnection, error) {
+	input := models.ConnectionInput{
+		Before: before,
+		After:  after,
+		First:  first,
+		Last:   last,
+	}
+
+	excerpts := obj.Repo.AllMilestoneExcerpts()
+
+	// Simply pass a []string with the ids to the pagination algorithm
+	source := make([]entity.Id, len(excerpts))
+	for i, excerpt := range excerpts {
+		source[i] = excerpt.Id
+	}
+
+	// The edger create a custom edge holding just the id
+	edger := func(id entity.Id, offset int) connections.Edge {
+		return connections.LazyMilestoneEdge{
+			Id:     id,
+			Cursor: connections.OffsetToCursor(offset),
+		}
+	}
+
+	// The conMaker will finally load the milestones from git to replace the selected edges
+	conMaker := func(lazyMilestoneEdges []*connections.LazyMilestoneEdge, lazyNode []entity.Id, info *models.PageInfo, totalCount int) (*models.MilestoneConnection, error) {
+		edges := make([]*models.MilestoneEdge, len(lazyMilestoneEdges))
+		nodes := make([]*cache.MilestoneCache, len(lazyMilestoneEdges))
+
+		for k, lazyMilestoneEdge := range lazyMilestoneEdges {
+			m, err := obj.Repo.ResolveMilestone(lazyMilestoneEdge.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			edges[k] = &models.MilestoneEdge{
+				Cursor: lazyMilestoneEdge.Cursor,
+				Node:   m,
+			}
+			nodes[k] = m
+		}
+
+		return &models.MilestoneConnection{
+			Edges:      edges,
+			Nodes:      nodes,
+			PageInfo:   info,
+			TotalCount: totalCount,
+		}, nil
+	}
+
+	return connections.LazyMilestoneCon(source, edger, conMaker, input)
+}
+
+func (repoResolver) Milestone(_ context.Context, obj *models.Repository, prefix string) (*cache.MilestoneCache, error) {
+	return obj.Repo.ResolveMilestonePrefix(prefix)
+}
+
 func (repoResolver) UserIdentity(ctx context.Context, obj *models.Repository) (models.IdentityWrapper, error) {
 	id, err := auth.UserFromCtx(ctx, obj.Repo)
 	if err == auth.ErrNotAuthenticated {
@@ -186,3 +244,45 @@ func (repoResolver) ValidLabels(_ context.Context, obj *models.Repository, after
 
 	return connections.LabelCon(obj.Repo.ValidLabels(), edger, conMaker, input)
 }
+
+func (repoResolver) SavedQueries(_ context.Context, obj *models.Repository) ([]*query.SavedQuery, error) {
+	queries, err := query.SavedQueries(obj.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*query.SavedQuery, len(queries))
+	for i := range queries {
+		result[i] = &queries[i]
+	}
+
+	return result, nil
+}
+
+func (repoResolver) BugTemplates(_ context.Context, obj *models.Repository) ([]*bug.Template, error) {
+	templates, err := bug.Templates(obj.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*bug.Template, len(templates))
+	for i := range templates {
+		result[i] = &templates[i]
+	}
+
+	return result, nil
+}
+
+func (repoResolver) WorkflowStatuses(_ context.Context, obj *models.Repository) ([]*bug.WorkflowStatus, error) {
+	workflow, err := bug.LoadWorkflow(obj.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*bug.WorkflowStatus, len(workflow.Statuses))
+	for i := range workflow.Statuses {
+		result[i] = &workflow.Statuses[i]
+	}
+
+	return result, nil
+}
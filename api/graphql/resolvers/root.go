@@ -30,6 +30,12 @@ func (r RootResolver) Mutation() graph.MutationResolver {
 	}
 }
 
+func (r RootResolver) Subscription() graph.SubscriptionResolver {
+	return &subscriptionResolver{
+		cache: r.MultiRepoCache,
+	}
+}
+
 func (RootResolver) Repository() graph.RepositoryResolver {
 	return &repoResolver{}
 }
@@ -54,6 +60,22 @@ func (r RootResolver) Identity() graph.IdentityResolver {
 	return &identityResolver{}
 }
 
+func (RootResolver) Milestone() graph.MilestoneResolver {
+	return &milestoneResolver{}
+}
+
+func (RootResolver) BugLink() graph.BugLinkResolver {
+	return &bugLinkResolver{}
+}
+
+func (RootResolver) ReactionGroup() graph.ReactionGroupResolver {
+	return &reactionGroupResolver{}
+}
+
+func (RootResolver) WorkflowStatus() graph.WorkflowStatusResolver {
+	return &workflowStatusResolver{}
+}
+
 func (RootResolver) CommentHistoryStep() graph.CommentHistoryStepResolver {
 	return &commentHistoryStepResolver{}
 }
@@ -78,6 +100,18 @@ func (r RootResolver) SetTitleTimelineItem() graph.SetTitleTimelineItemResolver
 	return &setTitleTimelineItem{}
 }
 
+func (r RootResolver) SetAssigneesTimelineItem() graph.SetAssigneesTimelineItemResolver {
+	return &setAssigneesTimelineItem{}
+}
+
+func (r RootResolver) SetMilestoneTimelineItem() graph.SetMilestoneTimelineItemResolver {
+	return &setMilestoneTimelineItem{}
+}
+
+func (r RootResolver) SetLinksTimelineItem() graph.SetLinksTimelineItemResolver {
+	return &setLinksTimelineItem{}
+}
+
 func (RootResolver) CreateOperation() graph.CreateOperationResolver {
 	return &createOperationResolver{}
 }
@@ -102,6 +136,34 @@ func (RootResolver) SetTitleOperation() graph.SetTitleOperationResolver {
 	return &setTitleOperationResolver{}
 }
 
+func (RootResolver) SetAssigneesOperation() graph.SetAssigneesOperationResolver {
+	return &setAssigneesOperationResolver{}
+}
+
+func (RootResolver) SetMilestoneOperation() graph.SetMilestoneOperationResolver {
+	return &setMilestoneOperationResolver{}
+}
+
+func (RootResolver) SetLinksOperation() graph.SetLinksOperationResolver {
+	return &setLinksOperationResolver{}
+}
+
+func (RootResolver) AddReactionOperation() graph.AddReactionOperationResolver {
+	return &addReactionOperationResolver{}
+}
+
+func (RootResolver) RemoveReactionOperation() graph.RemoveReactionOperationResolver {
+	return &removeReactionOperationResolver{}
+}
+
+func (RootResolver) RedactCommentOperation() graph.RedactCommentOperationResolver {
+	return &redactCommentOperationResolver{}
+}
+
+func (RootResolver) SetMetadataOperation() graph.SetMetadataOperationResolver {
+	return &setMetadataOperationResolver{}
+}
+
 func (r RootResolver) LabelChangeResult() graph.LabelChangeResultResolver {
 	return &labelChangeResultResolver{}
 }
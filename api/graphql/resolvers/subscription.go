@@ -0,0 +1,124 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/MichaelMure/git-bug/api/graphql/graph"
+	"github.com/MichaelMure/git-bug/api/graphql/models"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
+)
+
+var _ graph.SubscriptionResolver = &subscriptionResolver{}
+
+type subscriptionResolver struct {
+	cache *cache.MultiRepoCache
+}
+
+func (r subscriptionResolver) getRepo(ref *string) (*cache.RepoCache, error) {
+	if ref != nil {
+		return r.cache.ResolveRepo(*ref)
+	}
+
+	return r.cache.DefaultRepo()
+}
+
+func (r subscriptionResolver) BugChanged(ctx context.Context, repoRef *string, queryStr *string) (<-chan models.BugWrapper, error) {
+	repo, err := r.getRepo(repoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var q *query.Query
+	if queryStr != nil {
+		q, err = query.ParseWithSaved(repo, *queryStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	events, unsubscribe := repo.SubscribeBugEvents()
+	out := make(chan models.BugWrapper)
+
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if !repo.MatchBugExcerpt(q, event.Excerpt) {
+					continue
+				}
+				select {
+				case out <- models.NewLoadedBug(repo, event.Snapshot):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (r subscriptionResolver) TimelineItemAdded(ctx context.Context, repoRef *string, bugPrefix string) (<-chan bug.TimelineItem, error) {
+	repo, err := r.getRepo(repoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := repo.ResolveBugPrefix(bugPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the items appearing after the subscription are notified
+	seen := make(map[entity.Id]struct{})
+	for _, id := range b.TimelineIds() {
+		seen[id] = struct{}{}
+	}
+
+	events, unsubscribe := repo.SubscribeBugEvents()
+	out := make(chan bug.TimelineItem)
+
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if event.Id != b.Id() {
+					continue
+				}
+				// a merge can insert items anywhere in the timeline
+				for _, item := range event.Snapshot.Timeline {
+					if _, ok := seen[item.Id()]; ok {
+						continue
+					}
+					seen[item.Id()] = struct{}{}
+					select {
+					case out <- item:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
@@ -100,6 +100,10 @@ func (setStatusTimelineItem) Status(_ context.Context, obj *bug.SetStatusTimelin
 	return convertStatus(obj.Status)
 }
 
+func (setStatusTimelineItem) Resolution(_ context.Context, obj *bug.SetStatusTimelineItem) (*models.Resolution, error) {
+	return convertResolution(obj.Resolution)
+}
+
 var _ graph.SetTitleTimelineItemResolver = setTitleTimelineItem{}
 
 type setTitleTimelineItem struct{}
@@ -116,3 +120,78 @@ func (setTitleTimelineItem) Date(_ context.Context, obj *bug.SetTitleTimelineIte
 	t := obj.UnixTime.Time()
 	return &t, nil
 }
+
+var _ graph.SetAssigneesTimelineItemResolver = setAssigneesTimelineItem{}
+
+type setAssigneesTimelineItem struct{}
+
+func (setAssigneesTimelineItem) ID(_ context.Context, obj *bug.SetAssigneesTimelineItem) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (i setAssigneesTimelineItem) Author(_ context.Context, obj *bug.SetAssigneesTimelineItem) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (setAssigneesTimelineItem) Date(_ context.Context, obj *bug.SetAssigneesTimelineItem) (*time.Time, error) {
+	t := obj.UnixTime.Time()
+	return &t, nil
+}
+
+func (setAssigneesTimelineItem) Added(_ context.Context, obj *bug.SetAssigneesTimelineItem) ([]models.IdentityWrapper, error) {
+	return convertIdentities(obj.Added), nil
+}
+
+func (setAssigneesTimelineItem) Removed(_ context.Context, obj *bug.SetAssigneesTimelineItem) ([]models.IdentityWrapper, error) {
+	return convertIdentities(obj.Removed), nil
+}
+
+var _ graph.SetMilestoneTimelineItemResolver = setMilestoneTimelineItem{}
+
+type setMilestoneTimelineItem struct{}
+
+func (setMilestoneTimelineItem) ID(_ context.Context, obj *bug.SetMilestoneTimelineItem) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (i setMilestoneTimelineItem) Author(_ context.Context, obj *bug.SetMilestoneTimelineItem) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (setMilestoneTimelineItem) Date(_ context.Context, obj *bug.SetMilestoneTimelineItem) (*time.Time, error) {
+	t := obj.UnixTime.Time()
+	return &t, nil
+}
+
+func (setMilestoneTimelineItem) Milestone(_ context.Context, obj *bug.SetMilestoneTimelineItem) (*string, error) {
+	return convertOptionalId(obj.Milestone), nil
+}
+
+func (setMilestoneTimelineItem) Was(_ context.Context, obj *bug.SetMilestoneTimelineItem) (*string, error) {
+	return convertOptionalId(obj.Was), nil
+}
+
+var _ graph.SetLinksTimelineItemResolver = setLinksTimelineItem{}
+
+type setLinksTimelineItem struct{}
+
+func (setLinksTimelineItem) ID(_ context.Context, obj *bug.SetLinksTimelineItem) (string, error) {
+	return obj.Id().String(), nil
+}
+
+func (i setLinksTimelineItem) Author(_ context.Context, obj *bug.SetLinksTimelineItem) (models.IdentityWrapper, error) {
+	return models.NewLoadedIdentity(obj.Author), nil
+}
+
+func (setLinksTimelineItem) Date(_ context.Context, obj *bug.SetLinksTimelineItem) (*time.Time, error) {
+	t := obj.UnixTime.Time()
+	return &t, nil
+}
+
+func (setLinksTimelineItem) Added(_ context.Context, obj *bug.SetLinksTimelineItem) ([]*models.BugLink, error) {
+	return models.NewBugLinks(nil, obj.Added), nil
+}
+
+func (setLinksTimelineItem) Removed(_ context.Context, obj *bug.SetLinksTimelineItem) ([]*models.BugLink, error) {
+	return models.NewBugLinks(nil, obj.Removed), nil
+}
@@ -0,0 +1,17 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/MichaelMure/git-bug/api/graphql/graph"
+	"github.com/MichaelMure/git-bug/api/graphql/models"
+	"github.com/MichaelMure/git-bug/bug"
+)
+
+var _ graph.WorkflowStatusResolver = &workflowStatusResolver{}
+
+type workflowStatusResolver struct{}
+
+func (workflowStatusResolver) Category(_ context.Context, obj *bug.WorkflowStatus) (models.Status, error) {
+	return convertStatus(obj.Category)
+}
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// the maximum size of a webhook payload
+const webhookMaxSize = 25 << 20
+
+// implement a http.Handler that receive the webhooks of the remote
+// bug-trackers, and import the issue they notify a change of with the bridge.
+// The import is done within the request, so that a failed one is retried by
+// the remote.
+//
+// Expected gorilla/mux parameters:
+//   - "repo" : the ref of the repo or "" for the default one
+//   - "bridge" : the name of the bridge
+type webhookHandler struct {
+	mrc *cache.MultiRepoCache
+
+	mu sync.Mutex
+	// the import locks, by repo and bridge
+	locks map[string]*sync.Mutex
+}
+
+func NewWebhookHandler(mrc *cache.MultiRepoCache) http.Handler {
+	return &webhookHandler{
+		mrc:   mrc,
+		locks: make(map[string]*sync.Mutex),
+	}
+}
+
+func (wh *webhookHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	var repo *cache.RepoCache
+	var err error
+
+	repoVar := mux.Vars(r)["repo"]
+	switch repoVar {
+	case "":
+		repo, err = wh.mrc.DefaultRepo()
+	default:
+		repo, err = wh.mrc.ResolveRepo(repoVar)
+	}
+
+	if err != nil {
+		http.Error(rw, "invalid repo reference", http.StatusBadRequest)
+		return
+	}
+
+	name := mux.Vars(r)["bridge"]
+	if !core.BridgeExist(repo, name) {
+		http.Error(rw, "unknown bridge", http.StatusNotFound)
+		return
+	}
+
+	b, err := core.LoadBridge(repo, name)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	payload, err := ioutil.ReadAll(http.MaxBytesReader(rw, r.Body, webhookMaxSize))
+	if err != nil {
+		http.Error(rw, "can't read the payload", http.StatusBadRequest)
+		return
+	}
+
+	// the deliveries of a bridge are imported one at a time, as an import
+	// of the same issue could otherwise race with itself
+	lock := wh.bridgeLock(repoVar + "/" + name)
+	lock.Lock()
+	defer lock.Unlock()
+
+	events, err := b.ImportWebhook(r.Context(), r.Header, payload)
+	switch {
+	case err == core.ErrWebhookNotSupported:
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	case err == core.ErrWebhookSignature:
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	case err == core.ErrWebhookIgnored:
+		// ping, or an event not about the issues
+		rw.WriteHeader(http.StatusNoContent)
+		return
+	case err != nil:
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	failed := false
+	for event := range events {
+		if event.Err != nil {
+			log.Printf("bridge %s: %v\n", b.Name, event.Err)
+			failed = true
+		}
+	}
+
+	if failed {
+		// let the remote retry the delivery
+		http.Error(rw, "webhook import failed", http.StatusInternalServerError)
+		return
+	}
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+// bridgeLock return the lock serializing the imports of a bridge
+func (wh *webhookHandler) bridgeLock(key string) *sync.Mutex {
+	wh.mu.Lock()
+	defer wh.mu.Unlock()
+
+	lock, ok := wh.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		wh.locks[key] = lock
+	}
+	return lock
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/MichaelMure/git-bug/bridge"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	mrc := cache.NewMultiRepoCache()
+	_, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+	defer mrc.Close()
+
+	for key, value := range map[string]string{
+		"git-bug.bridge.github.target":          "github",
+		"git-bug.bridge.github.owner":           "MichaelMure",
+		"git-bug.bridge.github.project":         "git-bug",
+		"git-bug.bridge.github.default-login":   "test",
+		"git-bug.bridge.github.webhook-secret":  "secret",
+		"git-bug.bridge.nosecret.target":        "github",
+		"git-bug.bridge.nosecret.owner":         "MichaelMure",
+		"git-bug.bridge.nosecret.project":       "git-bug",
+		"git-bug.bridge.nosecret.default-login": "test",
+		"git-bug.bridge.email.target":           "email",
+		"git-bug.bridge.email.path":             "/tmp/mbox",
+	} {
+		require.NoError(t, repo.LocalConfig().StoreString(key, value))
+	}
+
+	router := mux.NewRouter()
+	router.Path("/webhook/{bridge}").Handler(NewWebhookHandler(mrc))
+
+	payload := []byte(`{"zen":"Keep it logically awesome."}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	post := func(bridge string, signature string) int {
+		r, _ := http.NewRequest("POST", "/webhook/"+bridge, bytes.NewReader(payload))
+		r.Header.Set("X-GitHub-Event", "ping")
+		r.Header.Set("X-Hub-Signature-256", signature)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusNoContent, post("github", signature))
+	require.Equal(t, http.StatusUnauthorized, post("github", "sha256=0123"))
+	require.Equal(t, http.StatusBadRequest, post("nosecret", signature))
+	require.Equal(t, http.StatusNotFound, post("email", signature))
+	require.Equal(t, http.StatusNotFound, post("unknown", signature))
+}
@@ -2,7 +2,10 @@
 package bridge
 
 import (
+	"github.com/MichaelMure/git-bug/bridge/bugzilla"
 	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/email"
+	"github.com/MichaelMure/git-bug/bridge/gitea"
 	"github.com/MichaelMure/git-bug/bridge/github"
 	"github.com/MichaelMure/git-bug/bridge/gitlab"
 	"github.com/MichaelMure/git-bug/bridge/jira"
@@ -16,6 +19,9 @@ func init() {
 	core.Register(&gitlab.Gitlab{})
 	core.Register(&launchpad.Launchpad{})
 	core.Register(&jira.Jira{})
+	core.Register(&gitea.Gitea{})
+	core.Register(&bugzilla.Bugzilla{})
+	core.Register(&email.Email{})
 }
 
 // Targets return all known bridge implementation target
@@ -0,0 +1,116 @@
+// Package bugzilla contains the Bugzilla bridge implementation
+package bugzilla
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+)
+
+const (
+	target = "bugzilla"
+
+	// the bug id, on the create operation
+	metaKeyBugzillaId = "bugzilla-id"
+	// the id of a comment, on the comment operations
+	metaKeyBugzillaCommentId = "bugzilla-comment-id"
+	// the index of an entry of the bug history and the field changed, on
+	// the status, title and label operations
+	metaKeyBugzillaHistoryId = "bugzilla-history-id"
+	metaKeyBugzillaUrl       = "bugzilla-url"
+	metaKeyBugzillaLogin     = "bugzilla-login"
+	metaKeyBugzillaProduct   = "bugzilla-product"
+	metaKeyBugzillaBaseUrl   = "bugzilla-base-url"
+
+	confKeyBugzillaBaseUrl = "base-url"
+	confKeyProduct         = "product"
+	confKeyDefaultLogin    = "default-login"
+
+	defaultTimeout = 60 * time.Second
+)
+
+var _ core.BridgeImpl = &Bugzilla{}
+
+type Bugzilla struct{}
+
+func (Bugzilla) Target() string {
+	return target
+}
+
+func (b *Bugzilla) LoginMetaKey() string {
+	return metaKeyBugzillaLogin
+}
+
+func (Bugzilla) NewImporter() core.Importer {
+	return &bugzillaImporter{}
+}
+
+func (Bugzilla) NewExporter() core.Exporter {
+	return &bugzillaExporter{}
+}
+
+// buildClient return a client authenticated with the given API key, or an
+// anonymous one if nil
+func buildClient(baseURL string, token *auth.Token) *client {
+	if token == nil {
+		return newClient(baseURL, "")
+	}
+	return newClient(baseURL, token.Value)
+}
+
+// bugURL return the web page of a bug
+func bugURL(baseURL string, id int64) string {
+	return fmt.Sprintf("%s/show_bug.cgi?id=%d", strings.TrimSuffix(baseURL, "/"), id)
+}
+
+// statuses of the default Bugzilla workflows used when exporting a status
+// change
+const (
+	statusReopened = "CONFIRMED"
+	statusClosed   = "RESOLVED"
+)
+
+// isClosedStatus return whether a bug with the given status is closed, in
+// the default Bugzilla workflows
+func isClosedStatus(status string) bool {
+	switch status {
+	case "RESOLVED", "VERIFIED", "CLOSED":
+		return true
+	default:
+		return false
+	}
+}
+
+// toResolution convert a Bugzilla resolution to the closest git-bug one
+func toResolution(resolution string) bug.Resolution {
+	switch resolution {
+	case "FIXED":
+		return bug.ResolutionFixed
+	case "WONTFIX":
+		return bug.ResolutionWontFix
+	case "DUPLICATE":
+		return bug.ResolutionDuplicate
+	case "INVALID", "WORKSFORME":
+		return bug.ResolutionInvalid
+	default:
+		return ""
+	}
+}
+
+// fromResolution convert a git-bug resolution to a Bugzilla one. As marking
+// a bug as a duplicate require the duplicated bug, duplicates are exported
+// as invalid.
+func fromResolution(resolution bug.Resolution) string {
+	switch resolution {
+	case bug.ResolutionWontFix:
+		return "WONTFIX"
+	case bug.ResolutionDuplicate, bug.ResolutionInvalid:
+		return "INVALID"
+	default:
+		return "FIXED"
+	}
+}
@@ -0,0 +1,343 @@
+package bugzilla
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+// number of bugs requested per page
+const pageSize = 50
+
+// history fields handled by the bridge
+const (
+	fieldStatus     = "status"
+	fieldResolution = "resolution"
+	fieldSummary    = "summary"
+	fieldKeywords   = "keywords"
+)
+
+var ErrProductNotFound = errors.New("product not found")
+
+// =============================================================================
+// JSON Objects
+// =============================================================================
+
+// User is a Bugzilla user. The name is the login, usually an email address.
+// https://bugzilla.readthedocs.io/en/latest/api/core/v1/user.html
+type User struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	RealName string `json:"real_name"`
+	Email    string `json:"email"`
+}
+
+// Product is a Bugzilla product, which bugs are filled against
+// https://bugzilla.readthedocs.io/en/latest/api/core/v1/product.html
+type Product struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Bug is a Bugzilla bug
+// https://bugzilla.readthedocs.io/en/latest/api/core/v1/bug.html
+type Bug struct {
+	ID             int64     `json:"id"`
+	Summary        string    `json:"summary"`
+	Status         string    `json:"status"`
+	Resolution     string    `json:"resolution"`
+	Keywords       []string  `json:"keywords"`
+	Creator        string    `json:"creator"`
+	CreatorDetail  *User     `json:"creator_detail"`
+	CreationTime   time.Time `json:"creation_time"`
+	LastChangeTime time.Time `json:"last_change_time"`
+}
+
+// Comment is a comment on a Bugzilla bug. The comment with the count 0 is
+// the description of the bug.
+// https://bugzilla.readthedocs.io/en/latest/api/core/v1/comment.html
+type Comment struct {
+	ID           int64     `json:"id"`
+	BugID        int64     `json:"bug_id"`
+	Count        int       `json:"count"`
+	Text         string    `json:"text"`
+	Creator      string    `json:"creator"`
+	CreationTime time.Time `json:"creation_time"`
+	AttachmentID *int64    `json:"attachment_id"`
+	IsPrivate    bool      `json:"is_private"`
+}
+
+// Attachment is a file attached to a Bugzilla bug
+// https://bugzilla.readthedocs.io/en/latest/api/core/v1/attachment.html
+type Attachment struct {
+	ID          int64  `json:"id"`
+	BugID       int64  `json:"bug_id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	// base64 encoded in the JSON, decoded by encoding/json
+	Data      []byte `json:"data"`
+	IsPrivate bool   `json:"is_private"`
+}
+
+// HistoryEntry is a set of changes made to the fields of a bug at once
+// https://bugzilla.readthedocs.io/en/latest/api/core/v1/bug.html#bug-history
+type HistoryEntry struct {
+	When    time.Time `json:"when"`
+	Who     string    `json:"who"`
+	Changes []Change  `json:"changes"`
+}
+
+// Change is the change of a single field of a bug
+type Change struct {
+	FieldName string `json:"field_name"`
+	Removed   string `json:"removed"`
+	Added     string `json:"added"`
+}
+
+// change return the change of the given field in the entry, if any
+func (h HistoryEntry) change(field string) (Change, bool) {
+	for _, change := range h.Changes {
+		if change.FieldName == field {
+			return change, true
+		}
+	}
+	return Change{}, false
+}
+
+// apiError is the body of an error response
+type apiError struct {
+	Error   bool   `json:"error"`
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+// =============================================================================
+// REST Client
+// =============================================================================
+
+// client is a minimal client of the Bugzilla REST API (Bugzilla 5.0 and
+// later)
+type client struct {
+	apiURL string
+	apiKey string
+	http   *http.Client
+}
+
+func newClient(baseURL string, apiKey string) *client {
+	return &client{
+		apiURL: strings.TrimSuffix(baseURL, "/") + "/rest",
+		apiKey: apiKey,
+		http:   &http.Client{},
+	}
+}
+
+// do send a request to the API, encoding in as the JSON body if not nil and
+// decoding the response in out if not nil
+func (c *client) do(ctx context.Context, method string, path string, query url.Values, in interface{}, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	reqURL := c.apiURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, reqURL, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.apiKey != "" {
+		req.Header.Set("X-BUGZILLA-API-KEY", c.apiKey)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := ioutil.ReadAll(resp.Body)
+		var apiErr apiError
+		err := fmt.Errorf("bugzilla: %s %s: %s", method, path, resp.Status)
+		if json.Unmarshal(data, &apiErr) == nil && apiErr.Message != "" {
+			err = fmt.Errorf("bugzilla: %s %s: %s (%s)", method, path, resp.Status, apiErr.Message)
+		}
+		if core.IsRateLimitResponse(resp) {
+			return core.NewRateLimitError(err)
+		}
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// WhoAmI return the user authenticated by the API key
+func (c *client) WhoAmI(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.do(ctx, http.MethodGet, "/whoami", nil, nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetUser return a user by login
+func (c *client) GetUser(ctx context.Context, login string) (*User, error) {
+	var result struct {
+		Users []User `json:"users"`
+	}
+	query := url.Values{"names": []string{login}}
+	if err := c.do(ctx, http.MethodGet, "/user", query, nil, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Users) == 0 {
+		return nil, fmt.Errorf("user %s not found", login)
+	}
+	return &result.Users[0], nil
+}
+
+// GetProduct return a product by name
+func (c *client) GetProduct(ctx context.Context, name string) (*Product, error) {
+	var result struct {
+		Products []Product `json:"products"`
+	}
+	query := url.Values{"names": []string{name}}
+	if err := c.do(ctx, http.MethodGet, "/product", query, nil, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Products) == 0 {
+		return nil, ErrProductNotFound
+	}
+	return &result.Products[0], nil
+}
+
+// SearchBugs return a page of the bugs of a product changed after since,
+// starting at the given offset
+func (c *client) SearchBugs(ctx context.Context, product string, since time.Time, offset int) ([]Bug, error) {
+	query := url.Values{
+		"product": []string{product},
+		"order":   []string{"bug_id"},
+		"limit":   []string{strconv.Itoa(pageSize)},
+		"offset":  []string{strconv.Itoa(offset)},
+	}
+	if !since.IsZero() {
+		query.Set("last_change_time", since.UTC().Format(time.RFC3339))
+	}
+
+	var result struct {
+		Bugs []Bug `json:"bugs"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/bug", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Bugs, nil
+}
+
+// GetComments return all the comments of a bug, the description included
+func (c *client) GetComments(ctx context.Context, bugID int64) ([]Comment, error) {
+	var result struct {
+		Bugs map[string]struct {
+			Comments []Comment `json:"comments"`
+		} `json:"bugs"`
+	}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bug/%d/comment", bugID), nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Bugs[strconv.FormatInt(bugID, 10)].Comments, nil
+}
+
+// GetHistory return the history of the changes of a bug, oldest first
+func (c *client) GetHistory(ctx context.Context, bugID int64) ([]HistoryEntry, error) {
+	var result struct {
+		Bugs []struct {
+			ID      int64          `json:"id"`
+			History []HistoryEntry `json:"history"`
+		} `json:"bugs"`
+	}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bug/%d/history", bugID), nil, nil, &result); err != nil {
+		return nil, err
+	}
+	for _, b := range result.Bugs {
+		if b.ID == bugID {
+			return b.History, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetAttachment return an attachment, with its data
+func (c *client) GetAttachment(ctx context.Context, id int64) (*Attachment, error) {
+	var result struct {
+		Attachments map[string]Attachment `json:"attachments"`
+	}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/bug/attachment/%d", id), nil, nil, &result); err != nil {
+		return nil, err
+	}
+	attachment, ok := result.Attachments[strconv.FormatInt(id, 10)]
+	if !ok {
+		return nil, fmt.Errorf("attachment %d not found", id)
+	}
+	return &attachment, nil
+}
+
+// AddComment add a comment to a bug and return its id
+func (c *client) AddComment(ctx context.Context, bugID int64, text string) (int64, error) {
+	in := struct {
+		Comment string `json:"comment"`
+	}{
+		Comment: text,
+	}
+
+	var result struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/bug/%d/comment", bugID), nil, in, &result); err != nil {
+		return 0, err
+	}
+	return result.ID, nil
+}
+
+// UpdateStatus change the status of a bug, with a resolution for the closed
+// statuses
+func (c *client) UpdateStatus(ctx context.Context, bugID int64, status, resolution string) error {
+	in := struct {
+		Status     string `json:"status"`
+		Resolution string `json:"resolution,omitempty"`
+	}{
+		Status:     status,
+		Resolution: resolution,
+	}
+
+	return c.do(ctx, http.MethodPut, fmt.Sprintf("/bug/%d", bugID), nil, in, nil)
+}
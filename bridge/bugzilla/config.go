@@ -0,0 +1,256 @@
+package bugzilla
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+var (
+	ErrBadProductURL = errors.New("bad product url")
+)
+
+func (b *Bugzilla) ValidParams() map[string]interface{} {
+	return map[string]interface{}{
+		"URL":        nil,
+		"BaseURL":    nil,
+		"Project":    nil,
+		"Login":      nil,
+		"CredPrefix": nil,
+		"TokenRaw":   nil,
+	}
+}
+
+func (b *Bugzilla) Configure(repo *cache.RepoCache, params core.BridgeParams) (core.Configuration, error) {
+	var err error
+	var baseUrl, product string
+
+	if params.URL != "" {
+		baseUrl, product, err = splitProductURL(params.URL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case params.BaseURL != "":
+		baseUrl = params.BaseURL
+	case baseUrl != "":
+	default:
+		baseUrl, err = input.Prompt("Bugzilla server URL", "URL", input.Required, input.IsURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "base url prompt")
+		}
+	}
+	baseUrl = strings.TrimSuffix(baseUrl, "/")
+
+	switch {
+	case params.Project != "":
+		product = params.Project
+	case product != "":
+	default:
+		product, err = input.Prompt("Bugzilla product", "product", input.Required)
+		if err != nil {
+			return nil, errors.Wrap(err, "product prompt")
+		}
+	}
+
+	var login string
+	var cred auth.Credential
+
+	switch {
+	case params.CredPrefix != "":
+		cred, err = auth.LoadWithPrefix(repo, params.CredPrefix)
+		if err != nil {
+			return nil, err
+		}
+		l, ok := cred.GetMetadata(auth.MetaKeyLogin)
+		if !ok {
+			return nil, fmt.Errorf("credential doesn't have a login")
+		}
+		login = l
+	case params.TokenRaw != "":
+		token := auth.NewToken(target, params.TokenRaw)
+		login, err = getLoginFromToken(baseUrl, token)
+		if err != nil {
+			return nil, err
+		}
+		token.SetMetadata(auth.MetaKeyLogin, login)
+		token.SetMetadata(auth.MetaKeyBaseURL, baseUrl)
+		cred = token
+	default:
+		if params.Login == "" {
+			login, err = input.Prompt("Bugzilla login", "login", input.Required)
+		} else {
+			login = params.Login
+		}
+		if err != nil {
+			return nil, err
+		}
+		cred, err = promptTokenOptions(repo, login, baseUrl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	token, ok := cred.(*auth.Token)
+	if !ok {
+		return nil, fmt.Errorf("the Bugzilla bridge only handle API key credentials")
+	}
+
+	// validate the product and the API key access
+	err = validateProduct(baseUrl, product, token)
+	if err != nil {
+		return nil, errors.Wrap(err, "product validation")
+	}
+
+	conf := make(core.Configuration)
+	conf[core.ConfigKeyTarget] = target
+	conf[confKeyBugzillaBaseUrl] = baseUrl
+	conf[confKeyProduct] = product
+	conf[confKeyDefaultLogin] = login
+
+	err = b.ValidateConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// don't forget to store the now known valid API key
+	if !auth.IdExist(repo, cred.ID()) {
+		err = auth.Store(repo, cred)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return conf, core.FinishConfig(repo, metaKeyBugzillaLogin, login)
+}
+
+func (b *Bugzilla) ValidateConfig(conf core.Configuration) error {
+	if v, ok := conf[core.ConfigKeyTarget]; !ok {
+		return fmt.Errorf("missing %s key", core.ConfigKeyTarget)
+	} else if v != target {
+		return fmt.Errorf("unexpected target name: %v", v)
+	}
+	if _, ok := conf[confKeyBugzillaBaseUrl]; !ok {
+		return fmt.Errorf("missing %s key", confKeyBugzillaBaseUrl)
+	}
+	if _, ok := conf[confKeyProduct]; !ok {
+		return fmt.Errorf("missing %s key", confKeyProduct)
+	}
+	if _, ok := conf[confKeyDefaultLogin]; !ok {
+		return fmt.Errorf("missing %s key", confKeyDefaultLogin)
+	}
+
+	return nil
+}
+
+func promptTokenOptions(repo repository.RepoKeyring, login, baseUrl string) (auth.Credential, error) {
+	creds, err := auth.List(repo,
+		auth.WithTarget(target),
+		auth.WithKind(auth.KindToken),
+		auth.WithMeta(auth.MetaKeyLogin, login),
+		auth.WithMeta(auth.MetaKeyBaseURL, baseUrl),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, index, err := input.PromptCredential(target, "API key", creds, []string{
+		"enter my API key",
+	})
+	switch {
+	case err != nil:
+		return nil, err
+	case cred != nil:
+		return cred, nil
+	case index == 0:
+		return promptToken(baseUrl)
+	default:
+		panic("missed case")
+	}
+}
+
+func promptToken(baseUrl string) (*auth.Token, error) {
+	fmt.Printf("You can generate a new API key by visiting %s/userprefs.cgi?tab=apikey.\n", baseUrl)
+	fmt.Println("Check 'Generate a new API key' and submit the changes.")
+	fmt.Println()
+
+	var login string
+
+	validator := func(name string, value string) (complaint string, err error) {
+		login, err = getLoginFromToken(baseUrl, auth.NewToken(target, value))
+		if err != nil {
+			return fmt.Sprintf("API key is invalid: %v", err), nil
+		}
+		return "", nil
+	}
+
+	rawToken, err := input.Prompt("Enter API key", "API key", input.Required, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	token := auth.NewToken(target, rawToken)
+	token.SetMetadata(auth.MetaKeyLogin, login)
+	token.SetMetadata(auth.MetaKeyBaseURL, baseUrl)
+
+	return token, nil
+}
+
+// splitProductURL return the URL of the Bugzilla instance and the product
+// of a page of this product, such as
+// https://bugzilla.example.com/buglist.cgi?product=Foo
+func splitProductURL(productUrl string) (baseUrl string, product string, err error) {
+	u, err := url.Parse(productUrl)
+	if err != nil || u.Host == "" {
+		return "", "", ErrBadProductURL
+	}
+
+	product = u.Query().Get("product")
+	if product == "" {
+		return "", "", ErrBadProductURL
+	}
+
+	// the instance can be served in a sub-path, the pages are at its root
+	p := u.Path
+	if strings.HasSuffix(p, ".cgi") {
+		p = p[:strings.LastIndex(p, "/")]
+	}
+
+	return fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, strings.TrimSuffix(p, "/")), product, nil
+}
+
+func validateProduct(baseUrl, product string, token *auth.Token) error {
+	client := buildClient(baseUrl, token)
+
+	_, err := client.GetProduct(context.Background(), product)
+	if err != nil {
+		return errors.Wrap(err, "wrong API key or non-existent product")
+	}
+
+	return nil
+}
+
+func getLoginFromToken(baseUrl string, token *auth.Token) (string, error) {
+	client := buildClient(baseUrl, token)
+
+	user, err := client.WhoAmI(context.Background())
+	if err != nil {
+		return "", err
+	}
+	if user.Name == "" {
+		return "", fmt.Errorf("bugzilla say login is empty")
+	}
+
+	return user.Name, nil
+}
@@ -0,0 +1,122 @@
+package bugzilla
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSplitProductURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		baseURL string
+		product string
+		err     bool
+	}{
+		{
+			name:    "bug list",
+			url:     "https://bugzilla.example.com/buglist.cgi?product=Foo&resolution=---",
+			baseURL: "https://bugzilla.example.com",
+			product: "Foo",
+		},
+		{
+			name:    "product description",
+			url:     "https://bugzilla.example.com/describecomponents.cgi?product=Foo%20Bar",
+			baseURL: "https://bugzilla.example.com",
+			product: "Foo Bar",
+		},
+		{
+			name:    "instance in a sub-path",
+			url:     "https://example.com/bugzilla/buglist.cgi?product=Foo",
+			baseURL: "https://example.com/bugzilla",
+			product: "Foo",
+		},
+		{
+			name: "missing product",
+			url:  "https://bugzilla.example.com/buglist.cgi?component=Foo",
+			err:  true,
+		},
+		{
+			name: "not an url",
+			url:  "Foo",
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseURL, product, err := splitProductURL(tt.url)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.baseURL, baseURL)
+			assert.Equal(t, tt.product, product)
+		})
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	server := newFakeBugzilla("Foo")
+	defer server.Close()
+	server.addUser("secret-key", User{ID: 1, Name: "alice@example.com", RealName: "Alice"})
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	b := &Bugzilla{}
+
+	// wrong API key
+	_, err = b.Configure(backend, core.BridgeParams{
+		URL:      server.productURL(),
+		TokenRaw: "wrong-key",
+	})
+	require.Error(t, err)
+
+	// unknown product
+	_, err = b.Configure(backend, core.BridgeParams{
+		BaseURL:  server.URL,
+		Project:  "Bar",
+		TokenRaw: "secret-key",
+	})
+	require.Error(t, err)
+
+	conf, err := b.Configure(backend, core.BridgeParams{
+		URL:      server.productURL(),
+		TokenRaw: "secret-key",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, core.Configuration{
+		core.ConfigKeyTarget:   target,
+		confKeyBugzillaBaseUrl: server.URL,
+		confKeyProduct:         "Foo",
+		confKeyDefaultLogin:    "alice@example.com",
+	}, conf)
+
+	// the API key is stored, tagged with the login
+	creds, err := auth.List(backend,
+		auth.WithTarget(target),
+		auth.WithMeta(auth.MetaKeyLogin, "alice@example.com"),
+		auth.WithMeta(auth.MetaKeyBaseURL, server.URL),
+	)
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+
+	// and the user identity as well
+	user, err := backend.GetUserIdentity()
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", user.ImmutableMetadata()[metaKeyBugzillaLogin])
+}
@@ -0,0 +1,331 @@
+package bugzilla
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+var (
+	ErrMissingIdentityToken = errors.New("missing identity token")
+)
+
+// bugzillaExporter implement the Exporter interface
+type bugzillaExporter struct {
+	conf core.Configuration
+
+	// cache identities clients
+	identityClient map[entity.Id]*client
+
+	// only describe the changes
+	dryRun bool
+}
+
+// Init .
+func (be *bugzillaExporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
+	be.conf = conf
+	be.dryRun = dryRun
+	be.identityClient = make(map[entity.Id]*client)
+
+	// preload all clients
+	err := be.cacheAllClient(repo)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (be *bugzillaExporter) cacheAllClient(repo *cache.RepoCache) error {
+	creds, err := auth.List(repo,
+		auth.WithTarget(target),
+		auth.WithKind(auth.KindToken),
+		auth.WithMeta(auth.MetaKeyBaseURL, be.conf[confKeyBugzillaBaseUrl]),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, cred := range creds {
+		login, ok := cred.GetMetadata(auth.MetaKeyLogin)
+		if !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "credential %s is not tagged with a Bugzilla login\n", cred.ID().Human())
+			continue
+		}
+
+		user, err := repo.ResolveIdentityImmutableMetadata(metaKeyBugzillaLogin, login)
+		if err == identity.ErrIdentityNotExist {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, ok := be.identityClient[user.Id()]; !ok {
+			be.identityClient[user.Id()] = buildClient(be.conf[confKeyBugzillaBaseUrl], cred.(*auth.Token))
+		}
+	}
+
+	return nil
+}
+
+// getIdentityClient return a Bugzilla API client configured with the API key of the given identity.
+func (be *bugzillaExporter) getIdentityClient(userId entity.Id) (*client, error) {
+	client, ok := be.identityClient[userId]
+	if ok {
+		return client, nil
+	}
+
+	return nil, ErrMissingIdentityToken
+}
+
+// ExportAll export all event made by the current user to Bugzilla
+func (be *bugzillaExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, ids []entity.Id, since time.Time) (<-chan core.ExportResult, error) {
+	out := make(chan core.ExportResult)
+
+	go func() {
+		defer close(out)
+
+		allIdentitiesIds := make([]entity.Id, 0, len(be.identityClient))
+		for id := range be.identityClient {
+			allIdentitiesIds = append(allIdentitiesIds, id)
+		}
+
+		for _, id := range ids {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				b, err := repo.ResolveBug(id)
+				if err != nil {
+					out <- core.NewExportError(err, id)
+					return
+				}
+
+				snapshot := b.Snapshot()
+
+				// ignore issues created before since date
+				// TODO: compare the Lamport time instead of using the unix time
+				if snapshot.CreateTime.Before(since) {
+					out <- core.NewExportNothing(b.Id(), "bug created before the since date")
+					continue
+				}
+
+				if snapshot.HasAnyActor(allIdentitiesIds...) {
+					// try to export the bug and it associated events
+					be.exportBug(ctx, b, out)
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// exportBug publish the events of a bug imported from Bugzilla
+func (be *bugzillaExporter) exportBug(ctx context.Context, b *cache.BugCache, out chan<- core.ExportResult) {
+	snapshot := b.Snapshot()
+
+	var bugUpdated bool
+
+	// filling a bug require to choose a component and a version of the
+	// product, so only the bugs imported from Bugzilla are updated
+	bugzillaID, ok := snapshot.GetCreateMetadata(metaKeyBugzillaId)
+	if !ok {
+		out <- core.NewExportNothing(b.Id(), "bug creation is not supported by the Bugzilla bridge")
+		return
+	}
+
+	bugzillaBaseUrl, ok := snapshot.GetCreateMetadata(metaKeyBugzillaBaseUrl)
+	if !ok || bugzillaBaseUrl != be.conf[confKeyBugzillaBaseUrl] {
+		out <- core.NewExportNothing(b.Id(), "skipping bug imported from another Bugzilla instance")
+		return
+	}
+
+	bugzillaProduct, ok := snapshot.GetCreateMetadata(metaKeyBugzillaProduct)
+	if !ok || bugzillaProduct != be.conf[confKeyProduct] {
+		out <- core.NewExportNothing(b.Id(), "skipping bug imported from another product")
+		return
+	}
+
+	bugID, err := strconv.ParseInt(bugzillaID, 10, 64)
+	if err != nil {
+		out <- core.NewExportError(fmt.Errorf("unexpected bugzilla id format: %s", bugzillaID), b.Id())
+		return
+	}
+
+	bugzillaURL := bugURL(be.conf[confKeyBugzillaBaseUrl], bugID)
+
+	for _, op := range snapshot.Operations[1:] {
+		// ignore SetMetadata operations
+		if _, ok := op.(*bug.SetMetadataOperation); ok {
+			continue
+		}
+
+		// ignore operations already existing in Bugzilla (due to import or export)
+		if _, ok := op.GetMetadata(metaKeyBugzillaUrl); ok {
+			continue
+		}
+
+		opAuthor := op.GetAuthor()
+		client, err := be.getIdentityClient(opAuthor.Id())
+		if err != nil {
+			continue
+		}
+
+		var metadata map[string]string
+
+		switch op := op.(type) {
+		case *bug.AddCommentOperation:
+			if be.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			commentID, err := client.AddComment(ctx, bugID, op.Message)
+			if err != nil {
+				err := errors.Wrap(err, "adding comment")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportComment(op.Id())
+
+			metadata = map[string]string{
+				metaKeyBugzillaCommentId: parseID(commentID),
+				metaKeyBugzillaUrl:       bugzillaURL,
+			}
+
+		case *bug.SetStatusOperation:
+			if be.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			status, resolution := statusReopened, ""
+			if op.Status == bug.ClosedStatus {
+				status, resolution = statusClosed, fromResolution(op.Resolution)
+			}
+
+			if err := client.UpdateStatus(ctx, bugID, status, resolution); err != nil {
+				err := errors.Wrap(err, "editing status")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportStatusChange(op.Id())
+
+			// the change is identified by its entry in the history, so it's
+			// not imported back
+			historyID, err := lastStatusHistoryID(ctx, client, bugID)
+			if err != nil {
+				err := errors.Wrap(err, "fetching history")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			metadata = map[string]string{
+				metaKeyBugzillaUrl: bugzillaURL,
+			}
+			if historyID != "" {
+				metadata[metaKeyBugzillaHistoryId] = historyID
+			}
+
+		case *bug.EditCommentOperation:
+			// not supported by Bugzilla, comments can't be edited
+			out <- core.NewExportNothing(op.Id(), "comment editions are not exported to Bugzilla")
+			continue
+
+		case *bug.SetTitleOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "title editions are not exported to Bugzilla")
+			continue
+
+		case *bug.LabelChangeOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "labels are not exported to Bugzilla")
+			continue
+
+		case *bug.SetAssigneesOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "assignees are not exported to Bugzilla")
+			continue
+
+		case *bug.SetMilestoneOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "milestones are not exported to Bugzilla")
+			continue
+
+		case *bug.SetLinksOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "links are not exported to Bugzilla")
+			continue
+
+		case *bug.AddReactionOperation, *bug.RemoveReactionOperation:
+			// not supported by Bugzilla
+			out <- core.NewExportNothing(op.Id(), "reactions are not exported to Bugzilla")
+			continue
+
+		case *bug.RedactCommentOperation:
+			// not supported by Bugzilla
+			out <- core.NewExportNothing(op.Id(), "comment redactions are not exported to Bugzilla")
+			continue
+
+		default:
+			panic("unhandled operation type case")
+		}
+
+		// mark operation as exported
+		if _, err := b.SetMetadata(op.Id(), metadata); err != nil {
+			err := errors.Wrap(err, "marking operation as exported")
+			out <- core.NewExportError(err, b.Id())
+			return
+		}
+
+		// commit at each operation export to avoid exporting same events multiple times
+		if err := b.CommitAsNeeded(); err != nil {
+			err := errors.Wrap(err, "bug commit")
+			out <- core.NewExportError(err, b.Id())
+			return
+		}
+
+		bugUpdated = true
+	}
+
+	if !bugUpdated {
+		out <- core.NewExportNothing(b.Id(), "nothing has been exported")
+	}
+}
+
+// lastStatusHistoryID return the history id of the latest status change of
+// a bug, or an empty string if there is none. Bugzilla doesn't return the
+// changes made when a bug is updated, so this is used right after.
+func lastStatusHistoryID(ctx context.Context, c *client, bugID int64) (string, error) {
+	history, err := c.GetHistory(ctx, bugID)
+	if err != nil {
+		return "", err
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		_, hasStatus := history[i].change(fieldStatus)
+		_, hasResolution := history[i].change(fieldResolution)
+		if hasStatus || hasResolution {
+			return fmt.Sprintf("%d-%s", i, fieldStatus), nil
+		}
+	}
+
+	return "", nil
+}
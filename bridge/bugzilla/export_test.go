@@ -0,0 +1,134 @@
+package bugzilla
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestPushPull(t *testing.T) {
+	server := newFakeBugzilla("Foo")
+	defer server.Close()
+
+	server.addUser("secret-key", User{ID: 1, Name: "alice@example.com", RealName: "Alice"})
+	server.addUser("", User{ID: 2, Name: "bob@example.com", RealName: "Bob"})
+
+	remoteBug := server.addBug("bob@example.com", "remote bug", "remote description")
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	// the identity of the configured user, owning the API key
+	author, err := backend.NewIdentityRaw("Alice", "", "alice@example.com", "", map[string]string{
+		metaKeyBugzillaLogin: "alice@example.com",
+	})
+	require.NoError(t, err)
+	err = backend.SetUserIdentity(author)
+	require.NoError(t, err)
+
+	token := auth.NewToken(target, "secret-key")
+	token.SetMetadata(auth.MetaKeyLogin, "alice@example.com")
+	token.SetMetadata(auth.MetaKeyBaseURL, server.URL)
+	err = auth.Store(repo, token)
+	require.NoError(t, err)
+	defer func() {
+		_ = auth.Remove(repo, token.ID())
+	}()
+
+	conf := core.Configuration{
+		core.ConfigKeyTarget:   target,
+		confKeyBugzillaBaseUrl: server.URL,
+		confKeyProduct:         "Foo",
+		confKeyDefaultLogin:    "alice@example.com",
+	}
+
+	ctx := context.Background()
+
+	importer := &bugzillaImporter{}
+	err = importer.Init(ctx, backend, conf, false)
+	require.NoError(t, err)
+
+	exporter := &bugzillaExporter{}
+	err = exporter.Init(ctx, backend, conf, false)
+	require.NoError(t, err)
+
+	pull := func() {
+		events, err := importer.ImportAll(ctx, backend, time.Time{})
+		require.NoError(t, err)
+		for result := range events {
+			require.NoError(t, result.Err)
+		}
+	}
+
+	push := func() []core.ExportResult {
+		events, err := exporter.ExportAll(ctx, backend, backend.AllBugsIds(), time.Time{})
+		require.NoError(t, err)
+		var results []core.ExportResult
+		for result := range events {
+			require.NoError(t, result.Err)
+			results = append(results, result)
+		}
+		return results
+	}
+
+	pull()
+
+	b, err := backend.ResolveBugCreateMetadata(metaKeyBugzillaId, "1")
+	require.NoError(t, err)
+
+	// a local bug, which can't be filled in Bugzilla
+	localBug, _, err := backend.NewBug("local bug", "local description")
+	require.NoError(t, err)
+
+	_, err = b.AddComment("local comment")
+	require.NoError(t, err)
+	_, err = b.CloseWithResolution(bug.ResolutionWontFix)
+	require.NoError(t, err)
+	_, _, err = b.ChangeLabels([]string{"local"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, b.CommitAsNeeded())
+
+	results := push()
+
+	var exported []core.ExportEvent
+	for _, result := range results {
+		if result.ID == localBug.Id() {
+			require.Equal(t, core.ExportEventNothing, result.Event)
+			continue
+		}
+		exported = append(exported, result.Event)
+	}
+	require.Contains(t, exported, core.ExportEventComment)
+	require.Contains(t, exported, core.ExportEventStatusChange)
+
+	// the changes are in Bugzilla
+	require.Len(t, remoteBug.comments, 2)
+	require.Equal(t, "local comment", remoteBug.comments[1].Text)
+	require.Equal(t, "alice@example.com", remoteBug.comments[1].Creator)
+	require.Equal(t, "RESOLVED", remoteBug.Status)
+	require.Equal(t, "WONTFIX", remoteBug.Resolution)
+
+	// and are not imported back
+	numOps := len(b.Snapshot().Operations)
+	pull()
+	require.Len(t, b.Snapshot().Operations, numOps)
+
+	// nor exported again
+	for _, result := range push() {
+		require.NotEqual(t, core.ExportEventComment, result.Event)
+		require.NotEqual(t, core.ExportEventStatusChange, result.Event)
+	}
+	require.Len(t, remoteBug.comments, 2)
+}
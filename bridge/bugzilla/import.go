@@ -0,0 +1,689 @@
+package bugzilla
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// history id of the label operation holding the keywords a bug was created
+// with, as they are not part of the history
+const historyIdInitialKeywords = "initial-keywords"
+
+// bugzillaImporter implement the Importer interface
+type bugzillaImporter struct {
+	conf core.Configuration
+
+	// default client
+	client *client
+
+	// send only channel
+	out chan<- core.ImportResult
+
+	// select the bugs to import
+	filter *core.ImportFilter
+
+	// only describe the changes, and the identities already described
+	dryRun           bool
+	dryRunIdentities map[string]bool
+}
+
+func (bi *bugzillaImporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
+	bi.conf = conf
+	bi.dryRun = dryRun
+	bi.dryRunIdentities = make(map[string]bool)
+
+	filter, err := core.NewImportFilter(conf)
+	if err != nil {
+		return err
+	}
+	bi.filter = filter
+
+	creds, err := auth.List(repo,
+		auth.WithTarget(target),
+		auth.WithKind(auth.KindToken),
+		auth.WithMeta(auth.MetaKeyBaseURL, conf[confKeyBugzillaBaseUrl]),
+		auth.WithMeta(auth.MetaKeyLogin, conf[confKeyDefaultLogin]),
+	)
+	if err != nil {
+		return err
+	}
+
+	// public bugs can be imported anonymously
+	if len(creds) == 0 {
+		bi.client = buildClient(conf[confKeyBugzillaBaseUrl], nil)
+		return nil
+	}
+
+	bi.client = buildClient(conf[confKeyBugzillaBaseUrl], creds[0].(*auth.Token))
+
+	return nil
+}
+
+// ImportAll iterate over all the bugs of the configured product, their
+// comments and their history, and ensure the creation of the missing bugs /
+// comments / status changes / label changes / title changes ...
+func (bi *bugzillaImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ImportResult, error) {
+	out := make(chan core.ImportResult)
+	bi.out = out
+
+	go func() {
+		defer close(bi.out)
+
+		for offset := 0; ; offset += pageSize {
+			bugs, err := bi.client.SearchBugs(ctx, bi.conf[confKeyProduct], since, offset)
+			if err != nil {
+				out <- core.NewImportError(err, "")
+				return
+			}
+
+			for _, bzBug := range bugs {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+
+				reason, err := bi.skipBug(repo, bzBug)
+				if err != nil {
+					out <- core.NewImportError(err, entity.Id(parseID(bzBug.ID)))
+					return
+				}
+				if reason != "" {
+					out <- core.NewImportNothing("", fmt.Sprintf("bug %s: %s", bugURL(bi.conf[confKeyBugzillaBaseUrl], bzBug.ID), reason))
+					continue
+				}
+
+				if err := bi.importBug(ctx, repo, bzBug); err != nil {
+					out <- core.NewImportError(err, entity.Id(parseID(bzBug.ID)))
+					return
+				}
+			}
+
+			if len(bugs) < pageSize {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// timelineItem is either a comment or an entry of the history of a bug
+type timelineItem struct {
+	when    time.Time
+	comment *Comment
+	index   int
+}
+
+func (bi *bugzillaImporter) importBug(ctx context.Context, repo *cache.RepoCache, bzBug Bug) error {
+	comments, err := bi.client.GetComments(ctx, bzBug.ID)
+	if err != nil {
+		return err
+	}
+
+	history, err := bi.client.GetHistory(ctx, bzBug.ID)
+	if err != nil {
+		return err
+	}
+
+	// create bug
+	b, err := bi.ensureBug(ctx, repo, bzBug, comments, history)
+	if err != nil {
+		return errors.Wrap(err, "bug creation")
+	}
+
+	if b == nil {
+		// a new bug in dry-run mode, its content would be imported with it
+		return nil
+	}
+
+	if err := bi.ensureInitialKeywords(ctx, repo, b, bzBug, history); err != nil {
+		return errors.Wrap(err, "keywords")
+	}
+
+	// comments and history are separated, they are merged back in order
+	var timeline []timelineItem
+	for i := range comments {
+		// the description is part of the bug creation
+		if comments[i].Count == 0 {
+			continue
+		}
+		timeline = append(timeline, timelineItem{when: comments[i].CreationTime, comment: &comments[i]})
+	}
+	for i, entry := range history {
+		timeline = append(timeline, timelineItem{when: entry.When, index: i})
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].when.Before(timeline[j].when)
+	})
+
+	state := initialState(bzBug, history)
+
+	for _, item := range timeline {
+		if item.comment != nil {
+			if err := bi.ensureComment(ctx, repo, b, bzBug, *item.comment); err != nil {
+				return errors.Wrap(err, "comment creation")
+			}
+			continue
+		}
+
+		if err := bi.ensureHistoryEntry(ctx, repo, b, bzBug, item.index, history[item.index], state); err != nil {
+			return errors.Wrap(err, "history entry")
+		}
+	}
+
+	if bi.dryRun {
+		return nil
+	}
+
+	if !b.NeedCommit() {
+		bi.out <- core.NewImportNothing(b.Id(), "no imported operation")
+	} else if err := b.Commit(); err != nil {
+		// commit bug state
+		return errors.Wrap(err, "bug commit")
+	}
+
+	return nil
+}
+
+// skipBug return why a bug is filtered out of the import, if it is. The bugs
+// already imported are always kept up to date.
+func (bi *bugzillaImporter) skipBug(repo *cache.RepoCache, bzBug Bug) (string, error) {
+	_, err := bi.resolveBug(repo, bzBug)
+	if err == nil {
+		return "", nil
+	}
+	if err != bug.ErrBugNotExist {
+		return "", err
+	}
+
+	return bi.filter.Skip(bzBug.Keywords, !isClosedStatus(bzBug.Status), bzBug.Creator), nil
+}
+
+// resolveBug return the bug imported from a Bugzilla bug
+func (bi *bugzillaImporter) resolveBug(repo *cache.RepoCache, bzBug Bug) (*cache.BugCache, error) {
+	return repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
+		return excerpt.CreateMetadata[metaKeyBugzillaId] == parseID(bzBug.ID) &&
+			excerpt.CreateMetadata[metaKeyBugzillaBaseUrl] == bi.conf[confKeyBugzillaBaseUrl] &&
+			excerpt.CreateMetadata[metaKeyBugzillaProduct] == bi.conf[confKeyProduct]
+	})
+}
+
+func (bi *bugzillaImporter) ensureBug(ctx context.Context, repo *cache.RepoCache, bzBug Bug, comments []Comment, history []HistoryEntry) (*cache.BugCache, error) {
+	// resolve bug
+	b, err := bi.resolveBug(repo, bzBug)
+	if err == nil {
+		return b, nil
+	}
+	if err != bug.ErrBugNotExist {
+		return nil, err
+	}
+
+	if bi.dryRun {
+		if _, err := bi.ensurePerson(ctx, repo, bzBug.Creator, bzBug.CreatorDetail); err != nil {
+			return nil, err
+		}
+		bi.out <- core.NewImportDryRun(core.ImportEventBug, "", fmt.Sprintf("create the bug \"%s\"", bzBug.Summary))
+		return nil, nil
+	}
+
+	// ensure bug author
+	author, err := bi.ensurePerson(ctx, repo, bzBug.Creator, bzBug.CreatorDetail)
+	if err != nil {
+		return nil, err
+	}
+
+	// if bug was never imported, the description is the first comment
+	var description string
+	var files []repository.Hash
+	for _, comment := range comments {
+		if comment.Count != 0 {
+			continue
+		}
+		description, err = text.Cleanup(comment.Text)
+		if err != nil {
+			return nil, err
+		}
+		files, err = bi.attachmentFiles(ctx, repo, comment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the bug hold the current summary, the original one is in the first
+	// summary change, if any
+	title := bzBug.Summary
+	for _, entry := range history {
+		if change, ok := entry.change(fieldSummary); ok {
+			title = change.Removed
+			break
+		}
+	}
+
+	// create bug
+	b, _, err = repo.NewBugRaw(
+		author,
+		bzBug.CreationTime.Unix(),
+		title,
+		description,
+		files,
+		map[string]string{
+			core.MetaKeyOrigin:     target,
+			metaKeyBugzillaId:      parseID(bzBug.ID),
+			metaKeyBugzillaUrl:     bugURL(bi.conf[confKeyBugzillaBaseUrl], bzBug.ID),
+			metaKeyBugzillaProduct: bi.conf[confKeyProduct],
+			metaKeyBugzillaBaseUrl: bi.conf[confKeyBugzillaBaseUrl],
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// importing a new bug
+	bi.out <- core.NewImportBug(b.Id())
+
+	return b, nil
+}
+
+// ensureInitialKeywords import the keywords the bug was created with, found
+// by reverting the keywords changes of the history
+func (bi *bugzillaImporter) ensureInitialKeywords(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, bzBug Bug, history []HistoryEntry) error {
+	_, err := b.ResolveOperationWithMetadata(metaKeyBugzillaHistoryId, historyIdInitialKeywords)
+	if err != cache.ErrNoMatchingOp {
+		return err
+	}
+
+	keywords := make(map[string]struct{})
+	for _, keyword := range bzBug.Keywords {
+		keywords[keyword] = struct{}{}
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		change, ok := history[i].change(fieldKeywords)
+		if !ok {
+			continue
+		}
+		for _, keyword := range splitKeywords(change.Added) {
+			delete(keywords, keyword)
+		}
+		for _, keyword := range splitKeywords(change.Removed) {
+			keywords[keyword] = struct{}{}
+		}
+	}
+
+	if len(keywords) == 0 {
+		return nil
+	}
+
+	added := make([]string, 0, len(keywords))
+	for keyword := range keywords {
+		added = append(added, keyword)
+	}
+	sort.Strings(added)
+
+	author, err := bi.ensurePerson(ctx, repo, bzBug.Creator, bzBug.CreatorDetail)
+	if err != nil {
+		return err
+	}
+
+	if bi.dryRun {
+		bi.out <- core.NewImportDryRun(core.ImportEventLabelChange, b.Id(),
+			fmt.Sprintf("add the keywords %s", strings.Join(added, ", ")))
+		return nil
+	}
+
+	op, err := b.ForceChangeLabelsRaw(
+		author,
+		bzBug.CreationTime.Unix(),
+		added,
+		nil,
+		map[string]string{
+			metaKeyBugzillaHistoryId: historyIdInitialKeywords,
+			metaKeyBugzillaUrl:       bugURL(bi.conf[confKeyBugzillaBaseUrl], bzBug.ID),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	bi.out <- core.NewImportLabelChange(op.Id())
+
+	return nil
+}
+
+func (bi *bugzillaImporter) ensureComment(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, bzBug Bug, comment Comment) error {
+	// private comments are only visible to some users, they are not
+	// published in the repository
+	if comment.IsPrivate {
+		return nil
+	}
+
+	_, err := b.ResolveOperationWithMetadata(metaKeyBugzillaCommentId, parseID(comment.ID))
+	if err != cache.ErrNoMatchingOp {
+		// comments can't be edited in Bugzilla, so nothing more to do
+		return err
+	}
+
+	cleanText, err := text.Cleanup(comment.Text)
+	if err != nil {
+		return err
+	}
+
+	if bi.dryRun {
+		if cleanText == "" && comment.AttachmentID == nil {
+			return nil
+		}
+		if _, err := bi.ensurePerson(ctx, repo, comment.Creator, nil); err != nil {
+			return err
+		}
+		bi.out <- core.NewImportDryRun(core.ImportEventComment, b.Id(), fmt.Sprintf("add a comment from %s", comment.Creator))
+		return nil
+	}
+
+	files, err := bi.attachmentFiles(ctx, repo, comment)
+	if err != nil {
+		return err
+	}
+
+	if cleanText == "" && len(files) == 0 {
+		return nil
+	}
+
+	// ensure comment author
+	author, err := bi.ensurePerson(ctx, repo, comment.Creator, nil)
+	if err != nil {
+		return err
+	}
+
+	op, err := b.AddCommentRaw(
+		author,
+		comment.CreationTime.Unix(),
+		cleanText,
+		files,
+		map[string]string{
+			metaKeyBugzillaCommentId: parseID(comment.ID),
+			metaKeyBugzillaUrl:       fmt.Sprintf("%s#c%d", bugURL(bi.conf[confKeyBugzillaBaseUrl], bzBug.ID), comment.Count),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	bi.out <- core.NewImportComment(op.Id())
+
+	return nil
+}
+
+// attachmentFiles store the attachment created with a comment, if any, as a
+// git blob
+func (bi *bugzillaImporter) attachmentFiles(ctx context.Context, repo *cache.RepoCache, comment Comment) ([]repository.Hash, error) {
+	if comment.AttachmentID == nil {
+		return nil, nil
+	}
+
+	attachment, err := bi.client.GetAttachment(ctx, *comment.AttachmentID)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.IsPrivate {
+		return nil, nil
+	}
+
+	hash, err := repo.StoreData(attachment.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return []repository.Hash{hash}, nil
+}
+
+// bugState is the status and resolution of a bug, tracked along its history
+type bugState struct {
+	status     string
+	resolution string
+}
+
+// initialState return the state a bug was created with, found in its first
+// changes if any
+func initialState(bzBug Bug, history []HistoryEntry) *bugState {
+	state := &bugState{status: bzBug.Status, resolution: bzBug.Resolution}
+
+	statusFound, resolutionFound := false, false
+	for _, entry := range history {
+		if change, ok := entry.change(fieldStatus); ok && !statusFound {
+			state.status = change.Removed
+			statusFound = true
+		}
+		if change, ok := entry.change(fieldResolution); ok && !resolutionFound {
+			state.resolution = change.Removed
+			resolutionFound = true
+		}
+	}
+
+	return state
+}
+
+// ensureHistoryEntry import the changes of an history entry, each change
+// identified by the index of the entry and the field
+func (bi *bugzillaImporter) ensureHistoryEntry(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, bzBug Bug, index int, entry HistoryEntry, state *bugState) error {
+	bugzillaURL := bugURL(bi.conf[confKeyBugzillaBaseUrl], bzBug.ID)
+
+	statusChange, hasStatus := entry.change(fieldStatus)
+	resolutionChange, hasResolution := entry.change(fieldResolution)
+
+	if hasStatus || hasResolution {
+		previous := *state
+		if hasStatus {
+			state.status = statusChange.Added
+		}
+		if hasResolution {
+			state.resolution = resolutionChange.Added
+		}
+
+		historyID := fmt.Sprintf("%d-%s", index, fieldStatus)
+		_, err := b.ResolveOperationWithMetadata(metaKeyBugzillaHistoryId, historyID)
+		if err != nil && err != cache.ErrNoMatchingOp {
+			return err
+		}
+
+		wasClosed, isClosed := isClosedStatus(previous.status), isClosedStatus(state.status)
+
+		// only the changes between open and closed, and of the resolution of
+		// a closed bug, are meaningful for git-bug
+		if err == cache.ErrNoMatchingOp && (wasClosed != isClosed || (isClosed && hasResolution)) {
+			author, err := bi.ensurePerson(ctx, repo, entry.Who, nil)
+			if err != nil {
+				return err
+			}
+
+			if bi.dryRun {
+				change := "reopen the bug"
+				if isClosed {
+					change = fmt.Sprintf("close the bug as %s", toResolution(state.resolution))
+				}
+				bi.out <- core.NewImportDryRun(core.ImportEventStatusChange, b.Id(), change)
+				return nil
+			}
+
+			metadata := map[string]string{
+				metaKeyBugzillaHistoryId: historyID,
+				metaKeyBugzillaUrl:       bugzillaURL,
+			}
+
+			var op *bug.SetStatusOperation
+			if isClosed {
+				op, err = b.ImportStatusRaw(author, entry.When.Unix(), bug.ClosedStatus, toResolution(state.resolution), metadata)
+			} else {
+				op, err = b.ImportStatusRaw(author, entry.When.Unix(), bug.OpenStatus, "", metadata)
+			}
+			if err != nil {
+				return err
+			}
+
+			bi.out <- core.NewImportStatusChange(op.Id())
+		}
+	}
+
+	if change, ok := entry.change(fieldSummary); ok {
+		historyID := fmt.Sprintf("%d-%s", index, fieldSummary)
+		_, err := b.ResolveOperationWithMetadata(metaKeyBugzillaHistoryId, historyID)
+		if err != nil && err != cache.ErrNoMatchingOp {
+			return err
+		}
+
+		if err == cache.ErrNoMatchingOp {
+			author, err := bi.ensurePerson(ctx, repo, entry.Who, nil)
+			if err != nil {
+				return err
+			}
+
+			if bi.dryRun {
+				bi.out <- core.NewImportDryRun(core.ImportEventTitleEdition, b.Id(),
+					fmt.Sprintf("change the title to \"%s\"", change.Added))
+				return nil
+			}
+
+			op, err := b.SetTitleRaw(
+				author,
+				entry.When.Unix(),
+				change.Added,
+				map[string]string{
+					metaKeyBugzillaHistoryId: historyID,
+					metaKeyBugzillaUrl:       bugzillaURL,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			bi.out <- core.NewImportTitleEdition(op.Id())
+		}
+	}
+
+	if change, ok := entry.change(fieldKeywords); ok {
+		historyID := fmt.Sprintf("%d-%s", index, fieldKeywords)
+		_, err := b.ResolveOperationWithMetadata(metaKeyBugzillaHistoryId, historyID)
+		if err != nil && err != cache.ErrNoMatchingOp {
+			return err
+		}
+
+		if err == cache.ErrNoMatchingOp {
+			author, err := bi.ensurePerson(ctx, repo, entry.Who, nil)
+			if err != nil {
+				return err
+			}
+
+			if bi.dryRun {
+				var changes []string
+				for _, keyword := range splitKeywords(change.Added) {
+					changes = append(changes, "+"+keyword)
+				}
+				for _, keyword := range splitKeywords(change.Removed) {
+					changes = append(changes, "-"+keyword)
+				}
+				bi.out <- core.NewImportDryRun(core.ImportEventLabelChange, b.Id(),
+					fmt.Sprintf("change the keywords: %s", strings.Join(changes, " ")))
+				return nil
+			}
+
+			op, err := b.ForceChangeLabelsRaw(
+				author,
+				entry.When.Unix(),
+				splitKeywords(change.Added),
+				splitKeywords(change.Removed),
+				map[string]string{
+					metaKeyBugzillaHistoryId: historyID,
+					metaKeyBugzillaUrl:       bugzillaURL,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			bi.out <- core.NewImportLabelChange(op.Id())
+		}
+	}
+
+	return nil
+}
+
+// ensurePerson return the identity of a Bugzilla login, creating it if
+// needed. The details of the user are fetched if not provided.
+func (bi *bugzillaImporter) ensurePerson(ctx context.Context, repo *cache.RepoCache, login string, details *User) (*cache.IdentityCache, error) {
+	// Look first in the cache
+	i, err := repo.ResolveIdentityImmutableMetadata(metaKeyBugzillaLogin, login)
+	if err == nil {
+		return i, nil
+	}
+	if entity.IsErrMultipleMatch(err) {
+		return nil, err
+	}
+
+	if bi.dryRun {
+		if !bi.dryRunIdentities[login] {
+			bi.dryRunIdentities[login] = true
+			bi.out <- core.NewImportDryRun(core.ImportEventIdentity, "", fmt.Sprintf("create the identity %s", login))
+		}
+		return nil, nil
+	}
+
+	if details == nil {
+		// the details can be hidden to anonymous users, the login is
+		// enough to go on
+		details, _ = bi.client.GetUser(ctx, login)
+	}
+
+	name := login
+	var email string
+	if details != nil {
+		if details.RealName != "" {
+			name = details.RealName
+		}
+		email = details.Email
+	}
+
+	i, err = repo.NewIdentityRaw(
+		name,
+		email,
+		login,
+		"",
+		map[string]string{
+			metaKeyBugzillaLogin: login,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bi.out <- core.NewImportIdentity(i.Id())
+	return i, nil
+}
+
+// splitKeywords split the keywords of a history change
+func splitKeywords(keywords string) []string {
+	var result []string
+	for _, keyword := range strings.Split(keywords, ",") {
+		keyword = strings.TrimSpace(keyword)
+		if keyword != "" {
+			result = append(result, keyword)
+		}
+	}
+	return result
+}
+
+func parseID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
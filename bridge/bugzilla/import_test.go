@@ -0,0 +1,119 @@
+package bugzilla
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestImport(t *testing.T) {
+	server := newFakeBugzilla("Foo")
+	defer server.Close()
+
+	server.addUser("", User{ID: 1, Name: "alice@example.com", RealName: "Alice", Email: "alice@example.com"})
+	server.addUser("", User{ID: 2, Name: "bob@example.com", RealName: "Bob", Email: "bob@example.com"})
+
+	bug1 := server.addBug("alice@example.com", "first title", "first description", "crash", "regression")
+	server.update(bug1, "bob@example.com", map[string]string{fieldSummary: "first bug"})
+	server.addComment(bug1, "bob@example.com", "here is a log", []byte("log data"), false)
+	server.addComment(bug1, "bob@example.com", "hidden comment", nil, true)
+	server.update(bug1, "bob@example.com", map[string]string{fieldKeywords: "crash, perf"})
+	server.update(bug1, "alice@example.com", map[string]string{fieldStatus: "RESOLVED", fieldResolution: "WONTFIX"})
+	server.update(bug1, "bob@example.com", map[string]string{fieldResolution: "FIXED"})
+	server.update(bug1, "bob@example.com", map[string]string{fieldStatus: "VERIFIED"})
+
+	bug2 := server.addBug("bob@example.com", "second bug", "second description")
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	conf := core.Configuration{
+		core.ConfigKeyTarget:   target,
+		confKeyBugzillaBaseUrl: server.URL,
+		confKeyProduct:         "Foo",
+		confKeyDefaultLogin:    "alice@example.com",
+	}
+
+	ctx := context.Background()
+
+	// no credential is needed for public bugs
+	importer := &bugzillaImporter{}
+	err = importer.Init(ctx, backend, conf, false)
+	require.NoError(t, err)
+
+	importSince := func(since time.Time) {
+		events, err := importer.ImportAll(ctx, backend, since)
+		require.NoError(t, err)
+		for result := range events {
+			require.NoError(t, result.Err)
+		}
+	}
+
+	importSince(time.Time{})
+
+	require.Len(t, backend.AllBugsIds(), 2)
+
+	b1, err := backend.ResolveBugCreateMetadata(metaKeyBugzillaId, "1")
+	require.NoError(t, err)
+
+	ops := b1.Snapshot().Operations
+	require.Equal(t, "first title", ops[0].(*bug.CreateOperation).Title)
+
+	snapshot := b1.Snapshot()
+	require.Equal(t, "first bug", snapshot.Title)
+	require.Equal(t, "Alice", snapshot.Author.Name())
+	require.Equal(t, "alice@example.com", snapshot.Author.Login())
+	require.Equal(t, bug.ClosedStatus, snapshot.Status)
+	require.Equal(t, bug.ResolutionFixed, snapshot.Resolution)
+	require.Equal(t, []bug.Label{"crash", "perf"}, snapshot.Labels)
+
+	// the private comment is not imported
+	require.Len(t, snapshot.Comments, 2)
+	require.Equal(t, "first description", snapshot.Comments[0].Message)
+	require.Equal(t, "here is a log", snapshot.Comments[1].Message)
+	require.Equal(t, "Bob", snapshot.Comments[1].Author.Name())
+
+	// the attachment is stored as a git blob
+	require.Len(t, snapshot.Comments[1].Files, 1)
+	data, err := repo.ReadData(snapshot.Comments[1].Files[0])
+	require.NoError(t, err)
+	require.Equal(t, []byte("log data"), data)
+
+	// create, initial keywords, title, comment, keywords, close, resolution
+	require.Len(t, ops, 7)
+
+	b2, err := backend.ResolveBugCreateMetadata(metaKeyBugzillaId, "2")
+	require.NoError(t, err)
+	require.Len(t, b2.Snapshot().Operations, 1)
+	require.Empty(t, b2.Snapshot().Labels)
+	require.Equal(t, bug.OpenStatus, b2.Snapshot().Status)
+
+	// the second bug is reopened
+	lastImport := server.clock
+	server.update(bug2, "alice@example.com", map[string]string{fieldStatus: "RESOLVED", fieldResolution: "INVALID"})
+	server.update(bug2, "bob@example.com", map[string]string{fieldStatus: "REOPENED", fieldResolution: ""})
+
+	importSince(lastImport)
+
+	snapshot = b2.Snapshot()
+	require.Len(t, snapshot.Operations, 3)
+	require.Equal(t, bug.OpenStatus, snapshot.Status)
+
+	// a full import doesn't duplicate anything
+	importSince(time.Time{})
+
+	require.Len(t, backend.AllBugsIds(), 2)
+	require.Len(t, b1.Snapshot().Operations, 7)
+	require.Len(t, b2.Snapshot().Operations, 3)
+}
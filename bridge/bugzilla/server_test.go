@@ -0,0 +1,367 @@
+package bugzilla
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fakeBugzilla is an in-memory stand-in of the Bugzilla REST API, serving a
+// single product
+type fakeBugzilla struct {
+	*httptest.Server
+
+	product string
+
+	mu sync.Mutex
+	// users by API key
+	keys        map[string]User
+	users       map[string]User
+	bugs        []*fakeBug
+	attachments map[int64]Attachment
+
+	nextCommentID    int64
+	nextAttachmentID int64
+	clock            time.Time
+}
+
+type fakeBug struct {
+	Bug
+	comments []Comment
+	history  []HistoryEntry
+}
+
+func newFakeBugzilla(product string) *fakeBugzilla {
+	f := &fakeBugzilla{
+		product:          product,
+		keys:             make(map[string]User),
+		users:            make(map[string]User),
+		attachments:      make(map[int64]Attachment),
+		nextCommentID:    1,
+		nextAttachmentID: 1,
+		clock:            time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.handle))
+	return f
+}
+
+// addUser register a user, authenticated by the given API key if not empty
+func (f *fakeBugzilla) addUser(apiKey string, user User) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if apiKey != "" {
+		f.keys[apiKey] = user
+	}
+	f.users[user.Name] = user
+}
+
+func (f *fakeBugzilla) productURL() string {
+	return fmt.Sprintf("%s/buglist.cgi?product=%s", f.URL, f.product)
+}
+
+func (f *fakeBugzilla) now() time.Time {
+	f.clock = f.clock.Add(time.Minute)
+	return f.clock
+}
+
+func (f *fakeBugzilla) addBug(creator, summary, description string, keywords ...string) *fakeBug {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	b := &fakeBug{Bug: Bug{
+		ID:           int64(len(f.bugs) + 1),
+		Summary:      summary,
+		Status:       "NEW",
+		Keywords:     keywords,
+		Creator:      creator,
+		CreationTime: f.now(),
+	}}
+	b.LastChangeTime = b.CreationTime
+	f.bugs = append(f.bugs, b)
+	f.addCommentLocked(b, creator, description, nil, false)
+	return b
+}
+
+func (f *fakeBugzilla) addComment(b *fakeBug, creator, text string, attachment []byte, private bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.addCommentLocked(b, creator, text, attachment, private)
+}
+
+func (f *fakeBugzilla) addCommentLocked(b *fakeBug, creator, text string, attachment []byte, private bool) *Comment {
+	comment := Comment{
+		ID:           f.nextCommentID,
+		BugID:        b.ID,
+		Count:        len(b.comments),
+		Text:         text,
+		Creator:      creator,
+		CreationTime: f.now(),
+		IsPrivate:    private,
+	}
+	f.nextCommentID++
+
+	if attachment != nil {
+		id := f.nextAttachmentID
+		f.nextAttachmentID++
+		f.attachments[id] = Attachment{
+			ID:          id,
+			BugID:       b.ID,
+			FileName:    "file.txt",
+			ContentType: "text/plain",
+			Data:        attachment,
+		}
+		comment.AttachmentID = &id
+	}
+
+	b.comments = append(b.comments, comment)
+	b.LastChangeTime = comment.CreationTime
+	return &b.comments[len(b.comments)-1]
+}
+
+// update change the given fields of a bug, recording the changes in its
+// history
+func (f *fakeBugzilla) update(b *fakeBug, who string, fields map[string]string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.updateLocked(b, who, fields)
+}
+
+func (f *fakeBugzilla) updateLocked(b *fakeBug, who string, fields map[string]string) {
+	entry := HistoryEntry{When: f.now(), Who: who}
+
+	// keep the changes in a stable order
+	for _, field := range []string{fieldSummary, fieldStatus, fieldResolution, fieldKeywords} {
+		added, ok := fields[field]
+		if !ok {
+			continue
+		}
+
+		var removed string
+		switch field {
+		case fieldSummary:
+			removed, b.Summary = b.Summary, added
+		case fieldStatus:
+			removed, b.Status = b.Status, added
+		case fieldResolution:
+			removed, b.Resolution = b.Resolution, added
+		case fieldKeywords:
+			// multi-valued fields record the values added and removed
+			keywords := splitKeywords(added)
+			removed = strings.Join(difference(b.Keywords, keywords), ", ")
+			added = strings.Join(difference(keywords, b.Keywords), ", ")
+			b.Keywords = keywords
+		}
+
+		if removed != added {
+			entry.Changes = append(entry.Changes, Change{FieldName: field, Removed: removed, Added: added})
+		}
+	}
+
+	b.history = append(b.history, entry)
+	b.LastChangeTime = entry.When
+}
+
+// difference return the values of a missing in b
+func difference(a, b []string) []string {
+	var result []string
+	for _, x := range a {
+		found := false
+		for _, y := range b {
+			if x == y {
+				found = true
+			}
+		}
+		if !found {
+			result = append(result, x)
+		}
+	}
+	return result
+}
+
+func (f *fakeBugzilla) bug(id string) *fakeBug {
+	for _, b := range f.bugs {
+		if strconv.FormatInt(b.ID, 10) == id {
+			return b
+		}
+	}
+	return nil
+}
+
+func (f *fakeBugzilla) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// anonymous access is allowed for reading
+	apiKey := r.Header.Get("X-BUGZILLA-API-KEY")
+	user, authenticated := f.keys[apiKey]
+	if apiKey != "" && !authenticated {
+		writeError(w, http.StatusBadRequest, "The API key you specified is invalid.")
+		return
+	}
+	if r.Method != http.MethodGet && !authenticated {
+		writeError(w, http.StatusUnauthorized, "You must log in before using this part of Bugzilla.")
+		return
+	}
+
+	query := r.URL.Query()
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/rest"), "/"), "/")
+
+	switch {
+	// /whoami
+	case len(parts) == 1 && parts[0] == "whoami":
+		if !authenticated {
+			writeError(w, http.StatusUnauthorized, "You must log in before using this part of Bugzilla.")
+			return
+		}
+		writeJSON(w, http.StatusOK, user)
+
+	// /user
+	case len(parts) == 1 && parts[0] == "user":
+		users := []User{}
+		for _, name := range query["names"] {
+			if u, ok := f.users[name]; ok {
+				users = append(users, u)
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"users": users})
+
+	// /product
+	case len(parts) == 1 && parts[0] == "product":
+		products := []Product{}
+		for _, name := range query["names"] {
+			if name == f.product {
+				products = append(products, Product{ID: 1, Name: f.product})
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"products": products})
+
+	// /bug
+	case len(parts) == 1 && parts[0] == "bug" && r.Method == http.MethodGet:
+		var since time.Time
+		if s := query.Get("last_change_time"); s != "" {
+			since, _ = time.Parse(time.RFC3339, s)
+		}
+
+		bugs := []Bug{}
+		if query.Get("product") == f.product {
+			for _, b := range f.bugs {
+				if !b.LastChangeTime.Before(since) {
+					bugs = append(bugs, b.Bug)
+				}
+			}
+		}
+
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		if offset > len(bugs) {
+			offset = len(bugs)
+		}
+		if limit > 0 && offset+limit < len(bugs) {
+			bugs = bugs[:offset+limit]
+		}
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{"bugs": bugs[offset:]})
+
+	// /bug/attachment/{id}
+	case len(parts) == 3 && parts[0] == "bug" && parts[1] == "attachment":
+		id, _ := strconv.ParseInt(parts[2], 10, 64)
+		attachment, ok := f.attachments[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "attachment not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"attachments": map[string]Attachment{parts[2]: attachment},
+		})
+
+	// /bug/{id}
+	case len(parts) == 2 && parts[0] == "bug" && r.Method == http.MethodPut:
+		b := f.bug(parts[1])
+		if b == nil {
+			writeError(w, http.StatusNotFound, "bug not found")
+			return
+		}
+		var in struct {
+			Status     string `json:"status"`
+			Resolution string `json:"resolution"`
+		}
+		if !readJSON(w, r, &in) {
+			return
+		}
+		fields := map[string]string{fieldStatus: in.Status}
+		if isClosedStatus(in.Status) {
+			fields[fieldResolution] = in.Resolution
+		} else {
+			fields[fieldResolution] = ""
+		}
+		f.updateLocked(b, user.Name, fields)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"bugs": []interface{}{}})
+
+	// /bug/{id}/comment
+	case len(parts) == 3 && parts[0] == "bug" && parts[2] == "comment" && r.Method == http.MethodGet:
+		b := f.bug(parts[1])
+		if b == nil {
+			writeError(w, http.StatusNotFound, "bug not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"bugs": map[string]interface{}{
+				parts[1]: map[string]interface{}{"comments": b.comments},
+			},
+		})
+
+	case len(parts) == 3 && parts[0] == "bug" && parts[2] == "comment" && r.Method == http.MethodPost:
+		b := f.bug(parts[1])
+		if b == nil {
+			writeError(w, http.StatusNotFound, "bug not found")
+			return
+		}
+		var in struct {
+			Comment string `json:"comment"`
+		}
+		if !readJSON(w, r, &in) {
+			return
+		}
+		comment := f.addCommentLocked(b, user.Name, in.Comment, nil, false)
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"id": comment.ID})
+
+	// /bug/{id}/history
+	case len(parts) == 3 && parts[0] == "bug" && parts[2] == "history":
+		b := f.bug(parts[1])
+		if b == nil {
+			writeError(w, http.StatusNotFound, "bug not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"bugs": []interface{}{
+				map[string]interface{}{"id": b.ID, "history": b.history},
+			},
+		})
+
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, apiError{Error: true, Message: message, Code: status})
+}
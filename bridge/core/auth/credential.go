@@ -31,6 +31,7 @@ const (
 	KindToken         CredentialKind = "token"
 	KindLogin         CredentialKind = "login"
 	KindLoginPassword CredentialKind = "login-password"
+	KindOAuth1        CredentialKind = "oauth1"
 )
 
 var ErrCredentialNotExist = errors.New("credential doesn't exist")
@@ -131,6 +132,8 @@ func decode(item repository.Item) (Credential, error) {
 		cred, err = NewLoginFromConfig(data)
 	case KindLoginPassword:
 		cred, err = NewLoginPasswordFromConfig(data)
+	case KindOAuth1:
+		cred, err = NewOAuth1FromConfig(data)
 	default:
 		return nil, fmt.Errorf("unknown credential type \"%s\"", data[keyringKeyKind])
 	}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+const (
+	keyringKeyOAuth1ConsumerKey = "consumer-key"
+	keyringKeyOAuth1Token       = "token"
+	keyringKeyOAuth1TokenSecret = "token-secret"
+)
+
+var _ Credential = &OAuth1{}
+
+// OAuth1 holds an OAuth 1.0 access token, as well as the consumer key it
+// has been granted to
+type OAuth1 struct {
+	*credentialBase
+	ConsumerKey string
+	Token       string
+	TokenSecret string
+}
+
+func NewOAuth1(target, consumerKey, token, tokenSecret string) *OAuth1 {
+	return &OAuth1{
+		credentialBase: newCredentialBase(target),
+		ConsumerKey:    consumerKey,
+		Token:          token,
+		TokenSecret:    tokenSecret,
+	}
+}
+
+func NewOAuth1FromConfig(conf map[string]string) (*OAuth1, error) {
+	base, err := newCredentialBaseFromData(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OAuth1{
+		credentialBase: base,
+		ConsumerKey:    conf[keyringKeyOAuth1ConsumerKey],
+		Token:          conf[keyringKeyOAuth1Token],
+		TokenSecret:    conf[keyringKeyOAuth1TokenSecret],
+	}, nil
+}
+
+func (o *OAuth1) ID() entity.Id {
+	h := sha256.New()
+	_, _ = h.Write(o.salt)
+	_, _ = h.Write([]byte(o.target))
+	_, _ = h.Write([]byte(o.ConsumerKey))
+	_, _ = h.Write([]byte(o.Token))
+	_, _ = h.Write([]byte(o.TokenSecret))
+	return entity.Id(fmt.Sprintf("%x", h.Sum(nil)))
+}
+
+func (o *OAuth1) Kind() CredentialKind {
+	return KindOAuth1
+}
+
+// Validate ensure the OAuth1 important fields are valid
+func (o *OAuth1) Validate() error {
+	err := o.credentialBase.validate()
+	if err != nil {
+		return err
+	}
+	if o.ConsumerKey == "" {
+		return fmt.Errorf("missing consumer key")
+	}
+	if o.Token == "" {
+		return fmt.Errorf("missing token")
+	}
+	if o.TokenSecret == "" {
+		return fmt.Errorf("missing token secret")
+	}
+	return nil
+}
+
+func (o *OAuth1) toConfig() map[string]string {
+	return map[string]string{
+		keyringKeyOAuth1ConsumerKey: o.ConsumerKey,
+		keyringKeyOAuth1Token:       o.Token,
+		keyringKeyOAuth1TokenSecret: o.TokenSecret,
+	}
+}
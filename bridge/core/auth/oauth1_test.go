@@ -0,0 +1,15 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOAuth1Serial(t *testing.T) {
+	original := NewOAuth1("launchpad-preview", "git-bug", "token", "secret")
+	loaded := testCredentialSerial(t, original)
+	assert.Equal(t, original.ConsumerKey, loaded.(*OAuth1).ConsumerKey)
+	assert.Equal(t, original.Token, loaded.(*OAuth1).Token)
+	assert.Equal(t, original.TokenSecret, loaded.(*OAuth1).TokenSecret)
+}
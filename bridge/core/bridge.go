@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/repository"
 )
 
@@ -42,6 +43,7 @@ type Bridge struct {
 	conf           Configuration
 	initImportDone bool
 	initExportDone bool
+	dryRun         bool
 }
 
 // Register will register a new BridgeImpl
@@ -123,6 +125,11 @@ func LoadBridge(repo *cache.RepoCache, name string) (*Bridge, error) {
 		return nil, errors.Wrap(err, "invalid configuration")
 	}
 
+	err = validateFilters(conf)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid configuration")
+	}
+
 	// will avoid reloading configuration before an export or import call
 	bridge.conf = conf
 	return bridge, nil
@@ -210,11 +217,20 @@ func (b *Bridge) Configure(params BridgeParams) error {
 		return err
 	}
 
+	if params.WebhookSecret != "" {
+		conf[ConfigKeyWebhookSecret] = params.WebhookSecret
+	}
+
 	err = b.impl.ValidateConfig(conf)
 	if err != nil {
 		return fmt.Errorf("invalid configuration: %v", err)
 	}
 
+	err = validateFilters(conf)
+	if err != nil {
+		return fmt.Errorf("invalid configuration: %v", err)
+	}
+
 	b.conf = conf
 	return b.storeConfig(conf)
 }
@@ -300,7 +316,7 @@ func (b *Bridge) ensureImportInit(ctx context.Context) error {
 
 	importer := b.getImporter()
 	if importer != nil {
-		err := importer.Init(ctx, b.repo, b.conf)
+		err := importer.Init(ctx, b.repo, b.conf, b.dryRun)
 		if err != nil {
 			return err
 		}
@@ -317,7 +333,7 @@ func (b *Bridge) ensureExportInit(ctx context.Context) error {
 
 	exporter := b.getExporter()
 	if exporter != nil {
-		err := exporter.Init(ctx, b.repo, b.conf)
+		err := exporter.Init(ctx, b.repo, b.conf, b.dryRun)
 		if err != nil {
 			return err
 		}
@@ -327,6 +343,13 @@ func (b *Bridge) ensureExportInit(ctx context.Context) error {
 	return nil
 }
 
+// SetDryRun enable the dry-run mode, where the import and export only
+// describe the changes they would make, locally or on the remote bug-tracker,
+// without writing anything. It must be set before any import or export.
+func (b *Bridge) SetDryRun(dryRun bool) {
+	b.dryRun = dryRun
+}
+
 func (b *Bridge) ImportAllSince(ctx context.Context, since time.Time) (<-chan ImportResult, error) {
 	// 5 seconds before the actual start just to be sure.
 	importStartTime := time.Now().Add(-5 * time.Second)
@@ -364,8 +387,9 @@ func (b *Bridge) ImportAllSince(ctx context.Context, since time.Time) (<-chan Im
 			out <- event
 		}
 
-		// store the last import time ONLY if no error happened
-		if noError {
+		// store the last import time ONLY if no error happened, and if the
+		// changes were actually imported
+		if noError && !b.dryRun {
 			key := fmt.Sprintf("git-bug.bridge.%s.lastImportTime", b.Name)
 			err = b.repo.LocalConfig().StoreTimestamp(key, importStartTime)
 		}
@@ -400,5 +424,38 @@ func (b *Bridge) ExportAll(ctx context.Context, since time.Time) (<-chan ExportR
 		return nil, err
 	}
 
-	return exporter.ExportAll(ctx, b.repo, since)
+	filter, err := NewExportFilter(b.repo, b.conf)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []entity.Id
+	var skipped []ExportResult
+	for _, id := range b.repo.AllBugsIds() {
+		if reason := filter.Skip(id); reason != "" {
+			skipped = append(skipped, NewExportNothing(id, reason))
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	events, err := exporter.ExportAll(ctx, b.repo, ids, since)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan ExportResult)
+
+	go func() {
+		defer close(out)
+
+		for _, result := range skipped {
+			out <- result
+		}
+		for event := range events {
+			out <- event
+		}
+	}()
+
+	return out, nil
 }
@@ -2,7 +2,9 @@ package core
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/entity"
 )
 
@@ -17,12 +19,18 @@ const (
 	ExportEventComment
 	// Comment has been edited on the remote tracker
 	ExportEventCommentEdition
+	// Comment has been removed or blanked on the remote tracker
+	ExportEventCommentRedaction
 	// Bug's status has been changed on on the remote tracker
 	ExportEventStatusChange
 	// Bug's title has been changed on the remote tracker
 	ExportEventTitleEdition
 	// Bug's labels have been changed on the remote tracker
 	ExportEventLabelChange
+	// Bug's assignees have been changed on the remote tracker
+	ExportEventAssigneeChange
+	// Bug's milestone has been changed on the remote tracker
+	ExportEventMilestoneChange
 
 	// Nothing changed on the bug
 	ExportEventNothing
@@ -43,9 +51,18 @@ type ExportResult struct {
 	Event  ExportEvent
 	ID     entity.Id
 	Reason string
+	// the change was not made, as the exporter is in dry-run mode
+	DryRun bool
 }
 
 func (er ExportResult) String() string {
+	if er.DryRun {
+		if er.ID != "" {
+			return fmt.Sprintf("dry-run at %s: would %s", er.ID, er.Reason)
+		}
+		return fmt.Sprintf("dry-run: would %s", er.Reason)
+	}
+
 	switch er.Event {
 	case ExportEventBug:
 		return fmt.Sprintf("new issue: %s", er.ID)
@@ -53,12 +70,18 @@ func (er ExportResult) String() string {
 		return fmt.Sprintf("new comment: %s", er.ID)
 	case ExportEventCommentEdition:
 		return fmt.Sprintf("updated comment: %s", er.ID)
+	case ExportEventCommentRedaction:
+		return fmt.Sprintf("redacted comment: %s", er.ID)
 	case ExportEventStatusChange:
 		return fmt.Sprintf("changed status: %s", er.ID)
 	case ExportEventTitleEdition:
 		return fmt.Sprintf("changed title: %s", er.ID)
 	case ExportEventLabelChange:
 		return fmt.Sprintf("changed label: %s", er.ID)
+	case ExportEventAssigneeChange:
+		return fmt.Sprintf("changed assignees: %s", er.ID)
+	case ExportEventMilestoneChange:
+		return fmt.Sprintf("changed milestone: %s", er.ID)
 	case ExportEventNothing:
 		if er.ID != "" {
 			return fmt.Sprintf("no actions taken for event %s: %s", er.ID, er.Reason)
@@ -125,6 +148,13 @@ func NewExportCommentEdition(id entity.Id) ExportResult {
 	}
 }
 
+func NewExportCommentRedaction(id entity.Id) ExportResult {
+	return ExportResult{
+		ID:    id,
+		Event: ExportEventCommentRedaction,
+	}
+}
+
 func NewExportStatusChange(id entity.Id) ExportResult {
 	return ExportResult{
 		ID:    id,
@@ -139,9 +169,67 @@ func NewExportLabelChange(id entity.Id) ExportResult {
 	}
 }
 
+func NewExportAssigneeChange(id entity.Id) ExportResult {
+	return ExportResult{
+		ID:    id,
+		Event: ExportEventAssigneeChange,
+	}
+}
+
+func NewExportMilestoneChange(id entity.Id) ExportResult {
+	return ExportResult{
+		ID:    id,
+		Event: ExportEventMilestoneChange,
+	}
+}
+
 func NewExportTitleEdition(id entity.Id) ExportResult {
 	return ExportResult{
 		ID:    id,
 		Event: ExportEventTitleEdition,
 	}
 }
+
+// NewExportDryRun describe a change that an exporter in dry-run mode would
+// make on the remote bug-tracker, such as "create the issue \"title\"". The
+// id is the one of the bug or operation exported.
+func NewExportDryRun(event ExportEvent, id entity.Id, change string) ExportResult {
+	return ExportResult{
+		ID:     id,
+		Reason: change,
+		Event:  event,
+		DryRun: true,
+	}
+}
+
+// NewExportDryRunOperation describe the export of an operation on an existing
+// issue, by an exporter in dry-run mode
+func NewExportDryRunOperation(op bug.Operation) ExportResult {
+	switch op := op.(type) {
+	case *bug.AddCommentOperation:
+		return NewExportDryRun(ExportEventComment, op.Id(), "add a comment")
+	case *bug.EditCommentOperation:
+		return NewExportDryRun(ExportEventCommentEdition, op.Id(), "edit a comment")
+	case *bug.RedactCommentOperation:
+		return NewExportDryRun(ExportEventCommentRedaction, op.Id(), "redact a comment")
+	case *bug.SetStatusOperation:
+		return NewExportDryRun(ExportEventStatusChange, op.Id(), fmt.Sprintf("change the status to %s", op.Status))
+	case *bug.SetTitleOperation:
+		return NewExportDryRun(ExportEventTitleEdition, op.Id(), fmt.Sprintf("change the title to \"%s\"", op.Title))
+	case *bug.LabelChangeOperation:
+		var changes []string
+		for _, label := range op.Added {
+			changes = append(changes, "+"+label.String())
+		}
+		for _, label := range op.Removed {
+			changes = append(changes, "-"+label.String())
+		}
+		return NewExportDryRun(ExportEventLabelChange, op.Id(), fmt.Sprintf("change the labels: %s", strings.Join(changes, " ")))
+	case *bug.SetAssigneesOperation:
+		return NewExportDryRun(ExportEventAssigneeChange, op.Id(), "change the assignees")
+	case *bug.SetMilestoneOperation:
+		return NewExportDryRun(ExportEventMilestoneChange, op.Id(), "change the milestone")
+	default:
+		return NewExportDryRun(ExportEventNothing, op.Id(), "export the operation")
+	}
+}
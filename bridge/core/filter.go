@@ -0,0 +1,164 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
+)
+
+const (
+	// ConfigKeyExportQuery is the configuration key holding a query selecting
+	// the bugs to export, such as "label:public"
+	ConfigKeyExportQuery = "export-query"
+
+	// ConfigKeyImportLabels is the configuration key holding a comma separated
+	// list of remote labels. Only the remote issues with one of them are
+	// imported.
+	ConfigKeyImportLabels = "import-labels"
+
+	// ConfigKeyImportState is the configuration key holding the state of the
+	// remote issues to import, either "open" or "closed"
+	ConfigKeyImportState = "import-state"
+
+	// ConfigKeyImportAuthors is the configuration key holding a comma separated
+	// list of remote logins. Only the remote issues opened by one of them are
+	// imported.
+	ConfigKeyImportAuthors = "import-authors"
+)
+
+// validateFilters check the filters of a bridge configuration
+func validateFilters(conf Configuration) error {
+	if _, err := parseExportQuery(conf); err != nil {
+		return err
+	}
+	_, err := NewImportFilter(conf)
+	return err
+}
+
+func parseExportQuery(conf Configuration) (*query.Query, error) {
+	raw, ok := conf[ConfigKeyExportQuery]
+	if !ok || strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	q, err := query.Parse(raw)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", ConfigKeyExportQuery)
+	}
+
+	return q, nil
+}
+
+// ExportFilter select the bugs to export with the export query of a bridge.
+// The query is matched when the filter is created, so a new one is needed
+// for each export.
+type ExportFilter struct {
+	// the bugs matching the query, nil if all the bugs are exported
+	matching map[entity.Id]bool
+}
+
+// NewExportFilter return the filter of the bugs to export. Without export
+// query, all the bugs are exported.
+func NewExportFilter(repo *cache.RepoCache, conf Configuration) (*ExportFilter, error) {
+	q, err := parseExportQuery(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &ExportFilter{}
+	if q == nil {
+		return filter, nil
+	}
+
+	filter.matching = make(map[entity.Id]bool)
+	for _, id := range repo.QueryBugs(q) {
+		filter.matching[id] = true
+	}
+
+	return filter, nil
+}
+
+// Skip return why a bug must not be exported, or an empty string if it must
+// be
+func (f *ExportFilter) Skip(id entity.Id) string {
+	if f.matching != nil && !f.matching[id] {
+		return "bug not matching the export query"
+	}
+	return ""
+}
+
+// ImportFilter select the remote issues to import by their labels, state or
+// author. Labels and logins are compared case-insensitively. The filter only
+// applies to the issues not imported yet, the importers keep the others up
+// to date.
+type ImportFilter struct {
+	labels  map[string]bool
+	state   string
+	authors map[string]bool
+}
+
+// NewImportFilter return the filter of the remote issues to import. Without
+// import filter, all the issues are imported.
+func NewImportFilter(conf Configuration) (*ImportFilter, error) {
+	filter := &ImportFilter{
+		labels:  splitFilterList(conf[ConfigKeyImportLabels]),
+		state:   strings.ToLower(strings.TrimSpace(conf[ConfigKeyImportState])),
+		authors: splitFilterList(conf[ConfigKeyImportAuthors]),
+	}
+
+	switch filter.state {
+	case "", "open", "closed":
+	default:
+		return nil, fmt.Errorf("invalid %s: %s, expected open or closed", ConfigKeyImportState, filter.state)
+	}
+
+	return filter, nil
+}
+
+// Skip return why a remote issue must not be imported, or an empty string if
+// it must be
+func (f *ImportFilter) Skip(labels []string, open bool, author string) string {
+	if f.labels != nil {
+		found := false
+		for _, label := range labels {
+			if f.labels[strings.ToLower(label)] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "no label matching the import filter"
+		}
+	}
+
+	if f.state == "open" && !open || f.state == "closed" && open {
+		return "state not matching the import filter"
+	}
+
+	if f.authors != nil && !f.authors[strings.ToLower(author)] {
+		return "author not matching the import filter"
+	}
+
+	return ""
+}
+
+// splitFilterList parse a comma separated list of a filter, nil if empty
+func splitFilterList(raw string) map[string]bool {
+	var result map[string]bool
+	for _, value := range strings.Split(raw, ",") {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]bool)
+		}
+		result[value] = true
+	}
+	return result
+}
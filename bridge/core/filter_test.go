@@ -0,0 +1,95 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestExportFilter(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	author, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(author))
+
+	public, _, err := backend.NewBug("public bug", "")
+	require.NoError(t, err)
+	_, _, err = public.ChangeLabels([]string{"public"}, nil)
+	require.NoError(t, err)
+	require.NoError(t, public.CommitAsNeeded())
+
+	private, _, err := backend.NewBug("private bug", "")
+	require.NoError(t, err)
+
+	// without query, everything is exported
+	filter, err := NewExportFilter(backend, Configuration{})
+	require.NoError(t, err)
+	require.Empty(t, filter.Skip(public.Id()))
+	require.Empty(t, filter.Skip(private.Id()))
+
+	filter, err = NewExportFilter(backend, Configuration{ConfigKeyExportQuery: "label:public"})
+	require.NoError(t, err)
+	require.Empty(t, filter.Skip(public.Id()))
+	require.NotEmpty(t, filter.Skip(private.Id()))
+
+	_, err = NewExportFilter(backend, Configuration{ConfigKeyExportQuery: "unknown:filter"})
+	require.Error(t, err)
+
+	// the bridge give to its exporter only the bugs matching the query
+	Register(&fakeBridge{})
+
+	b, err := NewBridge(backend, "fake", "filtered")
+	require.NoError(t, err)
+	require.NoError(t, b.Configure(BridgeParams{}))
+	require.NoError(t, backend.LocalConfig().StoreString("git-bug.bridge.filtered."+ConfigKeyExportQuery, "label:public"))
+
+	b, err = LoadBridge(backend, "filtered")
+	require.NoError(t, err)
+
+	events, err := b.ExportAll(context.Background(), time.Time{})
+	require.NoError(t, err)
+
+	var skipped []ExportResult
+	for event := range events {
+		require.Equal(t, ExportEventNothing, event.Event)
+		skipped = append(skipped, event)
+	}
+	require.Len(t, skipped, 1)
+	require.Equal(t, private.Id(), skipped[0].ID)
+	require.Equal(t, []entity.Id{public.Id()}, fakeExportedIds)
+}
+
+func TestImportFilter(t *testing.T) {
+	filter, err := NewImportFilter(Configuration{})
+	require.NoError(t, err)
+	require.Empty(t, filter.Skip(nil, false, "anyone"))
+
+	filter, err = NewImportFilter(Configuration{
+		ConfigKeyImportLabels:  "Public, help wanted",
+		ConfigKeyImportState:   "open",
+		ConfigKeyImportAuthors: "alice,bob",
+	})
+	require.NoError(t, err)
+
+	require.Empty(t, filter.Skip([]string{"bug", "public"}, true, "Alice"))
+	require.Empty(t, filter.Skip([]string{"help wanted"}, true, "bob"))
+	require.NotEmpty(t, filter.Skip([]string{"bug"}, true, "alice"))
+	require.NotEmpty(t, filter.Skip(nil, true, "alice"))
+	require.NotEmpty(t, filter.Skip([]string{"public"}, false, "alice"))
+	require.NotEmpty(t, filter.Skip([]string{"public"}, true, "carol"))
+
+	_, err = NewImportFilter(Configuration{ConfigKeyImportState: "merged"})
+	require.Error(t, err)
+}
@@ -24,12 +24,21 @@ const (
 	ImportEventTitleEdition
 	// Bug's labels changed
 	ImportEventLabelChange
+	// Bug's assignees changed
+	ImportEventAssigneeChange
+	// Bug's milestone changed
+	ImportEventMilestoneChange
+	// Reaction added or removed on a comment
+	ImportEventReaction
 	// Nothing happened on a Bug
 	ImportEventNothing
 
 	// Identity has been created
 	ImportEventIdentity
 
+	// Milestone has been created
+	ImportEventMilestone
+
 	// Something wrong happened during import that is worth notifying to the user
 	// but not severe enough to consider the import a failure.
 	ImportEventWarning
@@ -46,9 +55,18 @@ type ImportResult struct {
 	Event  ImportEvent
 	ID     entity.Id
 	Reason string
+	// the change was not made, as the importer is in dry-run mode
+	DryRun bool
 }
 
 func (er ImportResult) String() string {
+	if er.DryRun {
+		if er.ID != "" {
+			return fmt.Sprintf("dry-run at id %s: would %s", er.ID, er.Reason)
+		}
+		return fmt.Sprintf("dry-run: would %s", er.Reason)
+	}
+
 	switch er.Event {
 	case ImportEventBug:
 		return fmt.Sprintf("new issue: %s", er.ID)
@@ -62,8 +80,16 @@ func (er ImportResult) String() string {
 		return fmt.Sprintf("changed title: %s", er.ID)
 	case ImportEventLabelChange:
 		return fmt.Sprintf("changed label: %s", er.ID)
+	case ImportEventAssigneeChange:
+		return fmt.Sprintf("changed assignees: %s", er.ID)
+	case ImportEventMilestoneChange:
+		return fmt.Sprintf("changed milestone: %s", er.ID)
+	case ImportEventReaction:
+		return fmt.Sprintf("reaction: %s", er.ID)
 	case ImportEventIdentity:
 		return fmt.Sprintf("new identity: %s", er.ID)
+	case ImportEventMilestone:
+		return fmt.Sprintf("new milestone: %s", er.ID)
 	case ImportEventNothing:
 		if er.ID != "" {
 			return fmt.Sprintf("no action taken for event %s: %s", er.ID, er.Reason)
@@ -152,6 +178,27 @@ func NewImportLabelChange(id entity.Id) ImportResult {
 	}
 }
 
+func NewImportAssigneeChange(id entity.Id) ImportResult {
+	return ImportResult{
+		ID:    id,
+		Event: ImportEventAssigneeChange,
+	}
+}
+
+func NewImportMilestoneChange(id entity.Id) ImportResult {
+	return ImportResult{
+		ID:    id,
+		Event: ImportEventMilestoneChange,
+	}
+}
+
+func NewImportReaction(id entity.Id) ImportResult {
+	return ImportResult{
+		ID:    id,
+		Event: ImportEventReaction,
+	}
+}
+
 func NewImportTitleEdition(id entity.Id) ImportResult {
 	return ImportResult{
 		ID:    id,
@@ -165,3 +212,22 @@ func NewImportIdentity(id entity.Id) ImportResult {
 		Event: ImportEventIdentity,
 	}
 }
+
+func NewImportMilestone(id entity.Id) ImportResult {
+	return ImportResult{
+		ID:    id,
+		Event: ImportEventMilestone,
+	}
+}
+
+// NewImportDryRun describe a change that an importer in dry-run mode would
+// make, such as "create the bug \"title\"". The id is the one of the bug or
+// identity changed, if it exist.
+func NewImportDryRun(event ImportEvent, id entity.Id, change string) ImportResult {
+	return ImportResult{
+		ID:     id,
+		Reason: change,
+		Event:  event,
+		DryRun: true,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
 )
 
 type Configuration map[string]string
@@ -36,11 +37,19 @@ type BridgeImpl interface {
 }
 
 type Importer interface {
-	Init(ctx context.Context, repo *cache.RepoCache, conf Configuration) error
+	// Init prepare the importer. In dry-run mode, the importer must not write
+	// anything in the repository, and only describe the changes it would make
+	// with NewImportDryRun results.
+	Init(ctx context.Context, repo *cache.RepoCache, conf Configuration, dryRun bool) error
 	ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan ImportResult, error)
 }
 
 type Exporter interface {
-	Init(ctx context.Context, repo *cache.RepoCache, conf Configuration) error
-	ExportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan ExportResult, error)
+	// Init prepare the exporter. In dry-run mode, the exporter must not write
+	// anything on the remote bug-tracker or in the repository, and only
+	// describe the changes it would make with NewExportDryRun results.
+	Init(ctx context.Context, repo *cache.RepoCache, conf Configuration, dryRun bool) error
+	// ExportAll export the given bugs, already selected by the export filter
+	// of the bridge
+	ExportAll(ctx context.Context, repo *cache.RepoCache, ids []entity.Id, since time.Time) (<-chan ExportResult, error)
 }
@@ -5,13 +5,14 @@ import "fmt"
 // BridgeParams holds parameters to simplify the bridge configuration without
 // having to make terminal prompts.
 type BridgeParams struct {
-	URL        string // complete URL of a repo               (Github, Gitlab,     , Launchpad)
-	BaseURL    string // base URL for self-hosted instance    (        Gitlab, Jira,          )
-	Login      string // username for the passed credential   (Github, Gitlab, Jira,          )
-	CredPrefix string // ID prefix of the credential to use   (Github, Gitlab, Jira,          )
-	TokenRaw   string // pre-existing token to use            (Github, Gitlab,     ,          )
-	Owner      string // owner of the repo                    (Github,       ,     ,          )
-	Project    string // name of the repo or project key      (Github,       , Jira, Launchpad)
+	URL           string // complete URL of a repo               (Github, Gitlab,     , Launchpad, Gitea, Bugzilla, Email)
+	BaseURL       string // base URL for self-hosted instance    (        Gitlab, Jira,          , Gitea, Bugzilla,      )
+	Login         string // username for the passed credential   (Github, Gitlab, Jira,          , Gitea, Bugzilla,      )
+	CredPrefix    string // ID prefix of the credential to use   (Github, Gitlab, Jira, Launchpad, Gitea, Bugzilla,      )
+	TokenRaw      string // pre-existing token to use            (Github, Gitlab,     ,          , Gitea, Bugzilla,      )
+	Owner         string // owner of the repo                    (Github,       ,     ,          ,      ,         ,      )
+	Project       string // name of the repo or project key      (Github,       , Jira, Launchpad,      , Bugzilla,      )
+	WebhookSecret string // secret authenticating the webhooks    (Github, Gitlab,     ,          , Gitea,         ,      )
 }
 
 func (BridgeParams) fieldWarning(field string, target string) string {
@@ -30,6 +31,8 @@ func (BridgeParams) fieldWarning(field string, target string) string {
 		return fmt.Sprintf("warning: --owner is ineffective for a %s bridge", target)
 	case "Project":
 		return fmt.Sprintf("warning: --project is ineffective for a %s bridge", target)
+	case "WebhookSecret":
+		return fmt.Sprintf("warning: --webhook-secret is ineffective for a %s bridge", target)
 	default:
 		panic("unknown field")
 	}
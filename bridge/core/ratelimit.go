@@ -0,0 +1,64 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// RateLimitError is returned by a bridge when the remote refused a request
+// because too many were made. The synchronization is then retried after a
+// longer delay.
+type RateLimitError struct {
+	Err error
+}
+
+func NewRateLimitError(err error) *RateLimitError {
+	return &RateLimitError{Err: err}
+}
+
+func (e *RateLimitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// IsRateLimit tell if an error, or one it wraps, is a RateLimitError
+func IsRateLimit(err error) bool {
+	var rateLimit *RateLimitError
+	return errors.As(err, &rateLimit)
+}
+
+// IsRateLimitResponse tell if an HTTP response is the remote limiting the
+// rate of the requests: a 429 status, or a 403 with no remaining quota or
+// a delay to wait for, as GitHub does.
+func IsRateLimitResponse(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		return resp.Header.Get("X-RateLimit-Remaining") == "0" || resp.Header.Get("Retry-After") != ""
+	default:
+		return false
+	}
+}
+
+// RateLimitTransport is a http.RoundTripper returning a RateLimitError when
+// the remote limit the rate of the requests, for the bridges whose client
+// doesn't expose the responses.
+type RateLimitTransport struct {
+	Transport http.RoundTripper
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil || !IsRateLimitResponse(resp) {
+		return resp, err
+	}
+
+	_ = resp.Body.Close()
+	return nil, NewRateLimitError(fmt.Errorf("%s %s: %s", req.Method, req.URL.Host, resp.Status))
+}
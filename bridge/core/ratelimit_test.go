@@ -0,0 +1,60 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitTransport(t *testing.T) {
+	var status int
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &RateLimitTransport{Transport: http.DefaultTransport}}
+
+	tests := []struct {
+		name      string
+		status    int
+		header    http.Header
+		rateLimit bool
+	}{
+		{name: "ok", status: http.StatusOK},
+		{name: "not found", status: http.StatusNotFound},
+		{name: "forbidden", status: http.StatusForbidden},
+		{name: "too many requests", status: http.StatusTooManyRequests, rateLimit: true},
+		{name: "no remaining quota", status: http.StatusForbidden, header: http.Header{"X-Ratelimit-Remaining": {"0"}}, rateLimit: true},
+		{name: "retry after", status: http.StatusForbidden, header: http.Header{"Retry-After": {"60"}}, rateLimit: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, header = tt.status, tt.header
+
+			resp, err := client.Get(server.URL)
+			if !tt.rateLimit {
+				require.NoError(t, err)
+				require.Equal(t, tt.status, resp.StatusCode)
+				require.NoError(t, resp.Body.Close())
+				return
+			}
+
+			require.Error(t, err)
+			require.True(t, IsRateLimit(err))
+			require.True(t, IsRateLimit(errors.Wrap(err, "listing issues")))
+			require.True(t, IsRateLimit(fmt.Errorf("listing issues: %w", err)))
+		})
+	}
+
+	require.False(t, IsRateLimit(errors.New("429 too many requests")))
+}
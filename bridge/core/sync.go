@@ -0,0 +1,301 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// ConfigKeySyncInterval is the configuration key holding the interval
+// between two synchronizations of a bridge, overriding the default one
+const ConfigKeySyncInterval = "sync-interval"
+
+const syncStatusFile = "bridge-sync-status"
+
+const (
+	// delay before retrying a failed synchronization, doubled after each
+	// consecutive failure
+	syncRetryDelay = 30 * time.Second
+	// the maximum delay between two retries
+	syncMaxBackoff = 6 * time.Hour
+	// the minimum delay before retrying when the remote limited the rate of
+	// the requests
+	syncRateLimitDelay = 15 * time.Minute
+)
+
+// SyncStatus is the persisted status of the synchronization of a bridge
+type SyncStatus struct {
+	// the last synchronization, successful or not
+	LastSync    time.Time `json:"lastSync"`
+	LastSuccess time.Time `json:"lastSuccess"`
+	LastError   string    `json:"lastError,omitempty"`
+	// the number of consecutive failures
+	Failures    int  `json:"failures,omitempty"`
+	RateLimited bool `json:"rateLimited,omitempty"`
+}
+
+// NextSync return when the bridge should be synchronized again, with an
+// exponential backoff after a failure
+func (s SyncStatus) NextSync(interval time.Duration) time.Time {
+	if s.LastSync.IsZero() {
+		return time.Time{}
+	}
+	if s.Failures == 0 {
+		return s.LastSync.Add(interval)
+	}
+
+	delay := syncMaxBackoff
+	if s.Failures < 32 {
+		delay = syncRetryDelay << uint(s.Failures-1)
+	}
+	if delay > syncMaxBackoff {
+		delay = syncMaxBackoff
+	}
+	if s.RateLimited && delay < syncRateLimitDelay {
+		delay = syncRateLimitDelay
+	}
+
+	return s.LastSync.Add(delay)
+}
+
+// SyncResult is emitted after each synchronization of a bridge
+type SyncResult struct {
+	Name     string
+	Imported int
+	Exported int
+	Err      error
+	// when the bridge will be synchronized again, in watch mode
+	Next time.Time
+}
+
+func (sr SyncResult) String() string {
+	if sr.Err != nil {
+		return fmt.Sprintf("%s: sync failed: %v", sr.Name, sr.Err)
+	}
+	return fmt.Sprintf("%s: imported %d and exported %d issues", sr.Name, sr.Imported, sr.Exported)
+}
+
+func syncStatusPath(repo repository.RepoCommon) string {
+	return filepath.Join(repo.GetPath(), "git-bug", syncStatusFile)
+}
+
+// LoadSyncStatus read the synchronization status of the bridges
+func LoadSyncStatus(repo repository.RepoCommon) (map[string]SyncStatus, error) {
+	result := make(map[string]SyncStatus)
+
+	raw, err := ioutil.ReadFile(syncStatusPath(repo))
+	if os.IsNotExist(err) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "can't read the bridge sync status")
+	}
+
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, errors.Wrap(err, "invalid bridge sync status")
+	}
+
+	return result, nil
+}
+
+func storeSyncStatus(repo repository.RepoCommon, status map[string]SyncStatus) error {
+	raw, err := json.MarshalIndent(status, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := syncStatusPath(repo)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// write then rename to not lose the status if interrupted
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, raw, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// SyncInterval return the interval between two synchronizations of the
+// bridge, or the default one if not configured
+func (b *Bridge) SyncInterval(defaultInterval time.Duration) (time.Duration, error) {
+	err := b.ensureConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	raw, ok := b.conf[ConfigKeySyncInterval]
+	if !ok {
+		return defaultInterval, nil
+	}
+
+	interval, err := time.ParseDuration(raw)
+	if err != nil || interval <= 0 {
+		return 0, fmt.Errorf("invalid %s: %s", ConfigKeySyncInterval, raw)
+	}
+
+	return interval, nil
+}
+
+// Sync import then export the changes, when supported by the bridge. The
+// first error stop the synchronization.
+func (b *Bridge) Sync(ctx context.Context) (imported int, exported int, err error) {
+	importEvents, err := b.ImportAll(ctx)
+	switch {
+	case err == ErrImportNotSupported:
+		err = nil
+	case err != nil:
+		return 0, 0, err
+	default:
+		for result := range importEvents {
+			switch result.Event {
+			case ImportEventBug:
+				imported++
+			case ImportEventError:
+				if err == nil {
+					err = result.Err
+				}
+			}
+		}
+		if err != nil {
+			return imported, 0, err
+		}
+	}
+
+	exportEvents, err := b.ExportAll(ctx, time.Time{})
+	switch {
+	case err == ErrExportNotSupported:
+		return imported, 0, nil
+	case err != nil:
+		return imported, 0, err
+	default:
+		for result := range exportEvents {
+			switch result.Event {
+			case ExportEventBug:
+				exported++
+			case ExportEventError:
+				if err == nil {
+					err = result.Err
+				}
+			}
+		}
+	}
+
+	return imported, exported, err
+}
+
+// SyncAll synchronize all the configured bridges, and record their status.
+// In watch mode, each bridge is synchronized again after its interval, or
+// after a backoff delay if it failed, until the context is canceled.
+func SyncAll(ctx context.Context, repo *cache.RepoCache, defaultInterval time.Duration, watch bool) (<-chan SyncResult, error) {
+	status, err := LoadSyncStatus(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan SyncResult)
+
+	go func() {
+		defer close(out)
+
+		for {
+			names, err := ConfiguredBridges(repo)
+			if err != nil {
+				out <- SyncResult{Err: err}
+				return
+			}
+
+			var next time.Time
+
+			for _, name := range names {
+				if ctx.Err() != nil {
+					return
+				}
+
+				result, due := syncBridge(ctx, repo, name, defaultInterval, status, watch)
+				if result != nil {
+					if ctx.Err() != nil {
+						// interrupted, not a failure of the bridge
+						return
+					}
+
+					if err := storeSyncStatus(repo, status); err != nil {
+						out <- SyncResult{Err: err}
+						return
+					}
+					result.Next = due
+					out <- *result
+				}
+
+				if next.IsZero() || due.Before(next) {
+					next = due
+				}
+			}
+
+			if !watch {
+				return
+			}
+
+			// no bridge, check again later in case one get configured
+			if next.IsZero() {
+				next = time.Now().Add(defaultInterval)
+			}
+
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// syncBridge synchronize a bridge if it's due, and return the result, if
+// any, with the time of the next synchronization
+func syncBridge(ctx context.Context, repo *cache.RepoCache, name string, defaultInterval time.Duration, status map[string]SyncStatus, watch bool) (*SyncResult, time.Time) {
+	s := status[name]
+
+	interval := defaultInterval
+	b, err := LoadBridge(repo, name)
+	if err == nil {
+		interval, err = b.SyncInterval(defaultInterval)
+	}
+	if err == nil && watch && time.Now().Before(s.NextSync(interval)) {
+		return nil, s.NextSync(interval)
+	}
+
+	result := &SyncResult{Name: name}
+	if err == nil {
+		result.Imported, result.Exported, err = b.Sync(ctx)
+	}
+	result.Err = err
+
+	s.LastSync = time.Now()
+	if err == nil {
+		s.LastSuccess = s.LastSync
+		s.LastError = ""
+		s.Failures = 0
+		s.RateLimited = false
+	} else {
+		s.LastError = err.Error()
+		s.Failures++
+		s.RateLimited = IsRateLimit(err)
+	}
+	status[name] = s
+
+	return result, s.NextSync(interval)
+}
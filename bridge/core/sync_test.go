@@ -0,0 +1,134 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// fakeBridge is a bridge whose import fail a given number of times
+type fakeBridge struct{}
+
+var fakeImportErrors []error
+
+func (fakeBridge) Target() string                          { return "fake" }
+func (fakeBridge) NewImporter() Importer                   { return &fakeImporter{} }
+func (fakeBridge) NewExporter() Exporter                   { return &fakeExporter{} }
+func (fakeBridge) ValidParams() map[string]interface{}     { return nil }
+func (fakeBridge) ValidateConfig(conf Configuration) error { return nil }
+func (fakeBridge) LoginMetaKey() string                    { return "fake-login" }
+func (fakeBridge) Configure(*cache.RepoCache, BridgeParams) (Configuration, error) {
+	return Configuration{ConfigKeyTarget: "fake"}, nil
+}
+
+type fakeImporter struct{}
+
+func (*fakeImporter) Init(context.Context, *cache.RepoCache, Configuration, bool) error { return nil }
+
+func (*fakeImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan ImportResult, error) {
+	out := make(chan ImportResult, 1)
+	if len(fakeImportErrors) > 0 {
+		out <- ImportResult{Event: ImportEventError, Err: fakeImportErrors[0]}
+		fakeImportErrors = fakeImportErrors[1:]
+	} else {
+		out <- ImportResult{Event: ImportEventBug}
+	}
+	close(out)
+	return out, nil
+}
+
+// fakeExporter record the bugs it is given, and export none of them
+type fakeExporter struct{}
+
+var fakeExportedIds []entity.Id
+
+func (*fakeExporter) Init(context.Context, *cache.RepoCache, Configuration, bool) error { return nil }
+
+func (*fakeExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, ids []entity.Id, since time.Time) (<-chan ExportResult, error) {
+	fakeExportedIds = ids
+	out := make(chan ExportResult)
+	close(out)
+	return out, nil
+}
+
+func TestSyncStatusNextSync(t *testing.T) {
+	last := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	require.True(t, SyncStatus{}.NextSync(time.Hour).IsZero())
+	require.Equal(t, last.Add(time.Hour), SyncStatus{LastSync: last}.NextSync(time.Hour))
+
+	// exponential backoff
+	require.Equal(t, last.Add(30*time.Second), SyncStatus{LastSync: last, Failures: 1}.NextSync(time.Hour))
+	require.Equal(t, last.Add(2*time.Minute), SyncStatus{LastSync: last, Failures: 3}.NextSync(time.Hour))
+	require.Equal(t, last.Add(syncMaxBackoff), SyncStatus{LastSync: last, Failures: 20}.NextSync(time.Hour))
+	require.Equal(t, last.Add(syncMaxBackoff), SyncStatus{LastSync: last, Failures: 100}.NextSync(time.Hour))
+
+	// rate limited
+	require.Equal(t, last.Add(syncRateLimitDelay), SyncStatus{LastSync: last, Failures: 1, RateLimited: true}.NextSync(time.Hour))
+}
+
+func TestSyncAll(t *testing.T) {
+	Register(&fakeBridge{})
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	b, err := NewBridge(backend, "fake", "fake")
+	require.NoError(t, err)
+	require.NoError(t, b.Configure(BridgeParams{}))
+
+	fakeImportErrors = []error{fmt.Errorf("listing issues: %w", NewRateLimitError(errors.New("403 API rate limit exceeded")))}
+
+	sync := func() SyncResult {
+		results, err := SyncAll(context.Background(), backend, time.Hour, false)
+		require.NoError(t, err)
+		var all []SyncResult
+		for result := range results {
+			all = append(all, result)
+		}
+		require.Len(t, all, 1)
+		return all[0]
+	}
+
+	result := sync()
+	require.Equal(t, "fake", result.Name)
+	require.Error(t, result.Err)
+
+	status, err := LoadSyncStatus(repo)
+	require.NoError(t, err)
+	require.Equal(t, 1, status["fake"].Failures)
+	require.True(t, status["fake"].RateLimited)
+	require.True(t, status["fake"].LastSuccess.IsZero())
+	require.Equal(t, "listing issues: 403 API rate limit exceeded", status["fake"].LastError)
+
+	result = sync()
+	require.NoError(t, result.Err)
+	require.Equal(t, 1, result.Imported)
+
+	status, err = LoadSyncStatus(repo)
+	require.NoError(t, err)
+	require.Equal(t, 0, status["fake"].Failures)
+	require.Empty(t, status["fake"].LastError)
+	require.False(t, status["fake"].LastSuccess.IsZero())
+
+	// in watch mode, the bridge is not synchronized before its interval
+	ctx, cancel := context.WithCancel(context.Background())
+	results, err := SyncAll(ctx, backend, time.Hour, true)
+	require.NoError(t, err)
+	cancel()
+	for result := range results {
+		t.Fatalf("unexpected sync: %v", result)
+	}
+}
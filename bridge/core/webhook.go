@@ -0,0 +1,118 @@
+package core
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// ConfigKeyWebhookSecret is the configuration key holding the secret shared
+// with the remote bug-tracker to authenticate its webhooks
+const ConfigKeyWebhookSecret = "webhook-secret"
+
+var ErrWebhookNotSupported = errors.New("webhooks are not supported")
+var ErrWebhookSignature = errors.New("invalid webhook signature")
+var ErrWebhookIgnored = errors.New("the webhook event doesn't change the issues of the project")
+
+// WebhookImpl is implemented by the BridgeImpl able to receive the webhooks
+// of the remote bug-tracker
+type WebhookImpl interface {
+	// VerifyWebhook check that a webhook request is signed with the secret.
+	// ErrWebhookSignature is returned for an invalid signature.
+	VerifyWebhook(secret string, header http.Header, payload []byte) error
+
+	// DecodeWebhook decode the event of a verified webhook payload, and
+	// return what the importer need to import the issue it notify a change
+	// of, or nil for the events not changing the issues of the configured
+	// project.
+	DecodeWebhook(conf Configuration, header http.Header, payload []byte) (interface{}, error)
+}
+
+// WebhookImporter is implemented by the Importer able to import the change
+// notified by a webhook, without polling the whole remote bug-tracker
+type WebhookImporter interface {
+	// ImportWebhook import the issue of a webhook event, as returned by
+	// WebhookImpl.DecodeWebhook
+	ImportWebhook(ctx context.Context, repo *cache.RepoCache, event interface{}) (<-chan ImportResult, error)
+}
+
+// ImportWebhook check the signature of a webhook request, and import the
+// change it notify. ErrWebhookIgnored is returned for the events not changing
+// the issues of the configured project. The last import time is left as is,
+// as only the issue of the event is imported.
+func (b *Bridge) ImportWebhook(ctx context.Context, header http.Header, payload []byte) (<-chan ImportResult, error) {
+	impl, ok := b.impl.(WebhookImpl)
+	if !ok {
+		return nil, ErrWebhookNotSupported
+	}
+	importer, ok := b.getImporter().(WebhookImporter)
+	if !ok {
+		return nil, ErrWebhookNotSupported
+	}
+
+	err := b.ensureConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// without a secret anybody could trigger an import
+	secret := b.conf[ConfigKeyWebhookSecret]
+	if secret == "" {
+		return nil, errors.Errorf("no %s configured for the bridge %s", ConfigKeyWebhookSecret, b.Name)
+	}
+
+	err = impl.VerifyWebhook(secret, header, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := impl.DecodeWebhook(b.conf, header, payload)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrWebhookIgnored
+	}
+
+	err = b.ensureImportInit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return importer.ImportWebhook(ctx, b.repo, event)
+}
+
+// CheckHMACSignature verify a hex encoded HMAC signature of a webhook
+// payload, as sent by Github or Gitea. The hash is either "sha1" or "sha256".
+func CheckHMACSignature(hashName string, secret string, payload []byte, signature string) error {
+	var h func() hash.Hash
+	switch hashName {
+	case "sha1":
+		h = sha1.New
+	case "sha256":
+		h = sha256.New
+	default:
+		return errors.Errorf("unsupported signature hash %s", hashName)
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrWebhookSignature
+	}
+
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return ErrWebhookSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package email
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/input"
+)
+
+func (Email) ValidParams() map[string]interface{} {
+	return map[string]interface{}{
+		"URL": nil,
+	}
+}
+
+func (e *Email) Configure(repo *cache.RepoCache, params core.BridgeParams) (core.Configuration, error) {
+	var err error
+	var path string
+
+	switch {
+	case params.URL != "":
+		path = strings.TrimPrefix(params.URL, "file://")
+	default:
+		validator := func(name string, value string) (string, error) {
+			if _, err := mailboxFormat(value); err != nil {
+				return err.Error(), nil
+			}
+			return "", nil
+		}
+		path, err = input.Prompt("Path of the mbox file or Maildir", "path", input.Required, validator)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the bridge can be used from another directory later on
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify mailbox
+	if _, err := mailboxFormat(path); err != nil {
+		return nil, err
+	}
+
+	conf := make(core.Configuration)
+	conf[core.ConfigKeyTarget] = target
+	conf[confKeyPath] = path
+
+	err = e.ValidateConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+func (*Email) ValidateConfig(conf core.Configuration) error {
+	if v, ok := conf[core.ConfigKeyTarget]; !ok {
+		return fmt.Errorf("missing %s key", core.ConfigKeyTarget)
+	} else if v != target {
+		return fmt.Errorf("unexpected target name: %v", v)
+	}
+	if _, ok := conf[confKeyPath]; !ok {
+		return fmt.Errorf("missing %s key", confKeyPath)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// Package email contains the email bridge implementation, importing the bug
+// reports of a mailing list from a mbox file or a Maildir
+package email
+
+import (
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+const (
+	target = "email"
+
+	// the Message-Id of the email, on the create and comment operations
+	metaKeyEmailMessageId = "email-message-id"
+	// the address of the sender, on the identities
+	metaKeyEmailAddress = "email-address"
+
+	confKeyPath = "path"
+)
+
+var _ core.BridgeImpl = &Email{}
+
+type Email struct{}
+
+func (Email) Target() string {
+	return target
+}
+
+func (Email) LoginMetaKey() string {
+	return metaKeyEmailAddress
+}
+
+func (Email) NewImporter() core.Importer {
+	return &emailImporter{}
+}
+
+// NewExporter return nil, emails are only imported
+func (Email) NewExporter() core.Exporter {
+	return nil
+}
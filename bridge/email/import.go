@@ -0,0 +1,347 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// the prefixes added to the subject of the replies and forwards
+var replyPrefixRegexp = regexp.MustCompile(`(?i)^\s*((re|fwd?|aw|sv)\s*(\[\d+\])?\s*:\s*)+`)
+
+// emailImporter implement the Importer interface
+type emailImporter struct {
+	conf core.Configuration
+
+	// the bug of each imported message, by Message-Id
+	threads map[string]entity.Id
+
+	// select the threads to import, and the messages of the threads skipped
+	// by Message-Id
+	filter  *core.ImportFilter
+	skipped map[string]bool
+
+	// send only channel
+	out chan<- core.ImportResult
+
+	// only describe the changes, and the identities already described
+	dryRun           bool
+	dryRunIdentities map[string]bool
+}
+
+func (ei *emailImporter) Init(_ context.Context, _ *cache.RepoCache, conf core.Configuration, dryRun bool) error {
+	ei.conf = conf
+	ei.dryRun = dryRun
+	ei.dryRunIdentities = make(map[string]bool)
+
+	filter, err := core.NewImportFilter(conf)
+	if err != nil {
+		return err
+	}
+	ei.filter = filter
+
+	return nil
+}
+
+// ImportAll read all the messages of the mailbox, and create a bug for each
+// new thread and a comment for each new reply. As messages can be delivered
+// long after they are sent, the since date is ignored and the messages
+// already imported are recognized by their Message-Id.
+func (ei *emailImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ImportResult, error) {
+	out := make(chan core.ImportResult)
+	ei.out = out
+
+	go func() {
+		defer close(ei.out)
+
+		ei.skipped = make(map[string]bool)
+
+		if err := ei.indexThreads(repo); err != nil {
+			out <- core.NewImportError(err, "")
+			return
+		}
+
+		raws, err := readMailbox(ei.conf[confKeyPath])
+		if err != nil {
+			out <- core.NewImportError(err, "")
+			return
+		}
+
+		messages := make([]*message, 0, len(raws))
+		byId := make(map[string]*message, len(raws))
+		for _, raw := range raws {
+			m, err := parseMessage(raw)
+			if err != nil {
+				out <- core.NewImportWarning(fmt.Errorf("invalid message: %v", err), "")
+				continue
+			}
+			// the same message can be delivered more than once
+			if _, ok := byId[m.id]; ok {
+				continue
+			}
+			messages = append(messages, m)
+			byId[m.id] = m
+		}
+
+		sort.SliceStable(messages, func(i, j int) bool {
+			return messages[i].date.Before(messages[j].date)
+		})
+
+		importing := make(map[string]bool)
+		for _, m := range messages {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			if err := ei.importMessage(repo, m, byId, importing); err != nil {
+				out <- core.NewImportError(err, "")
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// indexThreads find the bug of the messages imported previously
+func (ei *emailImporter) indexThreads(repo *cache.RepoCache) error {
+	ei.threads = make(map[string]entity.Id)
+
+	for _, id := range repo.AllBugsIds() {
+		excerpt, err := repo.ResolveBugExcerpt(id)
+		if err != nil {
+			return err
+		}
+		if excerpt.CreateMetadata[core.MetaKeyOrigin] != target {
+			continue
+		}
+
+		b, err := repo.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+		for _, op := range b.Snapshot().Operations {
+			if messageId, ok := op.GetMetadata(metaKeyEmailMessageId); ok {
+				ei.threads[messageId] = id
+			}
+		}
+	}
+
+	return nil
+}
+
+// importMessage create a bug for the message starting a thread, or add the
+// message as a comment to the bug of its thread. The messages it reply to are
+// imported first.
+func (ei *emailImporter) importMessage(repo *cache.RepoCache, m *message, byId map[string]*message, importing map[string]bool) error {
+	if _, ok := ei.threads[m.id]; ok {
+		return nil
+	}
+
+	// protect against reference loops
+	if importing[m.id] {
+		return nil
+	}
+	importing[m.id] = true
+
+	// the direct parent first, then the closest references
+	parents := []string{m.inReplyTo}
+	for i := len(m.references) - 1; i >= 0; i-- {
+		parents = append(parents, m.references[i])
+	}
+
+	var thread entity.Id
+	for _, parent := range parents {
+		if parent == "" || parent == m.id {
+			continue
+		}
+		if pm, ok := byId[parent]; ok {
+			if err := ei.importMessage(repo, pm, byId, importing); err != nil {
+				return err
+			}
+		}
+		if ei.skipped[parent] {
+			ei.skipped[m.id] = true
+			ei.out <- core.NewImportNothing("", fmt.Sprintf("message %s: reply to a thread skipped by the import filter", m.id))
+			return nil
+		}
+		if id, ok := ei.threads[parent]; ok {
+			thread = id
+			break
+		}
+	}
+
+	// emails have neither labels nor state, only the threads started by the
+	// selected authors are imported
+	if thread == "" {
+		if reason := ei.filter.Skip(nil, true, m.from.Address); reason != "" {
+			ei.skipped[m.id] = true
+			ei.out <- core.NewImportNothing("", fmt.Sprintf("message %s: %s", m.id, reason))
+			return nil
+		}
+	}
+
+	author, err := ei.ensurePerson(repo, m.from)
+	if err != nil {
+		return err
+	}
+
+	if ei.dryRun {
+		ei.dryRunMessage(m, thread)
+		return nil
+	}
+
+	body, err := text.Cleanup(m.body)
+	if err != nil {
+		return err
+	}
+
+	files, err := storeAttachments(repo, m.attachments)
+	if err != nil {
+		return err
+	}
+
+	unixTime := m.date.Unix()
+	if m.date.IsZero() {
+		unixTime = time.Now().Unix()
+	}
+
+	if thread == "" {
+		b, _, err := repo.NewBugRaw(
+			author,
+			unixTime,
+			cleanSubject(m.subject),
+			body,
+			files,
+			map[string]string{
+				core.MetaKeyOrigin:    target,
+				metaKeyEmailMessageId: m.id,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("bug creation: %v", err)
+		}
+
+		ei.out <- core.NewImportBug(b.Id())
+		ei.threads[m.id] = b.Id()
+		return nil
+	}
+
+	b, err := repo.ResolveBug(thread)
+	if err != nil {
+		return err
+	}
+
+	op, err := b.AddCommentRaw(
+		author,
+		unixTime,
+		body,
+		files,
+		map[string]string{
+			metaKeyEmailMessageId: m.id,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("comment creation: %v", err)
+	}
+
+	if err := b.CommitAsNeeded(); err != nil {
+		return fmt.Errorf("bug commit: %v", err)
+	}
+
+	ei.out <- core.NewImportComment(op.Id())
+	ei.threads[m.id] = b.Id()
+	return nil
+}
+
+// dryRunMessage describe the import of a message. The threads started by a
+// bug that would be created are recorded with an unset id.
+func (ei *emailImporter) dryRunMessage(m *message, thread entity.Id) {
+	switch thread {
+	case "":
+		ei.out <- core.NewImportDryRun(core.ImportEventBug, "", fmt.Sprintf("create the bug \"%s\"", cleanSubject(m.subject)))
+		thread = entity.UnsetId
+	case entity.UnsetId:
+		ei.out <- core.NewImportDryRun(core.ImportEventComment, "", fmt.Sprintf("add a comment from %s to a new bug", m.from.Address))
+	default:
+		ei.out <- core.NewImportDryRun(core.ImportEventComment, thread, fmt.Sprintf("add a comment from %s", m.from.Address))
+	}
+	ei.threads[m.id] = thread
+}
+
+// ensurePerson return the identity of the sender of a message, creating it
+// if needed. Senders are identified by their address.
+func (ei *emailImporter) ensurePerson(repo *cache.RepoCache, from *mail.Address) (*cache.IdentityCache, error) {
+	// Look first in the cache
+	i, err := repo.ResolveIdentityImmutableMetadata(metaKeyEmailAddress, from.Address)
+	if err == nil {
+		return i, nil
+	}
+	if entity.IsErrMultipleMatch(err) {
+		return nil, err
+	}
+
+	if ei.dryRun {
+		if !ei.dryRunIdentities[from.Address] {
+			ei.dryRunIdentities[from.Address] = true
+			ei.out <- core.NewImportDryRun(core.ImportEventIdentity, "", fmt.Sprintf("create the identity %s", from.Address))
+		}
+		return nil, nil
+	}
+
+	name := from.Name
+	if name == "" {
+		name = strings.SplitN(from.Address, "@", 2)[0]
+	}
+
+	i, err = repo.NewIdentityRaw(
+		name,
+		from.Address,
+		"",
+		"",
+		map[string]string{
+			metaKeyEmailAddress: from.Address,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ei.out <- core.NewImportIdentity(i.Id())
+	return i, nil
+}
+
+// storeAttachments store the attachments of a message as git blobs
+func storeAttachments(repo *cache.RepoCache, attachments []attachment) ([]repository.Hash, error) {
+	var files []repository.Hash
+	for _, a := range attachments {
+		hash, err := repo.StoreData(a.data)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, hash)
+	}
+	return files, nil
+}
+
+// cleanSubject turn the subject of a message into a bug title
+func cleanSubject(subject string) string {
+	subject = replyPrefixRegexp.ReplaceAllString(subject, "")
+	subject = strings.Join(strings.Fields(subject), " ")
+	if subject == "" {
+		return "(no subject)"
+	}
+	return subject
+}
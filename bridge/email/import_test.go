@@ -0,0 +1,282 @@
+package email
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const testMbox = `From alice@example.com Mon Jun  1 12:00:00 2020
+From: Alice <alice@example.com>
+Subject: crash on start
+Date: Mon, 01 Jun 2020 12:00:00 +0000
+Message-Id: <root@example.com>
+
+It crashes.
+
+From bob@example.com Mon Jun  1 14:00:00 2020
+From: Bob <bob@example.com>
+Subject: Re: crash on start
+Date: Mon, 01 Jun 2020 14:00:00 +0000
+Message-Id: <reply2@example.com>
+In-Reply-To: <reply1@example.com>
+References: <root@example.com> <reply1@example.com>
+
+Fixed.
+
+From alice@example.com Mon Jun  1 13:00:00 2020
+From: ALICE@example.com
+Subject: Re: crash on start
+Date: Mon, 01 Jun 2020 15:00:00 +0000
+Message-Id: <reply1@example.com>
+In-Reply-To: <root@example.com>
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="b"
+
+--b
+Content-Type: text/plain
+
+Here is the log.
+--b
+Content-Type: application/octet-stream
+Content-Disposition: attachment; filename="crash.log"
+
+segfault
+--b--
+
+From carol@example.com Mon Jun  1 16:00:00 2020
+From: Carol <carol@example.com>
+Subject: another bug
+Date: Mon, 01 Jun 2020 16:00:00 +0000
+Message-Id: <other@example.com>
+
+Something else.
+`
+
+const testMboxReply = `From carol@example.com Tue Jun  2 12:00:00 2020
+From: Carol <carol@example.com>
+Subject: Re: another bug
+Date: Tue, 02 Jun 2020 12:00:00 +0000
+Message-Id: <other-reply@example.com>
+In-Reply-To: <other@example.com>
+
+Still there.
+`
+
+func TestImport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mbox")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "bugs.mbox")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testMbox), 0600))
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	conf, err := (&Email{}).Configure(backend, core.BridgeParams{URL: path})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	importer := &emailImporter{}
+	err = importer.Init(ctx, backend, conf, false)
+	require.NoError(t, err)
+
+	importAll := func() {
+		events, err := importer.ImportAll(ctx, backend, time.Time{})
+		require.NoError(t, err)
+		for result := range events {
+			require.NoError(t, result.Err)
+		}
+	}
+
+	importAll()
+
+	require.Len(t, backend.AllBugsIds(), 2)
+
+	b1, err := backend.ResolveBugCreateMetadata(metaKeyEmailMessageId, "root@example.com")
+	require.NoError(t, err)
+
+	// the replies are threaded, even out of order
+	snapshot := b1.Snapshot()
+	require.Equal(t, "crash on start", snapshot.Title)
+	require.Len(t, snapshot.Comments, 3)
+	require.Equal(t, "It crashes.", snapshot.Comments[0].Message)
+	require.Equal(t, "Here is the log.", snapshot.Comments[1].Message)
+	require.Equal(t, "Fixed.", snapshot.Comments[2].Message)
+
+	// senders are identified by their address
+	require.Equal(t, snapshot.Comments[0].Author.Id(), snapshot.Comments[1].Author.Id())
+	require.Equal(t, "Alice", snapshot.Comments[1].Author.Name())
+	require.Equal(t, "alice@example.com", snapshot.Comments[1].Author.Email())
+
+	// attachments are stored as git blobs
+	require.Len(t, snapshot.Comments[1].Files, 1)
+	data, err := repo.ReadData(snapshot.Comments[1].Files[0])
+	require.NoError(t, err)
+	require.Equal(t, "segfault", string(data))
+
+	// new messages are added to the known threads
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString("\n" + testMboxReply)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// with a fresh importer, as for a new run of the bridge
+	importer = &emailImporter{}
+	err = importer.Init(ctx, backend, conf, false)
+	require.NoError(t, err)
+	importAll()
+
+	require.Len(t, backend.AllBugsIds(), 2)
+	require.Len(t, b1.Snapshot().Comments, 3)
+
+	b2, err := backend.ResolveBugCreateMetadata(metaKeyEmailMessageId, "other@example.com")
+	require.NoError(t, err)
+	require.Len(t, b2.Snapshot().Comments, 2)
+	require.Equal(t, "Still there.", b2.Snapshot().Comments[1].Message)
+}
+
+func TestImportDryRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mbox")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "bugs.mbox")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testMbox), 0600))
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	conf, err := (&Email{}).Configure(backend, core.BridgeParams{URL: path})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	importAll := func(dryRun bool) map[core.ImportEvent][]core.ImportResult {
+		importer := &emailImporter{}
+		require.NoError(t, importer.Init(ctx, backend, conf, dryRun))
+
+		events, err := importer.ImportAll(ctx, backend, time.Time{})
+		require.NoError(t, err)
+
+		results := make(map[core.ImportEvent][]core.ImportResult)
+		for result := range events {
+			require.NoError(t, result.Err)
+			require.Equal(t, dryRun, result.DryRun)
+			results[result.Event] = append(results[result.Event], result)
+		}
+		return results
+	}
+
+	// the changes are described, but nothing is written
+	results := importAll(true)
+	require.Len(t, results[core.ImportEventBug], 2)
+	require.Len(t, results[core.ImportEventComment], 2)
+	require.Len(t, results[core.ImportEventIdentity], 3)
+	require.Equal(t, `dry-run: would create the bug "crash on start"`, results[core.ImportEventBug][0].String())
+	require.Empty(t, backend.AllBugsIds())
+	require.Empty(t, backend.AllIdentityIds())
+
+	importAll(false)
+	require.Len(t, backend.AllBugsIds(), 2)
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString("\n" + testMboxReply)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	b2, err := backend.ResolveBugCreateMetadata(metaKeyEmailMessageId, "other@example.com")
+	require.NoError(t, err)
+
+	results = importAll(true)
+	require.Len(t, results, 1)
+	require.Len(t, results[core.ImportEventComment], 1)
+	require.Equal(t, b2.Id(), results[core.ImportEventComment][0].ID)
+	require.Len(t, b2.Snapshot().Comments, 1)
+}
+
+func TestImportFilter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mbox")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "bugs.mbox")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testMbox), 0600))
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	conf, err := (&Email{}).Configure(backend, core.BridgeParams{URL: path})
+	require.NoError(t, err)
+	conf[core.ConfigKeyImportAuthors] = "carol@example.com"
+
+	ctx := context.Background()
+
+	importer := &emailImporter{}
+	require.NoError(t, importer.Init(ctx, backend, conf, false))
+
+	events, err := importer.ImportAll(ctx, backend, time.Time{})
+	require.NoError(t, err)
+
+	skipped := 0
+	for result := range events {
+		require.NoError(t, result.Err)
+		if result.Event == core.ImportEventNothing {
+			skipped++
+		}
+	}
+
+	// the thread started by alice is skipped with its replies
+	require.Equal(t, 3, skipped)
+	require.Len(t, backend.AllBugsIds(), 1)
+
+	b, err := backend.ResolveBugCreateMetadata(metaKeyEmailMessageId, "other@example.com")
+	require.NoError(t, err)
+	require.Equal(t, "another bug", b.Snapshot().Title)
+
+	// the threads already imported are kept up to date, even when the filter
+	// doesn't select them anymore
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString("\n" + testMboxReply)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	conf[core.ConfigKeyImportAuthors] = "bob@example.com"
+	importer = &emailImporter{}
+	require.NoError(t, importer.Init(ctx, backend, conf, false))
+
+	events, err = importer.ImportAll(ctx, backend, time.Time{})
+	require.NoError(t, err)
+	for result := range events {
+		require.NoError(t, result.Err)
+	}
+
+	require.Len(t, backend.AllBugsIds(), 1)
+	require.Len(t, b.Snapshot().Comments, 2)
+}
@@ -0,0 +1,147 @@
+package email
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	formatMbox    = "mbox"
+	formatMaildir = "maildir"
+)
+
+// mailboxFormat return the format of the mailbox at the given path: a
+// Maildir if it's a directory, a mbox file otherwise
+func mailboxFormat(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	if !info.IsDir() {
+		return formatMbox, nil
+	}
+
+	for _, sub := range []string{"cur", "new"} {
+		info, err := os.Stat(filepath.Join(path, sub))
+		if err != nil || !info.IsDir() {
+			return "", fmt.Errorf("%s is not a Maildir, the %s directory is missing", path, sub)
+		}
+	}
+
+	return formatMaildir, nil
+}
+
+// readMailbox return the raw messages of a mbox file or a Maildir
+func readMailbox(path string) ([][]byte, error) {
+	format, err := mailboxFormat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case formatMaildir:
+		return readMaildir(path)
+	default:
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return readMbox(f)
+	}
+}
+
+// readMbox split a mbox file in messages. Each message start with a "From "
+// line, and the "From " at the beginning of the lines of the messages are
+// escaped with '>', as in the mboxo and mboxrd variants.
+func readMbox(r io.Reader) ([][]byte, error) {
+	var messages [][]byte
+	var current *bytes.Buffer
+	previousEmpty := true
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if len(line) == 0 && err == io.EOF {
+			break
+		}
+
+		switch {
+		case previousEmpty && bytes.HasPrefix(line, []byte("From ")):
+			// start of a new message
+			if current != nil {
+				messages = append(messages, current.Bytes())
+			}
+			current = &bytes.Buffer{}
+
+		case current == nil:
+			// garbage before the first message
+			return nil, fmt.Errorf("not a mbox file")
+
+		default:
+			// mboxrd unescape the quoted "From " lines
+			if unquoted := bytes.TrimLeft(line, ">"); len(unquoted) < len(line) && bytes.HasPrefix(unquoted, []byte("From ")) {
+				line = line[1:]
+			}
+			current.Write(line)
+		}
+
+		previousEmpty = len(bytes.TrimRight(line, "\r\n")) == 0
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	if current != nil {
+		messages = append(messages, current.Bytes())
+	}
+
+	return messages, nil
+}
+
+// readMaildir return the messages of a Maildir, in the order of their file
+// names, which start with the delivery time
+func readMaildir(path string) ([][]byte, error) {
+	var files []string
+
+	// tmp hold the messages being delivered, they are skipped
+	for _, sub := range []string{"new", "cur"} {
+		infos, err := ioutil.ReadDir(filepath.Join(path, sub))
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+				continue
+			}
+			files = append(files, filepath.Join(path, sub, info.Name()))
+		}
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return filepath.Base(files[i]) < filepath.Base(files[j])
+	})
+
+	messages := make([][]byte, 0, len(files))
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, data)
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,68 @@
+package email
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadMbox(t *testing.T) {
+	mbox := `From alice@example.com Mon Jun  1 12:00:00 2020
+From: alice@example.com
+Subject: first
+
+first body
+>From the beginning
+>>From quoted
+
+From bob@example.com Mon Jun  1 13:00:00 2020
+From: bob@example.com
+Subject: second
+
+second body
+`
+
+	messages, err := readMbox(strings.NewReader(mbox))
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.Equal(t, "From: alice@example.com\nSubject: first\n\nfirst body\nFrom the beginning\n>From quoted\n\n", string(messages[0]))
+	require.Equal(t, "From: bob@example.com\nSubject: second\n\nsecond body\n", string(messages[1]))
+
+	_, err = readMbox(strings.NewReader("Subject: not a mbox\n"))
+	require.Error(t, err)
+}
+
+func TestReadMaildir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "maildir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// not a Maildir yet
+	_, err = mailboxFormat(dir)
+	require.Error(t, err)
+
+	for _, sub := range []string{"cur", "new", "tmp"} {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, sub), 0700))
+	}
+
+	write := func(path, content string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, path), []byte(content), 0600))
+	}
+	write("cur/1591012800.1.host:2,S", "Subject: first\n")
+	write("new/1591016400.2.host", "Subject: second\n")
+	write("tmp/1591020000.3.host", "Subject: being delivered\n")
+
+	format, err := mailboxFormat(dir)
+	require.NoError(t, err)
+	require.Equal(t, formatMaildir, format)
+
+	messages, err := readMailbox(dir)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.Equal(t, "Subject: first\n", string(messages[0]))
+	require.Equal(t, "Subject: second\n", string(messages[1]))
+}
@@ -0,0 +1,213 @@
+package email
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// message is a parsed email
+type message struct {
+	id         string
+	inReplyTo  string
+	references []string
+	from       *mail.Address
+	subject    string
+	date       time.Time
+	body       string
+	// the html body, only used when there is no plain text one
+	htmlBody    string
+	attachments []attachment
+}
+
+// attachment is a file attached to an email
+type attachment struct {
+	name        string
+	contentType string
+	data        []byte
+}
+
+// header is the common interface of the headers of a message and of its
+// parts
+type header interface {
+	Get(key string) string
+}
+
+var messageIdRegexp = regexp.MustCompile(`<([^<>\s]+)>`)
+
+var wordDecoder = &mime.WordDecoder{CharsetReader: charsetReader}
+
+// parseMessage parse a raw email
+func parseMessage(raw []byte) (*message, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &message{}
+
+	ids := parseMessageIds(msg.Header.Get("Message-Id"))
+	if len(ids) > 0 {
+		m.id = ids[0]
+	} else {
+		// a stable id is needed to not import the message twice
+		m.id = fmt.Sprintf("%x@git-bug", sha1.Sum(raw))
+	}
+
+	if ids := parseMessageIds(msg.Header.Get("In-Reply-To")); len(ids) > 0 {
+		m.inReplyTo = ids[0]
+	}
+	m.references = parseMessageIds(msg.Header.Get("References"))
+
+	parser := &mail.AddressParser{WordDecoder: wordDecoder}
+	m.from, err = parser.Parse(msg.Header.Get("From"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender: %v", err)
+	}
+	m.from.Address = strings.ToLower(m.from.Address)
+
+	m.subject, err = wordDecoder.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		m.subject = msg.Header.Get("Subject")
+	}
+
+	// the date is mandatory, but not always there
+	m.date, _ = msg.Header.Date()
+
+	err = m.parsePart(msg.Header, msg.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(m.body) == "" {
+		m.body = m.htmlBody
+	}
+
+	return m, nil
+}
+
+// parsePart parse the body of the message or of one of its parts, adding the
+// text to the body and the files to the attachments
+func (m *message) parsePart(h header, r io.Reader) error {
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", nil
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(r, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := m.parsePart(part.Header, part); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch strings.ToLower(h.Get("Content-Transfer-Encoding")) {
+	case "base64":
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	case "quoted-printable":
+		r = quotedprintable.NewReader(r)
+	}
+
+	disposition, dispositionParams, _ := mime.ParseMediaType(h.Get("Content-Disposition"))
+	name := dispositionParams["filename"]
+	if name == "" {
+		name = params["name"]
+	}
+
+	if disposition == "attachment" || name != "" || !strings.HasPrefix(mediaType, "text/") {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		m.attachments = append(m.attachments, attachment{
+			name:        name,
+			contentType: mediaType,
+			data:        data,
+		})
+		return nil
+	}
+
+	if charset := params["charset"]; charset != "" {
+		// an unknown charset is kept as is, the text is still mostly readable
+		if decoded, err := charsetReader(charset, r); err == nil {
+			r = decoded
+		}
+	}
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	text := strings.ToValidUTF8(string(data), "\uFFFD")
+
+	switch mediaType {
+	case "text/plain":
+		m.body = joinText(m.body, text)
+	case "text/html":
+		m.htmlBody = joinText(m.htmlBody, text)
+	default:
+		m.attachments = append(m.attachments, attachment{
+			contentType: mediaType,
+			data:        data,
+		})
+	}
+
+	return nil
+}
+
+// parseMessageIds return the ids of a Message-Id, In-Reply-To or References
+// header, without the angle brackets
+func parseMessageIds(value string) []string {
+	var ids []string
+	for _, match := range messageIdRegexp.FindAllStringSubmatch(value, -1) {
+		ids = append(ids, match[1])
+	}
+	if len(ids) == 0 && strings.TrimSpace(value) != "" {
+		// some clients forget the angle brackets
+		ids = strings.Fields(value)
+	}
+	return ids
+}
+
+// charsetReader return a reader converting the given charset to UTF-8
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "utf-8", "utf8", "us-ascii":
+		return input, nil
+	}
+
+	encoding, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported charset %s", charset)
+	}
+
+	return encoding.NewDecoder().Reader(input), nil
+}
+
+func joinText(a, b string) string {
+	if a == "" {
+		return b
+	}
+	return a + "\n" + b
+}
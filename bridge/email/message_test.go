@@ -0,0 +1,83 @@
+package email
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMessage(t *testing.T) {
+	raw := strings.Join([]string{
+		`From: =?utf-8?q?Michael_Mur=C3=A9?= <Michael@Example.com>`,
+		`Subject: =?iso-8859-1?q?caf=E9?= crash`,
+		`Date: Mon, 01 Jun 2020 12:00:00 +0000`,
+		`Message-Id: <reply@example.com>`,
+		`In-Reply-To: <parent@example.com>`,
+		`References: <root@example.com> <parent@example.com>`,
+		`MIME-Version: 1.0`,
+		`Content-Type: multipart/mixed; boundary="mixed"`,
+		``,
+		`--mixed`,
+		`Content-Type: multipart/alternative; boundary="alt"`,
+		``,
+		`--alt`,
+		`Content-Type: text/plain; charset=iso-8859-1`,
+		`Content-Transfer-Encoding: quoted-printable`,
+		``,
+		`The caf=E9 is broken`,
+		`--alt`,
+		`Content-Type: text/html; charset=utf-8`,
+		``,
+		`<p>The café is broken</p>`,
+		`--alt--`,
+		`--mixed`,
+		`Content-Type: text/x-log; name="crash.log"`,
+		`Content-Disposition: attachment; filename="crash.log"`,
+		`Content-Transfer-Encoding: base64`,
+		``,
+		`c2VnZmF1bHQK`,
+		`--mixed--`,
+		``,
+	}, "\r\n")
+
+	m, err := parseMessage([]byte(raw))
+	require.NoError(t, err)
+
+	require.Equal(t, "reply@example.com", m.id)
+	require.Equal(t, "parent@example.com", m.inReplyTo)
+	require.Equal(t, []string{"root@example.com", "parent@example.com"}, m.references)
+	require.Equal(t, "Michael Muré", m.from.Name)
+	require.Equal(t, "michael@example.com", m.from.Address)
+	require.Equal(t, "café crash", m.subject)
+	require.True(t, m.date.Equal(time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)))
+	require.Equal(t, "The café is broken", m.body)
+
+	require.Len(t, m.attachments, 1)
+	require.Equal(t, "crash.log", m.attachments[0].name)
+	require.Equal(t, "segfault\n", string(m.attachments[0].data))
+}
+
+func TestParseMessageWithoutId(t *testing.T) {
+	raw := []byte("From: alice@example.com\nSubject: hello\nContent-Type: text/html\n\n<b>html only</b>")
+
+	m1, err := parseMessage(raw)
+	require.NoError(t, err)
+	m2, err := parseMessage(raw)
+	require.NoError(t, err)
+
+	// the generated id is stable
+	require.NotEmpty(t, m1.id)
+	require.Equal(t, m1.id, m2.id)
+	require.Equal(t, "<b>html only</b>", m1.body)
+
+	_, err = parseMessage([]byte("Subject: no sender\n\nbody"))
+	require.Error(t, err)
+}
+
+func TestCleanSubject(t *testing.T) {
+	require.Equal(t, "crash on start", cleanSubject("Re: RE: Fwd:  crash on\tstart"))
+	require.Equal(t, "crash", cleanSubject("AW[2]: crash"))
+	require.Equal(t, "(no subject)", cleanSubject("Re: "))
+}
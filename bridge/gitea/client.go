@@ -0,0 +1,357 @@
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+// number of items requested per page
+const pageSize = 50
+
+// timeline event types
+const (
+	eventComment     = "comment"
+	eventClose       = "close"
+	eventReopen      = "reopen"
+	eventLabel       = "label"
+	eventChangeTitle = "change_title"
+)
+
+// =============================================================================
+// JSON Objects
+// =============================================================================
+
+// User is a Gitea user
+// https://try.gitea.io/api/swagger#model-User
+type User struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	FullName  string `json:"full_name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+// Repository is a Gitea repository
+// https://try.gitea.io/api/swagger#model-Repository
+type Repository struct {
+	ID       int64  `json:"id"`
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+}
+
+// Label is a label of a Gitea repository
+// https://try.gitea.io/api/swagger#model-Label
+type Label struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// Issue is a Gitea issue. Pull requests are issues too, with PullRequest set.
+// https://try.gitea.io/api/swagger#model-Issue
+type Issue struct {
+	ID          int64       `json:"id"`
+	Number      int64       `json:"number"`
+	User        User        `json:"user"`
+	Title       string      `json:"title"`
+	Body        string      `json:"body"`
+	Labels      []Label     `json:"labels"`
+	State       string      `json:"state"`
+	HTMLURL     string      `json:"html_url"`
+	Created     time.Time   `json:"created_at"`
+	Updated     time.Time   `json:"updated_at"`
+	PullRequest interface{} `json:"pull_request"`
+}
+
+// Comment is a comment on a Gitea issue
+// https://try.gitea.io/api/swagger#model-Comment
+type Comment struct {
+	ID      int64     `json:"id"`
+	User    User      `json:"user"`
+	Body    string    `json:"body"`
+	HTMLURL string    `json:"html_url"`
+	Created time.Time `json:"created_at"`
+	Updated time.Time `json:"updated_at"`
+}
+
+// TimelineEvent is an event of the timeline of a Gitea issue. For a label
+// event, the body is "1" when the label is added and empty when it's removed.
+// https://try.gitea.io/api/swagger#model-TimelineComment
+type TimelineEvent struct {
+	ID       int64     `json:"id"`
+	Type     string    `json:"type"`
+	User     User      `json:"user"`
+	Body     string    `json:"body"`
+	HTMLURL  string    `json:"html_url"`
+	Created  time.Time `json:"created_at"`
+	Updated  time.Time `json:"updated_at"`
+	Label    *Label    `json:"label"`
+	OldTitle string    `json:"old_title"`
+	NewTitle string    `json:"new_title"`
+}
+
+// EditIssueOption hold the fields to update on an issue, nil fields are left
+// untouched
+// https://try.gitea.io/api/swagger#model-EditIssueOption
+type EditIssueOption struct {
+	Title *string `json:"title,omitempty"`
+	Body  *string `json:"body,omitempty"`
+	State *string `json:"state,omitempty"`
+}
+
+// apiError is the body of an error response
+type apiError struct {
+	Message string `json:"message"`
+}
+
+// =============================================================================
+// REST Client
+// =============================================================================
+
+// client is a minimal client of the Gitea REST API (v1)
+type client struct {
+	apiURL string
+	token  string
+	http   *http.Client
+}
+
+func newClient(baseURL string, token string) *client {
+	return &client{
+		apiURL: strings.TrimSuffix(baseURL, "/") + "/api/v1",
+		token:  token,
+		http:   &http.Client{},
+	}
+}
+
+// do send a request to the API, encoding in as the JSON body if not nil and
+// decoding the response in out if not nil
+func (c *client) do(ctx context.Context, method string, path string, query url.Values, in interface{}, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	reqURL := c.apiURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, reqURL, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := ioutil.ReadAll(resp.Body)
+		var apiErr apiError
+		err := fmt.Errorf("gitea: %s %s: %s", method, path, resp.Status)
+		if json.Unmarshal(data, &apiErr) == nil && apiErr.Message != "" {
+			err = fmt.Errorf("gitea: %s %s: %s (%s)", method, path, resp.Status, apiErr.Message)
+		}
+		if core.IsRateLimitResponse(resp) {
+			return core.NewRateLimitError(err)
+		}
+		return err
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func repoPath(owner, project string) string {
+	return fmt.Sprintf("/repos/%s/%s", url.PathEscape(owner), url.PathEscape(project))
+}
+
+func pageQuery(page int) url.Values {
+	return url.Values{
+		"page":  []string{strconv.Itoa(page)},
+		"limit": []string{strconv.Itoa(pageSize)},
+	}
+}
+
+// CurrentUser return the user authenticated by the token
+func (c *client) CurrentUser(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.do(ctx, http.MethodGet, "/user", nil, nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetRepository return a repository
+func (c *client) GetRepository(ctx context.Context, owner, project string) (*Repository, error) {
+	var repo Repository
+	if err := c.do(ctx, http.MethodGet, repoPath(owner, project), nil, nil, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// ListIssues return a page of the issues of a repository updated after since,
+// pull requests excluded
+func (c *client) ListIssues(ctx context.Context, owner, project string, since time.Time, page int) ([]Issue, error) {
+	query := pageQuery(page)
+	query.Set("state", "all")
+	query.Set("type", "issues")
+	if !since.IsZero() {
+		query.Set("since", since.Format(time.RFC3339))
+	}
+
+	var issues []Issue
+	if err := c.do(ctx, http.MethodGet, repoPath(owner, project)+"/issues", query, nil, &issues); err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// ListTimeline return a page of the timeline events of an issue
+func (c *client) ListTimeline(ctx context.Context, owner, project string, number int64, page int) ([]TimelineEvent, error) {
+	path := fmt.Sprintf("%s/issues/%d/timeline", repoPath(owner, project), number)
+
+	var events []TimelineEvent
+	if err := c.do(ctx, http.MethodGet, path, pageQuery(page), nil, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// CreateIssue create a new issue
+func (c *client) CreateIssue(ctx context.Context, owner, project, title, body string) (*Issue, error) {
+	in := struct {
+		Title string `json:"title"`
+		Body  string `json:"body"`
+	}{
+		Title: title,
+		Body:  body,
+	}
+
+	var issue Issue
+	if err := c.do(ctx, http.MethodPost, repoPath(owner, project)+"/issues", nil, in, &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// EditIssue update the title, body or state of an issue
+func (c *client) EditIssue(ctx context.Context, owner, project string, number int64, opt EditIssueOption) (*Issue, error) {
+	path := fmt.Sprintf("%s/issues/%d", repoPath(owner, project), number)
+
+	var issue Issue
+	if err := c.do(ctx, http.MethodPatch, path, nil, opt, &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// CreateComment add a comment to an issue
+func (c *client) CreateComment(ctx context.Context, owner, project string, number int64, body string) (*Comment, error) {
+	path := fmt.Sprintf("%s/issues/%d/comments", repoPath(owner, project), number)
+	in := struct {
+		Body string `json:"body"`
+	}{
+		Body: body,
+	}
+
+	var comment Comment
+	if err := c.do(ctx, http.MethodPost, path, nil, in, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// EditComment update the body of a comment
+func (c *client) EditComment(ctx context.Context, owner, project string, id int64, body string) (*Comment, error) {
+	path := fmt.Sprintf("%s/issues/comments/%d", repoPath(owner, project), id)
+	in := struct {
+		Body string `json:"body"`
+	}{
+		Body: body,
+	}
+
+	var comment Comment
+	if err := c.do(ctx, http.MethodPatch, path, nil, in, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// DeleteComment remove a comment from an issue
+func (c *client) DeleteComment(ctx context.Context, owner, project string, id int64) error {
+	path := fmt.Sprintf("%s/issues/comments/%d", repoPath(owner, project), id)
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// ListLabels return a page of the labels of a repository
+func (c *client) ListLabels(ctx context.Context, owner, project string, page int) ([]Label, error) {
+	var labels []Label
+	if err := c.do(ctx, http.MethodGet, repoPath(owner, project)+"/labels", pageQuery(page), nil, &labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// CreateLabel create a new label in a repository, color is in the #rrggbb
+// format
+func (c *client) CreateLabel(ctx context.Context, owner, project, name, color string) (*Label, error) {
+	in := struct {
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	}{
+		Name:  name,
+		Color: color,
+	}
+
+	var label Label
+	if err := c.do(ctx, http.MethodPost, repoPath(owner, project)+"/labels", nil, in, &label); err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+// ReplaceIssueLabels set the complete list of labels of an issue
+func (c *client) ReplaceIssueLabels(ctx context.Context, owner, project string, number int64, labelIDs []int64) error {
+	path := fmt.Sprintf("%s/issues/%d/labels", repoPath(owner, project), number)
+	in := struct {
+		Labels []int64 `json:"labels"`
+	}{
+		Labels: labelIDs,
+	}
+
+	return c.do(ctx, http.MethodPut, path, nil, in, nil)
+}
@@ -0,0 +1,348 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+var (
+	ErrBadProjectURL = errors.New("bad project url")
+)
+
+func (g *Gitea) ValidParams() map[string]interface{} {
+	return map[string]interface{}{
+		"URL":           nil,
+		"BaseURL":       nil,
+		"Login":         nil,
+		"CredPrefix":    nil,
+		"TokenRaw":      nil,
+		"WebhookSecret": nil,
+	}
+}
+
+func (g *Gitea) Configure(repo *cache.RepoCache, params core.BridgeParams) (core.Configuration, error) {
+	var err error
+	var baseUrl string
+
+	switch {
+	case params.BaseURL != "":
+		baseUrl = params.BaseURL
+	case params.URL != "":
+		// assume the instance is served at the root of the host
+		baseUrl, err = baseURLFromProjectURL(params.URL)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		baseUrl, err = input.Prompt("Gitea server URL", "URL", input.Required, input.IsURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "base url prompt")
+		}
+	}
+	baseUrl = strings.TrimSuffix(baseUrl, "/")
+
+	var projectURL string
+
+	// get project url
+	switch {
+	case params.URL != "":
+		projectURL = params.URL
+	default:
+		// terminal prompt
+		projectURL, err = promptProjectURL(repo, baseUrl)
+		if err != nil {
+			return nil, errors.Wrap(err, "url prompt")
+		}
+	}
+
+	owner, project, err := splitProjectURL(baseUrl, projectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var login string
+	var cred auth.Credential
+
+	switch {
+	case params.CredPrefix != "":
+		cred, err = auth.LoadWithPrefix(repo, params.CredPrefix)
+		if err != nil {
+			return nil, err
+		}
+		l, ok := cred.GetMetadata(auth.MetaKeyLogin)
+		if !ok {
+			return nil, fmt.Errorf("credential doesn't have a login")
+		}
+		login = l
+	case params.TokenRaw != "":
+		token := auth.NewToken(target, params.TokenRaw)
+		login, err = getLoginFromToken(baseUrl, token)
+		if err != nil {
+			return nil, err
+		}
+		token.SetMetadata(auth.MetaKeyLogin, login)
+		token.SetMetadata(auth.MetaKeyBaseURL, baseUrl)
+		cred = token
+	default:
+		if params.Login == "" {
+			login, err = input.Prompt("Gitea login", "login", input.Required)
+		} else {
+			login = params.Login
+		}
+		if err != nil {
+			return nil, err
+		}
+		cred, err = promptTokenOptions(repo, login, baseUrl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	token, ok := cred.(*auth.Token)
+	if !ok {
+		return nil, fmt.Errorf("the Gitea bridge only handle token credentials")
+	}
+
+	// validate the project and the token access
+	err = validateProject(baseUrl, owner, project, token)
+	if err != nil {
+		return nil, errors.Wrap(err, "project validation")
+	}
+
+	conf := make(core.Configuration)
+	conf[core.ConfigKeyTarget] = target
+	conf[confKeyGiteaBaseUrl] = baseUrl
+	conf[confKeyOwner] = owner
+	conf[confKeyProject] = project
+	conf[confKeyDefaultLogin] = login
+
+	err = g.ValidateConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// don't forget to store the now known valid token
+	if !auth.IdExist(repo, cred.ID()) {
+		err = auth.Store(repo, cred)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return conf, core.FinishConfig(repo, metaKeyGiteaLogin, login)
+}
+
+func (g *Gitea) ValidateConfig(conf core.Configuration) error {
+	if v, ok := conf[core.ConfigKeyTarget]; !ok {
+		return fmt.Errorf("missing %s key", core.ConfigKeyTarget)
+	} else if v != target {
+		return fmt.Errorf("unexpected target name: %v", v)
+	}
+	if _, ok := conf[confKeyGiteaBaseUrl]; !ok {
+		return fmt.Errorf("missing %s key", confKeyGiteaBaseUrl)
+	}
+	if _, ok := conf[confKeyOwner]; !ok {
+		return fmt.Errorf("missing %s key", confKeyOwner)
+	}
+	if _, ok := conf[confKeyProject]; !ok {
+		return fmt.Errorf("missing %s key", confKeyProject)
+	}
+	if _, ok := conf[confKeyDefaultLogin]; !ok {
+		return fmt.Errorf("missing %s key", confKeyDefaultLogin)
+	}
+
+	return nil
+}
+
+func promptTokenOptions(repo repository.RepoKeyring, login, baseUrl string) (auth.Credential, error) {
+	creds, err := auth.List(repo,
+		auth.WithTarget(target),
+		auth.WithKind(auth.KindToken),
+		auth.WithMeta(auth.MetaKeyLogin, login),
+		auth.WithMeta(auth.MetaKeyBaseURL, baseUrl),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, index, err := input.PromptCredential(target, "token", creds, []string{
+		"enter my token",
+	})
+	switch {
+	case err != nil:
+		return nil, err
+	case cred != nil:
+		return cred, nil
+	case index == 0:
+		return promptToken(baseUrl)
+	default:
+		panic("missed case")
+	}
+}
+
+func promptToken(baseUrl string) (*auth.Token, error) {
+	fmt.Printf("You can generate a new token by visiting %s/user/settings/applications.\n", baseUrl)
+	fmt.Println("Choose 'Generate Token' and give it the read and write access to the issues")
+	fmt.Println("and the repository.")
+	fmt.Println()
+
+	var login string
+
+	validator := func(name string, value string) (complaint string, err error) {
+		login, err = getLoginFromToken(baseUrl, auth.NewToken(target, value))
+		if err != nil {
+			return fmt.Sprintf("token is invalid: %v", err), nil
+		}
+		return "", nil
+	}
+
+	rawToken, err := input.Prompt("Enter token", "token", input.Required, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	token := auth.NewToken(target, rawToken)
+	token.SetMetadata(auth.MetaKeyLogin, login)
+	token.SetMetadata(auth.MetaKeyBaseURL, baseUrl)
+
+	return token, nil
+}
+
+func promptProjectURL(repo repository.RepoCommon, baseUrl string) (string, error) {
+	validRemotes, err := getValidGiteaRemoteURLs(repo, baseUrl)
+	if err != nil {
+		return "", err
+	}
+
+	return input.PromptURLWithRemote("Gitea project URL", "URL", validRemotes, input.Required)
+}
+
+// baseURLFromProjectURL return the URL of the instance hosting a project,
+// assuming it's served at the root of the host
+func baseURLFromProjectURL(projectUrl string) (string, error) {
+	u, err := parseProjectURL(projectUrl)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host), nil
+}
+
+// splitProjectURL return the owner and the name of the project at the given
+// URL, which can be a web or a git remote URL
+func splitProjectURL(baseUrl, projectUrl string) (owner string, project string, err error) {
+	u, err := parseProjectURL(projectUrl)
+	if err != nil {
+		return "", "", err
+	}
+
+	base, err := url.Parse(baseUrl)
+	if err != nil || base.Host == "" {
+		return "", "", ErrBadProjectURL
+	}
+
+	if u.Hostname() != base.Hostname() {
+		return "", "", fmt.Errorf("base url and project url hostnames doesn't match")
+	}
+
+	// for a git remote, the instance sub-path is usually absent
+	p := strings.Trim(u.Path, "/")
+	basePath := strings.Trim(base.Path, "/")
+	if basePath != "" {
+		p = strings.TrimPrefix(strings.TrimPrefix(p, basePath), "/")
+	}
+
+	parts := strings.Split(p, "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", ErrBadProjectURL
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func parseProjectURL(projectUrl string) (*url.URL, error) {
+	cleanUrl := strings.TrimSuffix(strings.TrimSuffix(projectUrl, "/"), ".git")
+
+	// scp-like git remote: git@host:owner/project
+	if !strings.Contains(cleanUrl, "://") {
+		if i := strings.Index(cleanUrl, ":"); i >= 0 {
+			host := cleanUrl[:i]
+			if j := strings.Index(host, "@"); j >= 0 {
+				host = host[j+1:]
+			}
+			cleanUrl = fmt.Sprintf("https://%s/%s", host, cleanUrl[i+1:])
+		}
+	}
+
+	u, err := url.Parse(cleanUrl)
+	if err != nil || u.Host == "" {
+		return nil, ErrBadProjectURL
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+	default:
+		// ssh:// or git:// remote
+		u.Scheme = "https"
+		u.User = nil
+		u.Host = u.Hostname()
+	}
+
+	return u, nil
+}
+
+func getValidGiteaRemoteURLs(repo repository.RepoCommon, baseUrl string) ([]string, error) {
+	remotes, err := repo.GetRemotes()
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]string, 0, len(remotes))
+	for _, u := range remotes {
+		owner, project, err := splitProjectURL(baseUrl, u)
+		if err != nil {
+			continue
+		}
+
+		urls = append(urls, fmt.Sprintf("%s/%s/%s", baseUrl, owner, project))
+	}
+
+	sort.Strings(urls)
+
+	return urls, nil
+}
+
+func validateProject(baseUrl, owner, project string, token *auth.Token) error {
+	client := buildClient(baseUrl, token)
+
+	_, err := client.GetRepository(context.Background(), owner, project)
+	if err != nil {
+		return errors.Wrap(err, "wrong token scope or non-existent project")
+	}
+
+	return nil
+}
+
+func getLoginFromToken(baseUrl string, token *auth.Token) (string, error) {
+	client := buildClient(baseUrl, token)
+
+	user, err := client.CurrentUser(context.Background())
+	if err != nil {
+		return "", err
+	}
+	if user.Login == "" {
+		return "", fmt.Errorf("gitea say login is empty")
+	}
+
+	return user.Login, nil
+}
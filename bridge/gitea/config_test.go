@@ -0,0 +1,149 @@
+package gitea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSplitProjectURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		url     string
+		owner   string
+		project string
+		err     bool
+	}{
+		{
+			name:    "web url",
+			baseURL: "https://gitea.com",
+			url:     "https://gitea.com/MichaelMure/git-bug",
+			owner:   "MichaelMure",
+			project: "git-bug",
+		},
+		{
+			name:    "web url with trailing slash",
+			baseURL: "https://gitea.com/",
+			url:     "https://gitea.com/MichaelMure/git-bug/",
+			owner:   "MichaelMure",
+			project: "git-bug",
+		},
+		{
+			name:    "issue url",
+			baseURL: "https://gitea.com",
+			url:     "https://gitea.com/MichaelMure/git-bug/issues/12",
+			owner:   "MichaelMure",
+			project: "git-bug",
+		},
+		{
+			name:    "instance in a sub-path",
+			baseURL: "https://example.com/gitea",
+			url:     "https://example.com/gitea/MichaelMure/git-bug",
+			owner:   "MichaelMure",
+			project: "git-bug",
+		},
+		{
+			name:    "https remote",
+			baseURL: "https://gitea.com",
+			url:     "https://gitea.com/MichaelMure/git-bug.git",
+			owner:   "MichaelMure",
+			project: "git-bug",
+		},
+		{
+			name:    "scp-like ssh remote",
+			baseURL: "https://gitea.com",
+			url:     "git@gitea.com:MichaelMure/git-bug.git",
+			owner:   "MichaelMure",
+			project: "git-bug",
+		},
+		{
+			name:    "ssh remote",
+			baseURL: "https://gitea.com",
+			url:     "ssh://git@gitea.com:2222/MichaelMure/git-bug.git",
+			owner:   "MichaelMure",
+			project: "git-bug",
+		},
+		{
+			name:    "different host",
+			baseURL: "https://gitea.com",
+			url:     "https://codeberg.org/MichaelMure/git-bug",
+			err:     true,
+		},
+		{
+			name:    "missing project",
+			baseURL: "https://gitea.com",
+			url:     "https://gitea.com/MichaelMure",
+			err:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, project, err := splitProjectURL(tt.baseURL, tt.url)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.owner, owner)
+			assert.Equal(t, tt.project, project)
+		})
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	server := newFakeGitea("MichaelMure", "git-bug")
+	defer server.Close()
+	server.addUser("secret-token", User{ID: 1, Login: "michael", FullName: "Michael Muré"})
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	g := &Gitea{}
+
+	// wrong token
+	_, err = g.Configure(backend, core.BridgeParams{
+		URL:      server.repoURL(),
+		TokenRaw: "wrong-token",
+	})
+	require.Error(t, err)
+
+	conf, err := g.Configure(backend, core.BridgeParams{
+		URL:      server.repoURL(),
+		TokenRaw: "secret-token",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, core.Configuration{
+		core.ConfigKeyTarget: target,
+		confKeyGiteaBaseUrl:  server.URL,
+		confKeyOwner:         "MichaelMure",
+		confKeyProject:       "git-bug",
+		confKeyDefaultLogin:  "michael",
+	}, conf)
+
+	// the token is stored, tagged with the login
+	creds, err := auth.List(backend,
+		auth.WithTarget(target),
+		auth.WithMeta(auth.MetaKeyLogin, "michael"),
+		auth.WithMeta(auth.MetaKeyBaseURL, server.URL),
+	)
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+
+	// and the user identity as well
+	user, err := backend.GetUserIdentity()
+	require.NoError(t, err)
+	require.Equal(t, "michael", user.ImmutableMetadata()[metaKeyGiteaLogin])
+}
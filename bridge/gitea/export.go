@@ -0,0 +1,590 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+var (
+	ErrMissingIdentityToken = errors.New("missing identity token")
+)
+
+// giteaExporter implement the Exporter interface
+type giteaExporter struct {
+	conf core.Configuration
+
+	// cache identities clients
+	identityClient map[entity.Id]*client
+
+	// cache the gitea comment ID of the exported or imported comments
+	cachedOperationIDs map[entity.Id]int64
+
+	// cache gitea labels ID by name
+	cachedLabels map[string]int64
+
+	// only describe the changes
+	dryRun bool
+}
+
+// Init .
+func (ge *giteaExporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
+	ge.conf = conf
+	ge.dryRun = dryRun
+	ge.identityClient = make(map[entity.Id]*client)
+	ge.cachedOperationIDs = make(map[entity.Id]int64)
+
+	// preload all clients
+	err := ge.cacheAllClient(repo)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ge *giteaExporter) cacheAllClient(repo *cache.RepoCache) error {
+	creds, err := auth.List(repo,
+		auth.WithTarget(target),
+		auth.WithKind(auth.KindToken),
+		auth.WithMeta(auth.MetaKeyBaseURL, ge.conf[confKeyGiteaBaseUrl]),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, cred := range creds {
+		login, ok := cred.GetMetadata(auth.MetaKeyLogin)
+		if !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "credential %s is not tagged with a Gitea login\n", cred.ID().Human())
+			continue
+		}
+
+		user, err := repo.ResolveIdentityImmutableMetadata(metaKeyGiteaLogin, login)
+		if err == identity.ErrIdentityNotExist {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, ok := ge.identityClient[user.Id()]; !ok {
+			ge.identityClient[user.Id()] = buildClient(ge.conf[confKeyGiteaBaseUrl], cred.(*auth.Token))
+		}
+	}
+
+	return nil
+}
+
+// getIdentityClient return a Gitea API client configured with the access token of the given identity.
+func (ge *giteaExporter) getIdentityClient(userId entity.Id) (*client, error) {
+	client, ok := ge.identityClient[userId]
+	if ok {
+		return client, nil
+	}
+
+	return nil, ErrMissingIdentityToken
+}
+
+// ExportAll export all event made by the current user to Gitea
+func (ge *giteaExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, ids []entity.Id, since time.Time) (<-chan core.ExportResult, error) {
+	out := make(chan core.ExportResult)
+
+	go func() {
+		defer close(out)
+
+		allIdentitiesIds := make([]entity.Id, 0, len(ge.identityClient))
+		for id := range ge.identityClient {
+			allIdentitiesIds = append(allIdentitiesIds, id)
+		}
+
+		for _, id := range ids {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				b, err := repo.ResolveBug(id)
+				if err != nil {
+					out <- core.NewExportError(err, id)
+					return
+				}
+
+				snapshot := b.Snapshot()
+
+				// ignore issues created before since date
+				// TODO: compare the Lamport time instead of using the unix time
+				if snapshot.CreateTime.Before(since) {
+					out <- core.NewExportNothing(b.Id(), "bug created before the since date")
+					continue
+				}
+
+				if snapshot.HasAnyActor(allIdentitiesIds...) {
+					// try to export the bug and it associated events
+					ge.exportBug(ctx, b, out)
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// exportBug publish bugs and related events
+func (ge *giteaExporter) exportBug(ctx context.Context, b *cache.BugCache, out chan<- core.ExportResult) {
+	snapshot := b.Snapshot()
+
+	var bugUpdated bool
+	var issueNumber int64
+	var issueURL string
+
+	owner, project := ge.conf[confKeyOwner], ge.conf[confKeyProject]
+
+	// skip bug if origin is not allowed
+	origin, ok := snapshot.GetCreateMetadata(core.MetaKeyOrigin)
+	if ok && origin != target {
+		out <- core.NewExportNothing(b.Id(), fmt.Sprintf("issue tagged with origin: %s", origin))
+		return
+	}
+
+	// first operation is always createOp
+	createOp := snapshot.Operations[0].(*bug.CreateOperation)
+	author := snapshot.Author
+
+	// get gitea issue number
+	giteaID, ok := snapshot.GetCreateMetadata(metaKeyGiteaId)
+	if ok {
+		giteaBaseUrl, ok := snapshot.GetCreateMetadata(metaKeyGiteaBaseUrl)
+		if ok && giteaBaseUrl != ge.conf[confKeyGiteaBaseUrl] {
+			out <- core.NewExportNothing(b.Id(), "skipping issue imported from another Gitea instance")
+			return
+		}
+
+		giteaProject, ok := snapshot.GetCreateMetadata(metaKeyGiteaProject)
+		if !ok {
+			err := fmt.Errorf("expected to find gitea project")
+			out <- core.NewExportError(err, b.Id())
+			return
+		}
+
+		if giteaProject != ge.projectPath() {
+			out <- core.NewExportNothing(b.Id(), "skipping issue imported from another repository")
+			return
+		}
+
+		var err error
+		issueNumber, err = strconv.ParseInt(giteaID, 10, 64)
+		if err != nil {
+			out <- core.NewExportError(fmt.Errorf("unexpected gitea id format: %s", giteaID), b.Id())
+			return
+		}
+		issueURL, _ = snapshot.GetCreateMetadata(metaKeyGiteaUrl)
+
+	} else {
+		// check that we have a token for operation author
+		client, err := ge.getIdentityClient(author.Id())
+		if err != nil {
+			// if bug is still not exported and we do not have the author stop the execution
+			out <- core.NewExportNothing(b.Id(), fmt.Sprintf("missing author token"))
+			return
+		}
+
+		if ge.dryRun {
+			out <- core.NewExportDryRun(core.ExportEventBug, b.Id(), fmt.Sprintf("create the issue \"%s\"", createOp.Title))
+			bugUpdated = true
+		} else {
+			// create bug
+			issue, err := client.CreateIssue(ctx, owner, project, createOp.Title, createOp.Message)
+			if err != nil {
+				err := errors.Wrap(err, "exporting gitea issue")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportBug(b.Id())
+
+			_, err = b.SetMetadata(
+				createOp.Id(),
+				map[string]string{
+					metaKeyGiteaId:      parseID(issue.Number),
+					metaKeyGiteaUrl:     issue.HTMLURL,
+					metaKeyGiteaProject: ge.projectPath(),
+					metaKeyGiteaBaseUrl: ge.conf[confKeyGiteaBaseUrl],
+				},
+			)
+			if err != nil {
+				err := errors.Wrap(err, "marking operation as exported")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			// commit operation to avoid creating multiple issues with multiple pushes
+			if err := b.CommitAsNeeded(); err != nil {
+				err := errors.Wrap(err, "bug commit")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			issueNumber = issue.Number
+			issueURL = issue.HTMLURL
+		}
+	}
+
+	// gitea need the complete list of labels at each change, so we track
+	// them across all operations, exported or not
+	labelSet := make(map[string]struct{})
+	for _, op := range snapshot.Operations[1:] {
+		// ignore SetMetadata operations
+		if _, ok := op.(*bug.SetMetadataOperation); ok {
+			continue
+		}
+
+		if op, ok := op.(*bug.LabelChangeOperation); ok {
+			for _, label := range op.Added {
+				labelSet[label.String()] = struct{}{}
+			}
+			for _, label := range op.Removed {
+				delete(labelSet, label.String())
+			}
+		}
+
+		// ignore operations already existing in gitea (due to import or export)
+		// and cache the ID of the comments
+		if _, ok := op.GetMetadata(metaKeyGiteaUrl); ok {
+			if id, ok := op.GetMetadata(metaKeyGiteaCommentId); ok {
+				if commentID, err := strconv.ParseInt(id, 10, 64); err == nil {
+					ge.cachedOperationIDs[op.Id()] = commentID
+				}
+			}
+			continue
+		}
+
+		opAuthor := op.GetAuthor()
+		client, err := ge.getIdentityClient(opAuthor.Id())
+		if err != nil {
+			continue
+		}
+
+		// the timeline event created, 0 if none
+		var eventID int64
+		url := issueURL
+
+		switch op := op.(type) {
+		case *bug.AddCommentOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			comment, err := client.CreateComment(ctx, owner, project, issueNumber, op.Message)
+			if err != nil {
+				err := errors.Wrap(err, "adding comment")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportComment(op.Id())
+
+			eventID = comment.ID
+			url = comment.HTMLURL
+			// cache comment id
+			ge.cachedOperationIDs[op.Id()] = comment.ID
+
+		case *bug.EditCommentOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			// Since gitea doesn't consider the issue body as a comment
+			if op.Target == createOp.Id() {
+				// case bug creation operation: we need to edit the Gitea issue
+				_, err := client.EditIssue(ctx, owner, project, issueNumber, EditIssueOption{Body: &op.Message})
+				if err != nil {
+					err := errors.Wrap(err, "editing issue")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+
+			} else {
+				// case comment edition operation: we need to edit the Gitea comment
+				commentID, ok := ge.cachedOperationIDs[op.Target]
+				if !ok {
+					out <- core.NewExportError(fmt.Errorf("unexpected error: comment id not found"), op.Target)
+					return
+				}
+
+				comment, err := client.EditComment(ctx, owner, project, commentID, op.Message)
+				if err != nil {
+					err := errors.Wrap(err, "editing comment")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+
+				url = comment.HTMLURL
+			}
+
+			out <- core.NewExportCommentEdition(op.Id())
+
+		case *bug.SetStatusOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			state := "open"
+			if op.Status == bug.ClosedStatus {
+				state = "closed"
+			}
+
+			_, err := client.EditIssue(ctx, owner, project, issueNumber, EditIssueOption{State: &state})
+			if err != nil {
+				err := errors.Wrap(err, "editing status")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportStatusChange(op.Id())
+
+			eventID, err = lastTimelineEventID(ctx, client, owner, project, issueNumber, eventClose, eventReopen)
+			if err != nil {
+				err := errors.Wrap(err, "fetching timeline")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+		case *bug.SetTitleOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			_, err := client.EditIssue(ctx, owner, project, issueNumber, EditIssueOption{Title: &op.Title})
+			if err != nil {
+				err := errors.Wrap(err, "editing title")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportTitleEdition(op.Id())
+
+			eventID, err = lastTimelineEventID(ctx, client, owner, project, issueNumber, eventChangeTitle)
+			if err != nil {
+				err := errors.Wrap(err, "fetching timeline")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+		case *bug.LabelChangeOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			labelIDs, err := ge.getOrCreateLabelIDs(ctx, client, labelSet)
+			if err != nil {
+				err := errors.Wrap(err, "creating labels")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			if err := client.ReplaceIssueLabels(ctx, owner, project, issueNumber, labelIDs); err != nil {
+				err := errors.Wrap(err, "updating labels")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportLabelChange(op.Id())
+
+			eventID, err = lastTimelineEventID(ctx, client, owner, project, issueNumber, eventLabel)
+			if err != nil {
+				err := errors.Wrap(err, "fetching timeline")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+		case *bug.SetAssigneesOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "assignees are not exported to Gitea")
+			continue
+
+		case *bug.SetMilestoneOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "milestones are not exported to Gitea")
+			continue
+
+		case *bug.SetLinksOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "links are not exported to Gitea")
+			continue
+
+		case *bug.AddReactionOperation, *bug.RemoveReactionOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "reactions are not exported to Gitea")
+			continue
+
+		case *bug.RedactCommentOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			// the issue body can't be removed, only blanked
+			if op.Target == createOp.Id() {
+				empty := ""
+				_, err := client.EditIssue(ctx, owner, project, issueNumber, EditIssueOption{Body: &empty})
+				if err != nil {
+					err := errors.Wrap(err, "redacting issue body")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+
+			} else {
+				commentID, ok := ge.cachedOperationIDs[op.Target]
+				if !ok {
+					out <- core.NewExportNothing(op.Id(), "the redacted comment is not on Gitea")
+					continue
+				}
+
+				err := client.DeleteComment(ctx, owner, project, commentID)
+				if err != nil {
+					err := errors.Wrap(err, "deleting comment")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+			}
+
+			out <- core.NewExportCommentRedaction(op.Id())
+
+		default:
+			panic("unhandled operation type case")
+		}
+
+		// mark operation as exported
+		if err := markOperationAsExported(b, op.Id(), eventID, url); err != nil {
+			err := errors.Wrap(err, "marking operation as exported")
+			out <- core.NewExportError(err, b.Id())
+			return
+		}
+
+		// commit at each operation export to avoid exporting same events multiple times
+		if err := b.CommitAsNeeded(); err != nil {
+			err := errors.Wrap(err, "bug commit")
+			out <- core.NewExportError(err, b.Id())
+			return
+		}
+
+		bugUpdated = true
+	}
+
+	if !bugUpdated {
+		out <- core.NewExportNothing(b.Id(), "nothing has been exported")
+	}
+}
+
+// markOperationAsExported tag the operation with the url of the exported item
+// and, if any, the id of the timeline event it created
+func markOperationAsExported(b *cache.BugCache, target entity.Id, eventID int64, giteaURL string) error {
+	metadata := map[string]string{
+		metaKeyGiteaUrl: giteaURL,
+	}
+	if eventID != 0 {
+		metadata[metaKeyGiteaCommentId] = parseID(eventID)
+	}
+
+	_, err := b.SetMetadata(target, metadata)
+
+	return err
+}
+
+// lastTimelineEventID return the id of the latest timeline event of the given
+// types, or 0 if there is none. Gitea doesn't return the event created when
+// an issue is updated, so this is used right after.
+func lastTimelineEventID(ctx context.Context, c *client, owner, project string, number int64, types ...string) (int64, error) {
+	events, err := listTimeline(ctx, c, owner, project, number)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := len(events) - 1; i >= 0; i-- {
+		for _, t := range types {
+			if events[i].Type == t {
+				return events[i].ID, nil
+			}
+		}
+	}
+
+	return 0, nil
+}
+
+// getOrCreateLabelIDs return the gitea IDs of the given labels, creating the
+// missing ones in the repository
+func (ge *giteaExporter) getOrCreateLabelIDs(ctx context.Context, c *client, labelSet map[string]struct{}) ([]int64, error) {
+	owner, project := ge.conf[confKeyOwner], ge.conf[confKeyProject]
+
+	if ge.cachedLabels == nil {
+		ge.cachedLabels = make(map[string]int64)
+
+		for page := 1; ; page++ {
+			labels, err := c.ListLabels(ctx, owner, project, page)
+			if err != nil {
+				return nil, err
+			}
+			for _, label := range labels {
+				ge.cachedLabels[label.Name] = label.ID
+			}
+			if len(labels) < pageSize {
+				break
+			}
+		}
+	}
+
+	names := make([]string, 0, len(labelSet))
+	for name := range labelSet {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// an empty list, not null, remove all the labels
+	ids := make([]int64, 0, len(names))
+	for _, name := range names {
+		id, ok := ge.cachedLabels[name]
+		if !ok {
+			// RGBA to hex color
+			rgba := bug.Label(name).Color().RGBA()
+			hexColor := fmt.Sprintf("#%.2x%.2x%.2x", rgba.R, rgba.G, rgba.B)
+
+			label, err := c.CreateLabel(ctx, owner, project, name, hexColor)
+			if err != nil {
+				return nil, err
+			}
+			id = label.ID
+			ge.cachedLabels[name] = id
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// projectPath return the owner/project path of the configured repository
+func (ge *giteaExporter) projectPath() string {
+	return fmt.Sprintf("%s/%s", ge.conf[confKeyOwner], ge.conf[confKeyProject])
+}
@@ -0,0 +1,324 @@
+package gitea
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+type testCase struct {
+	name     string
+	bug      *cache.BugCache
+	numOp    int // number of original operations
+	numOpExp int // number of operations after export
+	numOpImp int // number of operations after import
+}
+
+func testCases(t *testing.T, repo *cache.RepoCache) []*testCase {
+	// simple bug
+	simpleBug, _, err := repo.NewBug("simple bug", "new bug")
+	require.NoError(t, err)
+
+	// bug with comments
+	bugWithComments, _, err := repo.NewBug("bug with comments", "new bug")
+	require.NoError(t, err)
+
+	_, err = bugWithComments.AddComment("new comment")
+	require.NoError(t, err)
+
+	// bug with label changes
+	bugLabelChange, _, err := repo.NewBug("bug label change", "new bug")
+	require.NoError(t, err)
+
+	_, _, err = bugLabelChange.ChangeLabels([]string{"bug", "core"}, nil)
+	require.NoError(t, err)
+
+	_, _, err = bugLabelChange.ChangeLabels([]string{"ui"}, nil)
+	require.NoError(t, err)
+
+	_, _, err = bugLabelChange.ChangeLabels(nil, []string{"bug"})
+	require.NoError(t, err)
+
+	// bug with comments editions
+	bugWithCommentEditions, createOp, err := repo.NewBug("bug with comments editions", "new bug")
+	require.NoError(t, err)
+
+	_, err = bugWithCommentEditions.EditComment(createOp.Id(), "first comment edited")
+	require.NoError(t, err)
+
+	commentOp, err := bugWithCommentEditions.AddComment("first comment")
+	require.NoError(t, err)
+
+	_, err = bugWithCommentEditions.EditComment(commentOp.Id(), "first comment edited")
+	require.NoError(t, err)
+
+	// bug status changed
+	bugStatusChanged, _, err := repo.NewBug("bug status changed", "new bug")
+	require.NoError(t, err)
+
+	_, err = bugStatusChanged.Close()
+	require.NoError(t, err)
+
+	_, err = bugStatusChanged.Open()
+	require.NoError(t, err)
+
+	// bug title changed
+	bugTitleEdited, _, err := repo.NewBug("bug title edited", "new bug")
+	require.NoError(t, err)
+
+	_, err = bugTitleEdited.SetTitle("bug title edited again")
+	require.NoError(t, err)
+
+	// bug with redacted comments
+	bugWithRedactions, createOp, err := repo.NewBug("bug with redactions", "secret bug")
+	require.NoError(t, err)
+
+	commentOp, err = bugWithRedactions.AddComment("secret comment")
+	require.NoError(t, err)
+
+	_, err = bugWithRedactions.AddComment("public comment")
+	require.NoError(t, err)
+
+	_, err = bugWithRedactions.RedactComment(createOp.Id())
+	require.NoError(t, err)
+
+	_, err = bugWithRedactions.RedactComment(commentOp.Id())
+	require.NoError(t, err)
+
+	return []*testCase{
+		{
+			name:     "simple bug",
+			bug:      simpleBug,
+			numOp:    1,
+			numOpExp: 2,
+			numOpImp: 1,
+		},
+		{
+			name:     "bug with comments",
+			bug:      bugWithComments,
+			numOp:    2,
+			numOpExp: 4,
+			numOpImp: 2,
+		},
+		{
+			name:     "bug label change",
+			bug:      bugLabelChange,
+			numOp:    4,
+			numOpExp: 8,
+			numOpImp: 4,
+		},
+		{
+			name:     "bug with comment editions",
+			bug:      bugWithCommentEditions,
+			numOp:    4,
+			numOpExp: 8,
+			// the issue description and the comment are imported in their
+			// latest version
+			numOpImp: 2,
+		},
+		{
+			name:     "bug changed status",
+			bug:      bugStatusChanged,
+			numOp:    3,
+			numOpExp: 6,
+			numOpImp: 3,
+		},
+		{
+			name:     "bug title edited",
+			bug:      bugTitleEdited,
+			numOp:    2,
+			numOpExp: 4,
+			numOpImp: 2,
+		},
+		{
+			name:     "bug with redactions",
+			bug:      bugWithRedactions,
+			numOp:    5,
+			numOpExp: 10,
+			// the redacted comment is removed from Gitea
+			numOpImp: 2,
+		},
+	}
+}
+
+func TestPushPull(t *testing.T) {
+	server := newFakeGitea("git-bug", "test")
+	defer server.Close()
+
+	login := "test-identity"
+	server.addUser("test-token", User{ID: 1, Login: login, FullName: "test identity"})
+
+	// create repo backend
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	// set author identity
+	author, err := backend.NewIdentity("test identity", "test@test.org")
+	require.NoError(t, err)
+	author.SetMetadata(metaKeyGiteaLogin, login)
+	err = author.Commit()
+	require.NoError(t, err)
+
+	err = backend.SetUserIdentity(author)
+	require.NoError(t, err)
+
+	token := auth.NewToken(target, "test-token")
+	token.SetMetadata(auth.MetaKeyLogin, login)
+	token.SetMetadata(auth.MetaKeyBaseURL, server.URL)
+	err = auth.Store(repo, token)
+	require.NoError(t, err)
+
+	tests := testCases(t, backend)
+
+	conf := core.Configuration{
+		confKeyGiteaBaseUrl: server.URL,
+		confKeyOwner:        "git-bug",
+		confKeyProject:      "test",
+		confKeyDefaultLogin: login,
+	}
+
+	ctx := context.Background()
+
+	// a dry-run only describe the changes
+	dryRunExporter := &giteaExporter{}
+	err = dryRunExporter.Init(ctx, backend, conf, true)
+	require.NoError(t, err)
+
+	exportEvents, err := dryRunExporter.ExportAll(ctx, backend, backend.AllBugsIds(), time.Time{})
+	require.NoError(t, err)
+
+	dryRunIssues := 0
+	for result := range exportEvents {
+		require.NoError(t, result.Err)
+		require.True(t, result.DryRun, result.String())
+		if result.Event == core.ExportEventBug {
+			dryRunIssues++
+		}
+	}
+	require.Equal(t, len(tests), dryRunIssues)
+
+	for _, tt := range tests {
+		require.Len(t, tt.bug.Snapshot().Operations, tt.numOp, tt.name)
+	}
+
+	// initialize exporter
+	exporter := &giteaExporter{}
+	err = exporter.Init(ctx, backend, conf, false)
+	require.NoError(t, err)
+
+	// export all bugs
+	exportEvents, err = exporter.ExportAll(ctx, backend, backend.AllBugsIds(), time.Time{})
+	require.NoError(t, err)
+
+	for result := range exportEvents {
+		require.NoError(t, result.Err)
+	}
+
+	repoTwo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repoTwo)
+
+	// create a second backend
+	backendTwo, err := cache.NewRepoCache(repoTwo)
+	require.NoError(t, err)
+	defer backendTwo.Close()
+
+	importer := &giteaImporter{}
+	err = importer.Init(ctx, backend, conf, false)
+	require.NoError(t, err)
+
+	// import all exported bugs to the second backend
+	importEvents, err := importer.ImportAll(ctx, backendTwo, time.Time{})
+	require.NoError(t, err)
+
+	for result := range importEvents {
+		require.NoError(t, result.Err)
+	}
+
+	require.Len(t, backendTwo.AllBugsIds(), len(tests))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// for each operation a SetMetadataOperation will be added
+			// so number of operations should double
+			require.Len(t, tt.bug.Snapshot().Operations, tt.numOpExp)
+
+			// verify operation have correct metadata
+			for _, op := range tt.bug.Snapshot().Operations {
+				// Check if the originals operations (*not* SetMetadata) are tagged properly
+				if _, ok := op.(*bug.SetMetadataOperation); !ok {
+					_, haveURLMetada := op.GetMetadata(metaKeyGiteaUrl)
+					require.True(t, haveURLMetada)
+				}
+			}
+
+			// get bug gitea ID
+			bugGiteaID, ok := tt.bug.Snapshot().GetCreateMetadata(metaKeyGiteaId)
+			require.True(t, ok)
+
+			// retrieve bug from backendTwo
+			importedBug, err := backendTwo.ResolveBugCreateMetadata(metaKeyGiteaId, bugGiteaID)
+			require.NoError(t, err)
+
+			// verify bug have same number of original operations
+			require.Len(t, importedBug.Snapshot().Operations, tt.numOpImp)
+
+			// verify bugs are tagged with origin=gitea
+			issueOrigin, ok := importedBug.Snapshot().GetCreateMetadata(core.MetaKeyOrigin)
+			require.True(t, ok)
+			require.Equal(t, issueOrigin, target)
+
+			// verify the final state
+			exported := tt.bug.Snapshot()
+			imported := importedBug.Snapshot()
+			require.Equal(t, exported.Title, imported.Title)
+			require.Equal(t, exported.Status, imported.Status)
+			require.ElementsMatch(t, exported.Labels, imported.Labels)
+			// the redacted comments are removed from Gitea, except the
+			// issue description
+			var comments []bug.Comment
+			for i, comment := range exported.Comments {
+				if i == 0 || !comment.Redacted {
+					comments = append(comments, comment)
+				}
+			}
+			require.Equal(t, len(comments), len(imported.Comments))
+			for i := range comments {
+				require.Equal(t, comments[i].Message, imported.Comments[i].Message)
+			}
+		})
+	}
+
+	// importing back in the original repository doesn't duplicate anything
+	importEvents, err = importer.ImportAll(ctx, backend, time.Time{})
+	require.NoError(t, err)
+
+	for result := range importEvents {
+		require.NoError(t, result.Err)
+		require.NotEqual(t, core.ImportEventBug, result.Event, result.String())
+	}
+
+	for _, tt := range tests {
+		require.Len(t, tt.bug.Snapshot().Operations, tt.numOpExp, tt.name)
+	}
+
+	// and nothing is left to export
+	exportEvents, err = exporter.ExportAll(ctx, backend, backend.AllBugsIds(), time.Time{})
+	require.NoError(t, err)
+
+	for result := range exportEvents {
+		require.NoError(t, result.Err)
+		require.Equal(t, core.ExportEventNothing, result.Event)
+	}
+}
@@ -0,0 +1,55 @@
+// Package gitea contains the Gitea bridge implementation. As Forgejo expose
+// the same REST API, it works for both.
+package gitea
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+)
+
+const (
+	target = "gitea"
+
+	// the issue number, on the create operation
+	metaKeyGiteaId = "gitea-id"
+	// the id of a timeline event (comments, status, title and label changes
+	// are all comments for Gitea), on the other operations
+	metaKeyGiteaCommentId = "gitea-comment-id"
+	metaKeyGiteaUrl       = "gitea-url"
+	metaKeyGiteaLogin     = "gitea-login"
+	metaKeyGiteaProject   = "gitea-project"
+	metaKeyGiteaBaseUrl   = "gitea-base-url"
+
+	confKeyGiteaBaseUrl = "base-url"
+	confKeyOwner        = "owner"
+	confKeyProject      = "project"
+	confKeyDefaultLogin = "default-login"
+
+	defaultTimeout = 60 * time.Second
+)
+
+var _ core.BridgeImpl = &Gitea{}
+
+type Gitea struct{}
+
+func (Gitea) Target() string {
+	return target
+}
+
+func (g *Gitea) LoginMetaKey() string {
+	return metaKeyGiteaLogin
+}
+
+func (Gitea) NewImporter() core.Importer {
+	return &giteaImporter{}
+}
+
+func (Gitea) NewExporter() core.Exporter {
+	return &giteaExporter{}
+}
+
+func buildClient(baseURL string, token *auth.Token) *client {
+	return newClient(baseURL, token.Value)
+}
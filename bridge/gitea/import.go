@@ -0,0 +1,638 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// giteaImporter implement the Importer interface
+type giteaImporter struct {
+	conf core.Configuration
+
+	// default client
+	client *client
+
+	// send only channel
+	out chan<- core.ImportResult
+
+	// select the issues to import
+	filter *core.ImportFilter
+
+	// only describe the changes, and the identities already described
+	dryRun           bool
+	dryRunIdentities map[string]bool
+}
+
+func (gi *giteaImporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
+	gi.conf = conf
+	gi.dryRun = dryRun
+	gi.dryRunIdentities = make(map[string]bool)
+
+	filter, err := core.NewImportFilter(conf)
+	if err != nil {
+		return err
+	}
+	gi.filter = filter
+
+	creds, err := auth.List(repo,
+		auth.WithTarget(target),
+		auth.WithKind(auth.KindToken),
+		auth.WithMeta(auth.MetaKeyBaseURL, conf[confKeyGiteaBaseUrl]),
+		auth.WithMeta(auth.MetaKeyLogin, conf[confKeyDefaultLogin]),
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(creds) == 0 {
+		return ErrMissingIdentityToken
+	}
+
+	gi.client = buildClient(conf[confKeyGiteaBaseUrl], creds[0].(*auth.Token))
+
+	return nil
+}
+
+// ImportAll iterate over all the configured repository issues and their timeline
+// and ensure the creation of the missing issues / comments / label events /
+// title changes ...
+func (gi *giteaImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ImportResult, error) {
+	out := make(chan core.ImportResult)
+	gi.out = out
+
+	go func() {
+		defer close(gi.out)
+
+		for page := 1; ; page++ {
+			issues, err := gi.client.ListIssues(ctx, gi.conf[confKeyOwner], gi.conf[confKeyProject], since, page)
+			if err != nil {
+				out <- core.NewImportError(err, "")
+				return
+			}
+
+			for _, issue := range issues {
+				if issue.PullRequest != nil {
+					continue
+				}
+
+				reason, err := gi.skipIssue(repo, issue)
+				if err != nil {
+					out <- core.NewImportError(err, "")
+					return
+				}
+				if reason != "" {
+					out <- core.NewImportNothing("", fmt.Sprintf("issue %s: %s", issue.HTMLURL, reason))
+					continue
+				}
+
+				if err := gi.importIssue(ctx, repo, issue); err != nil {
+					out <- core.NewImportError(err, "")
+					return
+				}
+			}
+
+			if len(issues) < pageSize {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// skipIssue return why an issue is filtered out of the import, if it is. The
+// issues already imported are always kept up to date.
+func (gi *giteaImporter) skipIssue(repo *cache.RepoCache, issue Issue) (string, error) {
+	_, err := gi.resolveIssue(repo, issue)
+	if err == nil {
+		return "", nil
+	}
+	if err != bug.ErrBugNotExist {
+		return "", err
+	}
+
+	labels := make([]string, len(issue.Labels))
+	for i, label := range issue.Labels {
+		labels[i] = label.Name
+	}
+	return gi.filter.Skip(labels, issue.State != "closed", issue.User.Login), nil
+}
+
+// resolveIssue return the bug imported from an issue. The origin is not
+// checked to also match the exported bugs.
+func (gi *giteaImporter) resolveIssue(repo *cache.RepoCache, issue Issue) (*cache.BugCache, error) {
+	return repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
+		return excerpt.CreateMetadata[metaKeyGiteaId] == parseID(issue.Number) &&
+			excerpt.CreateMetadata[metaKeyGiteaBaseUrl] == gi.conf[confKeyGiteaBaseUrl] &&
+			excerpt.CreateMetadata[metaKeyGiteaProject] == gi.projectPath()
+	})
+}
+
+func (gi *giteaImporter) importIssue(ctx context.Context, repo *cache.RepoCache, issue Issue) error {
+	events, err := listTimeline(ctx, gi.client, gi.conf[confKeyOwner], gi.conf[confKeyProject], issue.Number)
+	if err != nil {
+		return err
+	}
+
+	// create issue
+	b, err := gi.ensureIssue(repo, issue, events)
+	if err != nil {
+		return errors.Wrap(err, "issue creation")
+	}
+
+	if b == nil {
+		// a new bug in dry-run mode, its content would be imported with it
+		return nil
+	}
+
+	// Loop over all timeline events
+	for i := 0; i < len(events); i++ {
+		if events[i].Type != eventLabel {
+			if err := gi.ensureTimelineEvent(repo, b, issue, events[i]); err != nil {
+				return errors.Wrap(err, "timeline event creation")
+			}
+			continue
+		}
+
+		// Gitea record a label event for each label changed at once, they are
+		// grouped back in a single operation
+		j := i + 1
+		for j < len(events) && events[j].Type == eventLabel &&
+			events[j].User.Login == events[i].User.Login &&
+			events[j].Created.Equal(events[i].Created) {
+			j++
+		}
+
+		if err := gi.ensureLabelEvents(repo, b, issue, events[i:j]); err != nil {
+			return errors.Wrap(err, "label event creation")
+		}
+		i = j - 1
+	}
+
+	if err := gi.ensureIssueEdition(repo, b, issue); err != nil {
+		return errors.Wrap(err, "issue edition")
+	}
+
+	if gi.dryRun {
+		return nil
+	}
+
+	if !b.NeedCommit() {
+		gi.out <- core.NewImportNothing(b.Id(), "no imported operation")
+	} else if err := b.Commit(); err != nil {
+		// commit bug state
+		return errors.Wrap(err, "bug commit")
+	}
+
+	return nil
+}
+
+func (gi *giteaImporter) ensureIssue(repo *cache.RepoCache, issue Issue, events []TimelineEvent) (*cache.BugCache, error) {
+	// ensure issue author
+	author, err := gi.ensurePerson(repo, issue.User)
+	if err != nil {
+		return nil, err
+	}
+
+	// resolve bug
+	b, err := gi.resolveIssue(repo, issue)
+	if err == nil {
+		return b, nil
+	}
+	if err != bug.ErrBugNotExist {
+		return nil, err
+	}
+
+	// if bug was never imported
+	cleanText, err := text.Cleanup(issue.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the issue hold the current title, the original one is in the first
+	// title change, if any
+	title := issue.Title
+	for _, event := range events {
+		if event.Type == eventChangeTitle {
+			title = event.OldTitle
+			break
+		}
+	}
+
+	if gi.dryRun {
+		gi.out <- core.NewImportDryRun(core.ImportEventBug, "", fmt.Sprintf("create the bug \"%s\"", title))
+		return nil, nil
+	}
+
+	// create bug
+	b, _, err = repo.NewBugRaw(
+		author,
+		issue.Created.Unix(),
+		title,
+		cleanText,
+		nil,
+		map[string]string{
+			core.MetaKeyOrigin:  target,
+			metaKeyGiteaId:      parseID(issue.Number),
+			metaKeyGiteaUrl:     issue.HTMLURL,
+			metaKeyGiteaProject: gi.projectPath(),
+			metaKeyGiteaBaseUrl: gi.conf[confKeyGiteaBaseUrl],
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// importing a new bug
+	gi.out <- core.NewImportBug(b.Id())
+
+	return b, nil
+}
+
+// ensureIssueEdition import the edition of the issue description. As Gitea
+// doesn't provide the history of the description, only the latest version is
+// imported.
+func (gi *giteaImporter) ensureIssueEdition(repo *cache.RepoCache, b *cache.BugCache, issue Issue) error {
+	firstComment := b.Snapshot().Comments[0]
+	if firstComment.Redacted {
+		return nil
+	}
+
+	cleanText, err := text.Cleanup(issue.Body)
+	if err != nil {
+		return err
+	}
+
+	if firstComment.Message == cleanText {
+		return nil
+	}
+
+	if gi.dryRun {
+		gi.out <- core.NewImportDryRun(core.ImportEventCommentEdition, b.Id(), "edit the description")
+		return nil
+	}
+
+	author, err := gi.ensurePerson(repo, issue.User)
+	if err != nil {
+		return err
+	}
+
+	op, err := b.EditCommentRaw(
+		author,
+		issue.Updated.Unix(),
+		firstComment.Id(),
+		cleanText,
+		map[string]string{
+			metaKeyGiteaUrl: issue.HTMLURL,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	gi.out <- core.NewImportCommentEdition(op.Id())
+
+	return nil
+}
+
+func (gi *giteaImporter) ensureTimelineEvent(repo *cache.RepoCache, b *cache.BugCache, issue Issue, event TimelineEvent) error {
+	switch event.Type {
+	case eventComment, eventClose, eventReopen, eventChangeTitle:
+	default:
+		// not supported
+		return nil
+	}
+
+	giteaID := parseID(event.ID)
+	giteaURL := event.HTMLURL
+	if giteaURL == "" {
+		giteaURL = issue.HTMLURL
+	}
+
+	id, errResolve := b.ResolveOperationWithMetadata(metaKeyGiteaCommentId, giteaID)
+	if errResolve != nil && errResolve != cache.ErrNoMatchingOp {
+		return errResolve
+	}
+
+	// ensure event author
+	author, err := gi.ensurePerson(repo, event.User)
+	if err != nil {
+		return err
+	}
+
+	if gi.dryRun {
+		return gi.dryRunTimelineEvent(b, event, id, errResolve)
+	}
+
+	switch event.Type {
+	case eventClose:
+		if errResolve == nil {
+			return nil
+		}
+
+		op, err := b.ImportStatusRaw(
+			author,
+			event.Created.Unix(),
+			bug.ClosedStatus,
+			"",
+			map[string]string{
+				metaKeyGiteaCommentId: giteaID,
+				metaKeyGiteaUrl:       giteaURL,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportStatusChange(op.Id())
+
+	case eventReopen:
+		if errResolve == nil {
+			return nil
+		}
+
+		op, err := b.ImportStatusRaw(
+			author,
+			event.Created.Unix(),
+			bug.OpenStatus,
+			"",
+			map[string]string{
+				metaKeyGiteaCommentId: giteaID,
+				metaKeyGiteaUrl:       giteaURL,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportStatusChange(op.Id())
+
+	case eventComment:
+		cleanText, err := text.Cleanup(event.Body)
+		if err != nil {
+			return err
+		}
+
+		// if we didn't import the comment
+		if errResolve == cache.ErrNoMatchingOp {
+			op, err := b.AddCommentRaw(
+				author,
+				event.Created.Unix(),
+				cleanText,
+				nil,
+				map[string]string{
+					metaKeyGiteaCommentId: giteaID,
+					metaKeyGiteaUrl:       giteaURL,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			gi.out <- core.NewImportComment(op.Id())
+			return nil
+		}
+
+		// if comment was already imported or exported
+
+		// search for last comment update
+		comment, err := b.Snapshot().SearchComment(id)
+		if err != nil {
+			return err
+		}
+
+		if comment.Redacted || comment.Message == cleanText {
+			return nil
+		}
+
+		op, err := b.EditCommentRaw(
+			author,
+			event.Updated.Unix(),
+			comment.Id(),
+			cleanText,
+			map[string]string{
+				metaKeyGiteaUrl: giteaURL,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportCommentEdition(op.Id())
+
+	case eventChangeTitle:
+		if errResolve == nil {
+			return nil
+		}
+
+		op, err := b.SetTitleRaw(
+			author,
+			event.Created.Unix(),
+			event.NewTitle,
+			map[string]string{
+				metaKeyGiteaCommentId: giteaID,
+				metaKeyGiteaUrl:       giteaURL,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportTitleEdition(op.Id())
+	}
+
+	return nil
+}
+
+// dryRunTimelineEvent describe the import of a timeline event
+func (gi *giteaImporter) dryRunTimelineEvent(b *cache.BugCache, event TimelineEvent, id entity.Id, errResolve error) error {
+	switch event.Type {
+	case eventClose:
+		if errResolve == cache.ErrNoMatchingOp {
+			gi.out <- core.NewImportDryRun(core.ImportEventStatusChange, b.Id(), "close the bug")
+		}
+
+	case eventReopen:
+		if errResolve == cache.ErrNoMatchingOp {
+			gi.out <- core.NewImportDryRun(core.ImportEventStatusChange, b.Id(), "reopen the bug")
+		}
+
+	case eventComment:
+		if errResolve == cache.ErrNoMatchingOp {
+			gi.out <- core.NewImportDryRun(core.ImportEventComment, b.Id(), fmt.Sprintf("add a comment from %s", event.User.Login))
+			return nil
+		}
+
+		cleanText, err := text.Cleanup(event.Body)
+		if err != nil {
+			return err
+		}
+
+		comment, err := b.Snapshot().SearchComment(id)
+		if err != nil {
+			return err
+		}
+
+		if !comment.Redacted && comment.Message != cleanText {
+			gi.out <- core.NewImportDryRun(core.ImportEventCommentEdition, b.Id(), "edit a comment")
+		}
+
+	case eventChangeTitle:
+		if errResolve == cache.ErrNoMatchingOp {
+			gi.out <- core.NewImportDryRun(core.ImportEventTitleEdition, b.Id(), fmt.Sprintf("change the title to \"%s\"", event.NewTitle))
+		}
+	}
+
+	return nil
+}
+
+// ensureLabelEvents import a group of label events made at once as a single
+// operation, identified by the last event of the group
+func (gi *giteaImporter) ensureLabelEvents(repo *cache.RepoCache, b *cache.BugCache, issue Issue, events []TimelineEvent) error {
+	last := events[len(events)-1]
+	giteaID := parseID(last.ID)
+
+	_, err := b.ResolveOperationWithMetadata(metaKeyGiteaCommentId, giteaID)
+	if err != cache.ErrNoMatchingOp {
+		return err
+	}
+
+	var added, removed []string
+	for _, event := range events {
+		if event.Label == nil {
+			// the label has been deleted since
+			continue
+		}
+		if event.Body == "1" {
+			added = append(added, event.Label.Name)
+		} else {
+			removed = append(removed, event.Label.Name)
+		}
+	}
+
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	// ensure event author
+	author, err := gi.ensurePerson(repo, last.User)
+	if err != nil {
+		return err
+	}
+
+	if gi.dryRun {
+		var changes []string
+		for _, label := range added {
+			changes = append(changes, "+"+label)
+		}
+		for _, label := range removed {
+			changes = append(changes, "-"+label)
+		}
+		gi.out <- core.NewImportDryRun(core.ImportEventLabelChange, b.Id(),
+			fmt.Sprintf("change the labels: %s", strings.Join(changes, " ")))
+		return nil
+	}
+
+	giteaURL := last.HTMLURL
+	if giteaURL == "" {
+		giteaURL = issue.HTMLURL
+	}
+
+	op, err := b.ForceChangeLabelsRaw(
+		author,
+		last.Created.Unix(),
+		added,
+		removed,
+		map[string]string{
+			metaKeyGiteaCommentId: giteaID,
+			metaKeyGiteaUrl:       giteaURL,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	gi.out <- core.NewImportLabelChange(op.Id())
+
+	return nil
+}
+
+func (gi *giteaImporter) ensurePerson(repo *cache.RepoCache, user User) (*cache.IdentityCache, error) {
+	// Look first in the cache
+	i, err := repo.ResolveIdentityImmutableMetadata(metaKeyGiteaLogin, user.Login)
+	if err == nil {
+		return i, nil
+	}
+	if entity.IsErrMultipleMatch(err) {
+		return nil, err
+	}
+
+	if gi.dryRun {
+		if !gi.dryRunIdentities[user.Login] {
+			gi.dryRunIdentities[user.Login] = true
+			gi.out <- core.NewImportDryRun(core.ImportEventIdentity, "", fmt.Sprintf("create the identity %s", user.Login))
+		}
+		return nil, nil
+	}
+
+	name := user.FullName
+	if name == "" {
+		name = user.Login
+	}
+
+	i, err = repo.NewIdentityRaw(
+		name,
+		user.Email,
+		user.Login,
+		user.AvatarURL,
+		map[string]string{
+			metaKeyGiteaId:    parseID(user.ID),
+			metaKeyGiteaLogin: user.Login,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	gi.out <- core.NewImportIdentity(i.Id())
+	return i, nil
+}
+
+// projectPath return the owner/project path of the configured repository
+func (gi *giteaImporter) projectPath() string {
+	return fmt.Sprintf("%s/%s", gi.conf[confKeyOwner], gi.conf[confKeyProject])
+}
+
+// listTimeline return all the timeline events of an issue
+func listTimeline(ctx context.Context, c *client, owner, project string, number int64) ([]TimelineEvent, error) {
+	var result []TimelineEvent
+
+	for page := 1; ; page++ {
+		events, err := c.ListTimeline(ctx, owner, project, number, page)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, events...)
+
+		if len(events) < pageSize {
+			return result, nil
+		}
+	}
+}
+
+func parseID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
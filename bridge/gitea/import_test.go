@@ -0,0 +1,234 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestImport(t *testing.T) {
+	server := newFakeGitea("git-bug", "test")
+	defer server.Close()
+
+	alice := User{ID: 1, Login: "alice", FullName: "Alice", Email: "alice@example.com"}
+	bob := User{ID: 2, Login: "bob"}
+	server.addUser("alice-token", alice)
+
+	bugLabel := Label{ID: 1, Name: "bug", Color: "#ee0701"}
+	uiLabel := Label{ID: 2, Name: "ui", Color: "#84b6eb"}
+	server.labels = []Label{bugLabel, uiLabel}
+
+	// a complete issue
+	server.issues = append(server.issues, &Issue{
+		Number:  1,
+		User:    alice,
+		Title:   "complex issue edited",
+		Body:    "description edited",
+		State:   "closed",
+		HTMLURL: server.repoURL() + "/issues/1",
+		Created: server.now(),
+		Updated: server.now(),
+	})
+	server.addEvent(1, TimelineEvent{Type: eventComment, User: bob, Body: "first comment"})
+	labelTime := server.now()
+	server.addEvent(1, TimelineEvent{Type: eventLabel, User: alice, Body: "1", Label: &bugLabel, Created: labelTime})
+	server.addEvent(1, TimelineEvent{Type: eventLabel, User: alice, Body: "1", Label: &uiLabel, Created: labelTime})
+	server.addEvent(1, TimelineEvent{Type: eventChangeTitle, User: bob, OldTitle: "complex issue", NewTitle: "complex issue edited"})
+	server.addEvent(1, TimelineEvent{Type: eventLabel, User: bob, Label: &uiLabel})
+	// the label has been deleted
+	server.addEvent(1, TimelineEvent{Type: eventLabel, User: bob, Body: "1"})
+	// not supported
+	server.addEvent(1, TimelineEvent{Type: "assignees", User: bob})
+	server.addEvent(1, TimelineEvent{Type: eventClose, User: alice})
+
+	// a pull request
+	server.issues = append(server.issues, &Issue{
+		Number:      2,
+		User:        alice,
+		Title:       "pull request",
+		State:       "open",
+		Created:     server.now(),
+		PullRequest: map[string]interface{}{"merged": false},
+	})
+
+	// an issue with more comments than a page
+	server.issues = append(server.issues, &Issue{
+		Number:  3,
+		User:    bob,
+		Title:   "long issue",
+		State:   "open",
+		HTMLURL: server.repoURL() + "/issues/3",
+		Created: server.now(),
+	})
+	for i := 0; i < pageSize+10; i++ {
+		server.addEvent(3, TimelineEvent{Type: eventComment, User: alice, Body: fmt.Sprintf("comment %d", i)})
+	}
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	token := auth.NewToken(target, "alice-token")
+	token.SetMetadata(auth.MetaKeyLogin, "alice")
+	token.SetMetadata(auth.MetaKeyBaseURL, server.URL)
+	err = auth.Store(repo, token)
+	require.NoError(t, err)
+
+	conf := core.Configuration{
+		confKeyGiteaBaseUrl: server.URL,
+		confKeyOwner:        "git-bug",
+		confKeyProject:      "test",
+		confKeyDefaultLogin: "alice",
+	}
+
+	ctx := context.Background()
+
+	importer := &giteaImporter{}
+	err = importer.Init(ctx, backend, conf, false)
+	require.NoError(t, err)
+
+	importAll := func() {
+		events, err := importer.ImportAll(ctx, backend, time.Time{})
+		require.NoError(t, err)
+		for result := range events {
+			require.NoError(t, result.Err)
+		}
+	}
+
+	importAll()
+
+	require.Len(t, backend.AllBugsIds(), 2)
+
+	b, err := backend.ResolveBugCreateMetadata(metaKeyGiteaId, "1")
+	require.NoError(t, err)
+
+	snapshot := b.Snapshot()
+	require.Equal(t, "complex issue edited", snapshot.Title)
+	require.Equal(t, bug.ClosedStatus, snapshot.Status)
+	require.Equal(t, []bug.Label{"bug"}, snapshot.Labels)
+	require.Len(t, snapshot.Comments, 2)
+	require.Equal(t, "description edited", snapshot.Comments[0].Message)
+	require.Equal(t, "first comment", snapshot.Comments[1].Message)
+	require.Equal(t, "bob", snapshot.Comments[1].Author.Login())
+
+	// create, comment, labels, title, label and close
+	require.Len(t, snapshot.Operations, 6)
+	require.Equal(t, "complex issue", snapshot.Operations[0].(*bug.CreateOperation).Title)
+	labelOp, ok := snapshot.Operations[2].(*bug.LabelChangeOperation)
+	require.True(t, ok)
+	require.ElementsMatch(t, []bug.Label{"bug", "ui"}, labelOp.Added)
+
+	// identities carry the gitea login
+	i, err := backend.ResolveIdentityImmutableMetadata(metaKeyGiteaLogin, "alice")
+	require.NoError(t, err)
+	require.Equal(t, "Alice", i.Name())
+	require.Equal(t, "alice@example.com", i.Email())
+
+	long, err := backend.ResolveBugCreateMetadata(metaKeyGiteaId, "3")
+	require.NoError(t, err)
+	require.Len(t, long.Snapshot().Comments, pageSize+11)
+
+	// a comment edited on gitea
+	server.timeline[1][0].Body = "first comment edited"
+	server.timeline[1][0].Updated = server.now()
+
+	importAll()
+
+	require.Len(t, backend.AllBugsIds(), 2)
+
+	snapshot = b.Snapshot()
+	require.Len(t, snapshot.Operations, 7)
+	require.Equal(t, "first comment edited", snapshot.Comments[1].Message)
+}
+
+func TestImportFilter(t *testing.T) {
+	server := newFakeGitea("git-bug", "test")
+	defer server.Close()
+
+	alice := User{ID: 1, Login: "alice"}
+	bob := User{ID: 2, Login: "bob"}
+	server.addUser("alice-token", alice)
+
+	addIssue := func(author User) *Issue {
+		number := int64(len(server.issues) + 1)
+		issue := &Issue{
+			Number:  number,
+			User:    author,
+			Title:   fmt.Sprintf("issue %d", number),
+			State:   "open",
+			HTMLURL: fmt.Sprintf("%s/issues/%d", server.repoURL(), number),
+			Created: server.now(),
+		}
+		server.issues = append(server.issues, issue)
+		return issue
+	}
+
+	first := addIssue(alice)
+	addIssue(bob)
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	token := auth.NewToken(target, "alice-token")
+	token.SetMetadata(auth.MetaKeyLogin, "alice")
+	token.SetMetadata(auth.MetaKeyBaseURL, server.URL)
+	require.NoError(t, auth.Store(repo, token))
+
+	conf := core.Configuration{
+		confKeyGiteaBaseUrl:         server.URL,
+		confKeyOwner:                "git-bug",
+		confKeyProject:              "test",
+		confKeyDefaultLogin:         "alice",
+		core.ConfigKeyImportAuthors: "alice",
+	}
+
+	ctx := context.Background()
+
+	importAll := func() {
+		importer := &giteaImporter{}
+		require.NoError(t, importer.Init(ctx, backend, conf, false))
+		events, err := importer.ImportAll(ctx, backend, time.Time{})
+		require.NoError(t, err)
+		for result := range events {
+			require.NoError(t, result.Err)
+		}
+	}
+
+	importAll()
+	require.Len(t, backend.AllBugsIds(), 1)
+
+	b, err := backend.ResolveBugCreateMetadata(metaKeyGiteaId, "1")
+	require.NoError(t, err)
+	require.Equal(t, bug.OpenStatus, b.Snapshot().Status)
+
+	// the issue already imported doesn't match the filter anymore, but is
+	// still kept up to date
+	server.addEvent(1, TimelineEvent{Type: eventClose, User: alice})
+	first.State = "closed"
+	first.Updated = server.now()
+	addIssue(alice)
+	conf[core.ConfigKeyImportState] = "open"
+
+	importAll()
+	require.Len(t, backend.AllBugsIds(), 2)
+	require.Equal(t, bug.ClosedStatus, b.Snapshot().Status)
+
+	_, err = backend.ResolveBugCreateMetadata(metaKeyGiteaId, "3")
+	require.NoError(t, err)
+}
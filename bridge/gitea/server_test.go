@@ -0,0 +1,387 @@
+package gitea
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fakeGitea is an in-memory stand-in of the Gitea REST API, serving a single
+// repository
+type fakeGitea struct {
+	*httptest.Server
+
+	owner   string
+	project string
+
+	mu sync.Mutex
+	// users by token
+	users    map[string]User
+	issues   []*Issue
+	timeline map[int64][]*TimelineEvent
+	labels   []Label
+
+	// ids of the comment table, shared by all the timeline events as in Gitea
+	nextCommentID int64
+	nextLabelID   int64
+	clock         time.Time
+}
+
+func newFakeGitea(owner, project string) *fakeGitea {
+	f := &fakeGitea{
+		owner:         owner,
+		project:       project,
+		users:         make(map[string]User),
+		timeline:      make(map[int64][]*TimelineEvent),
+		nextCommentID: 1,
+		nextLabelID:   1,
+		clock:         time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.handle))
+	return f
+}
+
+// addUser register a user authenticated by the given token
+func (f *fakeGitea) addUser(token string, user User) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.users[token] = user
+}
+
+func (f *fakeGitea) repoURL() string {
+	return fmt.Sprintf("%s/%s/%s", f.URL, f.owner, f.project)
+}
+
+func (f *fakeGitea) now() time.Time {
+	f.clock = f.clock.Add(time.Minute)
+	return f.clock
+}
+
+func (f *fakeGitea) addEvent(number int64, event TimelineEvent) *TimelineEvent {
+	event.ID = f.nextCommentID
+	f.nextCommentID++
+	event.HTMLURL = fmt.Sprintf("%s/issues/%d#issuecomment-%d", f.repoURL(), number, event.ID)
+	if event.Created.IsZero() {
+		event.Created = f.now()
+	}
+	event.Updated = event.Created
+	f.timeline[number] = append(f.timeline[number], &event)
+	return &event
+}
+
+func (f *fakeGitea) issue(number int64) *Issue {
+	for _, issue := range f.issues {
+		if issue.Number == number {
+			return issue
+		}
+	}
+	return nil
+}
+
+func (f *fakeGitea) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user, ok := f.users[strings.TrimPrefix(r.Header.Get("Authorization"), "token ")]
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "invalid token")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v1")
+	if path == "/user" && r.Method == http.MethodGet {
+		writeJSON(w, http.StatusOK, user)
+		return
+	}
+
+	prefix := fmt.Sprintf("/repos/%s/%s", f.owner, f.project)
+	if !strings.HasPrefix(path, prefix) {
+		writeError(w, http.StatusNotFound, "repository not found")
+		return
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(path, prefix), "/"), "/")
+
+	switch {
+	// /repos/{owner}/{repo}
+	case len(parts) == 1 && parts[0] == "" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, Repository{
+			ID:       1,
+			FullName: f.owner + "/" + f.project,
+			HTMLURL:  f.repoURL(),
+		})
+
+	// /repos/{owner}/{repo}/issues
+	case len(parts) == 1 && parts[0] == "issues" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, paginate(r, f.issues))
+
+	case len(parts) == 1 && parts[0] == "issues" && r.Method == http.MethodPost:
+		var in struct {
+			Title string `json:"title"`
+			Body  string `json:"body"`
+		}
+		if !readJSON(w, r, &in) {
+			return
+		}
+		number := int64(len(f.issues) + 1)
+		issue := &Issue{
+			ID:      number + 1000,
+			Number:  number,
+			User:    user,
+			Title:   in.Title,
+			Body:    in.Body,
+			Labels:  []Label{},
+			State:   "open",
+			HTMLURL: fmt.Sprintf("%s/issues/%d", f.repoURL(), number),
+			Created: f.now(),
+		}
+		issue.Updated = issue.Created
+		f.issues = append(f.issues, issue)
+		writeJSON(w, http.StatusCreated, issue)
+
+	// /repos/{owner}/{repo}/issues/comments/{id}
+	case len(parts) == 3 && parts[0] == "issues" && parts[1] == "comments" && r.Method == http.MethodPatch:
+		id, _ := strconv.ParseInt(parts[2], 10, 64)
+		var in struct {
+			Body string `json:"body"`
+		}
+		if !readJSON(w, r, &in) {
+			return
+		}
+		for _, events := range f.timeline {
+			for _, event := range events {
+				if event.ID == id && event.Type == eventComment {
+					event.Body = in.Body
+					event.Updated = f.now()
+					writeJSON(w, http.StatusOK, toComment(event))
+					return
+				}
+			}
+		}
+		writeError(w, http.StatusNotFound, "comment not found")
+
+	case len(parts) == 3 && parts[0] == "issues" && parts[1] == "comments" && r.Method == http.MethodDelete:
+		id, _ := strconv.ParseInt(parts[2], 10, 64)
+		for number, events := range f.timeline {
+			for i, event := range events {
+				if event.ID == id && event.Type == eventComment {
+					f.timeline[number] = append(events[:i:i], events[i+1:]...)
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+			}
+		}
+		writeError(w, http.StatusNotFound, "comment not found")
+
+	// /repos/{owner}/{repo}/issues/{number}
+	case len(parts) == 2 && parts[0] == "issues" && r.Method == http.MethodPatch:
+		issue := f.issueFromPath(w, parts[1])
+		if issue == nil {
+			return
+		}
+		var in EditIssueOption
+		if !readJSON(w, r, &in) {
+			return
+		}
+		if in.Title != nil && *in.Title != issue.Title {
+			f.addEvent(issue.Number, TimelineEvent{
+				Type:     eventChangeTitle,
+				User:     user,
+				OldTitle: issue.Title,
+				NewTitle: *in.Title,
+			})
+			issue.Title = *in.Title
+		}
+		if in.Body != nil {
+			issue.Body = *in.Body
+		}
+		if in.State != nil && *in.State != issue.State {
+			eventType := eventClose
+			if *in.State == "open" {
+				eventType = eventReopen
+			}
+			f.addEvent(issue.Number, TimelineEvent{Type: eventType, User: user})
+			issue.State = *in.State
+		}
+		issue.Updated = f.now()
+		writeJSON(w, http.StatusCreated, issue)
+
+	// /repos/{owner}/{repo}/issues/{number}/timeline
+	case len(parts) == 3 && parts[0] == "issues" && parts[2] == "timeline" && r.Method == http.MethodGet:
+		issue := f.issueFromPath(w, parts[1])
+		if issue == nil {
+			return
+		}
+		writeJSON(w, http.StatusOK, paginate(r, f.timeline[issue.Number]))
+
+	// /repos/{owner}/{repo}/issues/{number}/comments
+	case len(parts) == 3 && parts[0] == "issues" && parts[2] == "comments" && r.Method == http.MethodPost:
+		issue := f.issueFromPath(w, parts[1])
+		if issue == nil {
+			return
+		}
+		var in struct {
+			Body string `json:"body"`
+		}
+		if !readJSON(w, r, &in) {
+			return
+		}
+		event := f.addEvent(issue.Number, TimelineEvent{Type: eventComment, User: user, Body: in.Body})
+		writeJSON(w, http.StatusCreated, toComment(event))
+
+	// /repos/{owner}/{repo}/issues/{number}/labels
+	case len(parts) == 3 && parts[0] == "issues" && parts[2] == "labels" && r.Method == http.MethodPut:
+		issue := f.issueFromPath(w, parts[1])
+		if issue == nil {
+			return
+		}
+		var in struct {
+			Labels []int64 `json:"labels"`
+		}
+		if !readJSON(w, r, &in) {
+			return
+		}
+		if in.Labels == nil {
+			writeError(w, http.StatusUnprocessableEntity, "labels is null")
+			return
+		}
+		f.replaceLabels(issue, user, in.Labels)
+		writeJSON(w, http.StatusOK, issue.Labels)
+
+	// /repos/{owner}/{repo}/labels
+	case len(parts) == 1 && parts[0] == "labels" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, paginate(r, f.labels))
+
+	case len(parts) == 1 && parts[0] == "labels" && r.Method == http.MethodPost:
+		var in Label
+		if !readJSON(w, r, &in) {
+			return
+		}
+		if len(in.Color) != 7 || in.Color[0] != '#' {
+			writeError(w, http.StatusUnprocessableEntity, "invalid color")
+			return
+		}
+		in.ID = f.nextLabelID
+		f.nextLabelID++
+		f.labels = append(f.labels, in)
+		writeJSON(w, http.StatusCreated, in)
+
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// replaceLabels set the labels of an issue, recording a label event with the
+// same date for each label added or removed
+func (f *fakeGitea) replaceLabels(issue *Issue, user User, ids []int64) {
+	created := f.now()
+
+	wanted := make(map[int64]bool)
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	current := make(map[int64]bool)
+	kept := []Label{}
+	for _, label := range issue.Labels {
+		current[label.ID] = true
+		if wanted[label.ID] {
+			kept = append(kept, label)
+			continue
+		}
+		label := label
+		f.addEvent(issue.Number, TimelineEvent{Type: eventLabel, User: user, Label: &label, Created: created})
+	}
+
+	for _, label := range f.labels {
+		if wanted[label.ID] && !current[label.ID] {
+			label := label
+			kept = append(kept, label)
+			f.addEvent(issue.Number, TimelineEvent{Type: eventLabel, User: user, Body: "1", Label: &label, Created: created})
+		}
+	}
+
+	issue.Labels = kept
+}
+
+func (f *fakeGitea) issueFromPath(w http.ResponseWriter, raw string) *Issue {
+	number, _ := strconv.ParseInt(raw, 10, 64)
+	issue := f.issue(number)
+	if issue == nil {
+		writeError(w, http.StatusNotFound, "issue not found")
+	}
+	return issue
+}
+
+func toComment(event *TimelineEvent) Comment {
+	return Comment{
+		ID:      event.ID,
+		User:    event.User,
+		Body:    event.Body,
+		HTMLURL: event.HTMLURL,
+		Created: event.Created,
+		Updated: event.Updated,
+	}
+}
+
+// paginate return the requested page of a slice, according to the page and
+// limit query parameters
+func paginate(r *http.Request, items interface{}) interface{} {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 30
+	}
+
+	var all []interface{}
+	switch items := items.(type) {
+	case []*Issue:
+		for _, item := range items {
+			all = append(all, item)
+		}
+	case []*TimelineEvent:
+		for _, item := range items {
+			all = append(all, item)
+		}
+	case []Label:
+		for _, item := range items {
+			all = append(all, item)
+		}
+	}
+
+	start := (page - 1) * limit
+	if start > len(all) {
+		start = len(all)
+	}
+	end := start + limit
+	if end > len(all) {
+		end = len(all)
+	}
+
+	return append([]interface{}{}, all[start:end]...)
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, apiError{Message: message})
+}
@@ -0,0 +1,93 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+var _ core.WebhookImpl = &Gitea{}
+var _ core.WebhookImporter = &giteaImporter{}
+
+// the webhook events changing the issues
+var webhookEvents = map[string]bool{
+	"issues":        true,
+	"issue_comment": true,
+	"issue_label":   true,
+}
+
+// VerifyWebhook check the HMAC-SHA256 signature of a Gitea webhook
+func (*Gitea) VerifyWebhook(secret string, header http.Header, payload []byte) error {
+	sig := header.Get("X-Gitea-Signature")
+	if sig == "" {
+		return core.ErrWebhookSignature
+	}
+	return core.CheckHMACSignature("sha256", secret, payload, sig)
+}
+
+// webhookEvent is the part of a Gitea webhook payload holding the issue
+// changed, in the same form as the API
+type webhookEvent struct {
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Issue *Issue `json:"issue"`
+}
+
+// DecodeWebhook return the issue changed by the event of a webhook payload,
+// or nil if it doesn't change an issue of the configured project
+func (*Gitea) DecodeWebhook(conf core.Configuration, header http.Header, payload []byte) (interface{}, error) {
+	if !webhookEvents[header.Get("X-Gitea-Event")] {
+		return nil, nil
+	}
+
+	var event webhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(event.Repository.FullName, conf[confKeyOwner]+"/"+conf[confKeyProject]) {
+		return nil, nil
+	}
+
+	// the comments on the pull requests are notified as well
+	if event.Issue == nil || event.Issue.PullRequest != nil {
+		return nil, nil
+	}
+
+	return event.Issue, nil
+}
+
+// ImportWebhook import the issue changed by a webhook event. The issue of
+// the payload is used as is, only its timeline is queried.
+func (gi *giteaImporter) ImportWebhook(ctx context.Context, repo *cache.RepoCache, event interface{}) (<-chan core.ImportResult, error) {
+	issue := event.(*Issue)
+
+	out := make(chan core.ImportResult)
+	gi.out = out
+
+	go func() {
+		defer close(out)
+
+		reason, err := gi.skipIssue(repo, *issue)
+		if err != nil {
+			out <- core.NewImportError(err, "")
+			return
+		}
+		if reason != "" {
+			out <- core.NewImportNothing("", fmt.Sprintf("issue %s: %s", issue.HTMLURL, reason))
+			return
+		}
+
+		if err := gi.importIssue(ctx, repo, *issue); err != nil {
+			out <- core.NewImportError(err, "")
+		}
+	}()
+
+	return out, nil
+}
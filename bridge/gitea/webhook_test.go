@@ -0,0 +1,170 @@
+package gitea
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestVerifyWebhook(t *testing.T) {
+	payload := []byte(`{"action":"created","repository":{"full_name":"alice/project"}}`)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	g := &Gitea{}
+
+	header := http.Header{}
+	header.Set("X-Gitea-Event", "issue_comment")
+	header.Set("X-Gitea-Signature", signature)
+	require.NoError(t, g.VerifyWebhook("secret", header, payload))
+
+	// the payload was tampered with
+	err := g.VerifyWebhook("secret", header, append(payload, ' '))
+	require.Equal(t, core.ErrWebhookSignature, err)
+
+	header.Del("X-Gitea-Signature")
+	err = g.VerifyWebhook("secret", header, payload)
+	require.Equal(t, core.ErrWebhookSignature, err)
+}
+
+func TestDecodeWebhook(t *testing.T) {
+	conf := core.Configuration{
+		confKeyOwner:   "alice",
+		confKeyProject: "project",
+	}
+
+	tests := []struct {
+		name    string
+		event   string
+		payload string
+		number  int64
+	}{
+		{
+			name:    "issue",
+			event:   "issues",
+			payload: `{"action":"opened","repository":{"full_name":"alice/project"},"issue":{"number":4,"state":"open"}}`,
+			number:  4,
+		},
+		{
+			name:    "comment",
+			event:   "issue_comment",
+			payload: `{"action":"created","repository":{"full_name":"alice/project"},"issue":{"number":5,"state":"open"}}`,
+			number:  5,
+		},
+		{
+			name:    "pull request comment",
+			event:   "issue_comment",
+			payload: `{"action":"created","repository":{"full_name":"alice/project"},"issue":{"number":6,"pull_request":{"merged":false}}}`,
+		},
+		{
+			name:    "other repository",
+			event:   "issues",
+			payload: `{"action":"opened","repository":{"full_name":"alice/other"},"issue":{"number":4}}`,
+		},
+		{
+			name:    "push",
+			event:   "push",
+			payload: `{"ref":"refs/heads/master","repository":{"full_name":"alice/project"}}`,
+		},
+	}
+
+	g := &Gitea{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("X-Gitea-Event", tt.event)
+
+			issue, err := g.DecodeWebhook(conf, header, []byte(tt.payload))
+			require.NoError(t, err)
+			if tt.number == 0 {
+				require.Nil(t, issue)
+				return
+			}
+			require.Equal(t, tt.number, issue.(*Issue).Number)
+		})
+	}
+}
+
+func TestImportWebhook(t *testing.T) {
+	server := newFakeGitea("git-bug", "test")
+	defer server.Close()
+
+	alice := User{ID: 1, Login: "alice"}
+	server.addUser("alice-token", alice)
+
+	for _, number := range []int64{1, 2} {
+		server.issues = append(server.issues, &Issue{
+			Number:  number,
+			User:    alice,
+			Title:   "issue",
+			State:   "open",
+			HTMLURL: fmt.Sprintf("%s/issues/%d", server.repoURL(), number),
+			Created: server.now(),
+		})
+	}
+	server.addEvent(2, TimelineEvent{Type: eventComment, User: alice, Body: "a comment"})
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	token := auth.NewToken(target, "alice-token")
+	token.SetMetadata(auth.MetaKeyLogin, "alice")
+	token.SetMetadata(auth.MetaKeyBaseURL, server.URL)
+	require.NoError(t, auth.Store(repo, token))
+
+	conf := core.Configuration{
+		confKeyGiteaBaseUrl: server.URL,
+		confKeyOwner:        "git-bug",
+		confKeyProject:      "test",
+		confKeyDefaultLogin: "alice",
+	}
+
+	ctx := context.Background()
+
+	importer := &giteaImporter{}
+	require.NoError(t, importer.Init(ctx, backend, conf, false))
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"action":     "created",
+		"repository": Repository{FullName: "git-bug/test"},
+		"issue":      server.issue(2),
+	})
+	require.NoError(t, err)
+
+	header := http.Header{}
+	header.Set("X-Gitea-Event", "issue_comment")
+
+	event, err := (&Gitea{}).DecodeWebhook(conf, header, payload)
+	require.NoError(t, err)
+
+	events, err := importer.ImportWebhook(ctx, backend, event)
+	require.NoError(t, err)
+	for result := range events {
+		require.NoError(t, result.Err)
+	}
+
+	// only the notified issue is imported
+	require.Len(t, backend.AllBugsIds(), 1)
+	b, err := backend.ResolveBugCreateMetadata(metaKeyGiteaId, "2")
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Comments, 2)
+	require.Equal(t, "a comment", b.Snapshot().Comments[1].Message)
+}
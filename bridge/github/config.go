@@ -29,12 +29,13 @@ var (
 
 func (g *Github) ValidParams() map[string]interface{} {
 	return map[string]interface{}{
-		"URL":        nil,
-		"Login":      nil,
-		"CredPrefix": nil,
-		"TokenRaw":   nil,
-		"Owner":      nil,
-		"Project":    nil,
+		"URL":           nil,
+		"Login":         nil,
+		"CredPrefix":    nil,
+		"TokenRaw":      nil,
+		"Owner":         nil,
+		"Project":       nil,
+		"WebhookSecret": nil,
 	}
 }
 
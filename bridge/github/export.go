@@ -8,6 +8,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -53,14 +55,25 @@ type githubExporter struct {
 
 	// cache labels used to speed up exporting labels events
 	cachedLabels map[string]string
+
+	// cache github users node ID used to speed up exporting assignees events
+	cachedUsers map[string]string
+
+	// cache github milestones number by title, loaded at the first milestone event
+	cachedMilestones map[string]int
+
+	// only describe the changes
+	dryRun bool
 }
 
 // Init .
-func (ge *githubExporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration) error {
+func (ge *githubExporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
 	ge.conf = conf
+	ge.dryRun = dryRun
 	ge.identityClient = make(map[entity.Id]*githubv4.Client)
 	ge.cachedOperationIDs = make(map[entity.Id]string)
 	ge.cachedLabels = make(map[string]string)
+	ge.cachedUsers = make(map[string]string)
 
 	// preload all clients
 	err := ge.cacheAllClient(repo)
@@ -124,7 +137,7 @@ func (ge *githubExporter) getClientForIdentity(userId entity.Id) (*githubv4.Clie
 }
 
 // ExportAll export all event made by the current user to Github
-func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ExportResult, error) {
+func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, ids []entity.Id, since time.Time) (<-chan core.ExportResult, error) {
 	out := make(chan core.ExportResult)
 
 	var err error
@@ -153,9 +166,7 @@ func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 			allIdentitiesIds = append(allIdentitiesIds, id)
 		}
 
-		allBugsIds := repo.AllBugsIds()
-
-		for _, id := range allBugsIds {
+		for _, id := range ids {
 			b, err := repo.ResolveBug(id)
 			if err != nil {
 				out <- core.NewExportError(errors.Wrap(err, "can't load bug"), id)
@@ -180,7 +191,7 @@ func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 
 				if snapshot.HasAnyActor(allIdentitiesIds...) {
 					// try to export the bug and it associated events
-					ge.exportBug(ctx, b, out)
+					ge.exportBug(ctx, repo, b, out)
 				}
 			}
 		}
@@ -190,7 +201,7 @@ func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 }
 
 // exportBug publish bugs and related events
-func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out chan<- core.ExportResult) {
+func (ge *githubExporter) exportBug(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, out chan<- core.ExportResult) {
 	snapshot := b.Snapshot()
 	var bugUpdated bool
 
@@ -249,33 +260,38 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			return
 		}
 
-		// create bug
-		id, url, err := createGithubIssue(ctx, client, ge.repositoryID, createOp.Title, createOp.Message)
-		if err != nil {
-			err := errors.Wrap(err, "exporting github issue")
-			out <- core.NewExportError(err, b.Id())
-			return
-		}
+		if ge.dryRun {
+			out <- core.NewExportDryRun(core.ExportEventBug, b.Id(), fmt.Sprintf("create the issue \"%s\"", createOp.Title))
+			bugUpdated = true
+		} else {
+			// create bug
+			id, url, err := createGithubIssue(ctx, client, ge.repositoryID, createOp.Title, createOp.Message)
+			if err != nil {
+				err := errors.Wrap(err, "exporting github issue")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
 
-		out <- core.NewExportBug(b.Id())
+			out <- core.NewExportBug(b.Id())
 
-		// mark bug creation operation as exported
-		if err := markOperationAsExported(b, createOp.Id(), id, url); err != nil {
-			err := errors.Wrap(err, "marking operation as exported")
-			out <- core.NewExportError(err, b.Id())
-			return
-		}
+			// mark bug creation operation as exported
+			if err := markOperationAsExported(b, createOp.Id(), id, url); err != nil {
+				err := errors.Wrap(err, "marking operation as exported")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
 
-		// commit operation to avoid creating multiple issues with multiple pushes
-		if err := b.CommitAsNeeded(); err != nil {
-			err := errors.Wrap(err, "bug commit")
-			out <- core.NewExportError(err, b.Id())
-			return
-		}
+			// commit operation to avoid creating multiple issues with multiple pushes
+			if err := b.CommitAsNeeded(); err != nil {
+				err := errors.Wrap(err, "bug commit")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
 
-		// cache bug github ID and URL
-		bugGithubID = id
-		bugGithubURL = url
+			// cache bug github ID and URL
+			bugGithubID = id
+			bugGithubURL = url
+		}
 	}
 
 	// cache operation github id
@@ -303,6 +319,12 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 		var id, url string
 		switch op := op.(type) {
 		case *bug.AddCommentOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
 			// send operation to github
 			id, url, err = addCommentGithubIssue(ctx, client, bugGithubID, op.Message)
 			if err != nil {
@@ -317,6 +339,12 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			ge.cachedOperationIDs[op.Id()] = id
 
 		case *bug.EditCommentOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
 			// Since github doesn't consider the issue body as a comment
 			if op.Target == createOp.Id() {
 
@@ -355,7 +383,13 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			}
 
 		case *bug.SetStatusOperation:
-			if err := updateGithubIssueStatus(ctx, client, bugGithubID, op.Status); err != nil {
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			if err := updateGithubIssueStatus(ctx, client, bugGithubID, op.Status, op.Resolution); err != nil {
 				err := errors.Wrap(err, "editing status")
 				out <- core.NewExportError(err, b.Id())
 				return
@@ -367,6 +401,12 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			url = bugGithubURL
 
 		case *bug.SetTitleOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
 			if err := updateGithubIssueTitle(ctx, client, bugGithubID, op.Title); err != nil {
 				err := errors.Wrap(err, "editing title")
 				out <- core.NewExportError(err, b.Id())
@@ -379,6 +419,12 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			url = bugGithubURL
 
 		case *bug.LabelChangeOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
 			if err := ge.updateGithubIssueLabels(ctx, client, bugGithubID, op.Added, op.Removed); err != nil {
 				err := errors.Wrap(err, "updating labels")
 				out <- core.NewExportError(err, b.Id())
@@ -390,6 +436,104 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			id = bugGithubID
 			url = bugGithubURL
 
+		case *bug.SetAssigneesOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			if err := ge.updateGithubIssueAssignees(ctx, client, bugGithubID, op.Added, op.Removed); err != nil {
+				err := errors.Wrap(err, "updating assignees")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportAssigneeChange(op.Id())
+
+			id = bugGithubID
+			url = bugGithubURL
+
+		case *bug.SetMilestoneOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			var number *int
+			if op.Milestone != "" {
+				m, err := repo.ResolveMilestone(op.Milestone)
+				if err != nil {
+					err := errors.Wrap(err, "resolving milestone")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+
+				n, err := ge.getOrCreateGithubMilestone(ctx, m)
+				if err != nil {
+					err := errors.Wrap(err, "creating milestone")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+				number = &n
+			}
+
+			if err := ge.updateGithubIssueMilestone(ctx, bugGithubURL, number); err != nil {
+				err := errors.Wrap(err, "updating milestone")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportMilestoneChange(op.Id())
+
+			id = bugGithubID
+			url = bugGithubURL
+
+		case *bug.SetLinksOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "links are not exported to GitHub")
+			continue
+
+		case *bug.AddReactionOperation, *bug.RemoveReactionOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "reactions are not exported to GitHub")
+			continue
+
+		case *bug.RedactCommentOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			// the issue body can't be removed, only blanked
+			if op.Target == createOp.Id() {
+				if err := updateGithubIssueBody(ctx, client, bugGithubID, ""); err != nil {
+					err := errors.Wrap(err, "redacting issue body")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+
+			} else {
+				commentID, ok := ge.cachedOperationIDs[op.Target]
+				if !ok {
+					out <- core.NewExportNothing(op.Id(), "the redacted comment is not on GitHub")
+					continue
+				}
+
+				if err := deleteCommentGithubIssue(ctx, client, commentID); err != nil {
+					err := errors.Wrap(err, "deleting comment")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+			}
+
+			out <- core.NewExportCommentRedaction(op.Id())
+
+			id = bugGithubID
+			url = bugGithubURL
+
 		default:
 			panic("unhandled operation type case")
 		}
@@ -698,7 +842,23 @@ func editCommentGithubIssue(ctx context.Context, gc *githubv4.Client, commentID,
 	return commentID, m.UpdateIssueComment.IssueComment.URL, nil
 }
 
-func updateGithubIssueStatus(ctx context.Context, gc *githubv4.Client, id string, status bug.Status) error {
+func deleteCommentGithubIssue(ctx context.Context, gc *githubv4.Client, commentID string) error {
+	m := &deleteIssueCommentMutation{}
+	input := githubv4.DeleteIssueCommentInput{
+		ID: commentID,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	return gc.Mutate(ctx, m, input, nil)
+}
+
+func updateGithubIssueStatus(ctx context.Context, gc *githubv4.Client, id string, status bug.Status, resolution bug.Resolution) error {
+	if status == bug.ClosedStatus && resolution != "" {
+		return closeGithubIssue(ctx, gc, id, resolution)
+	}
+
 	m := &updateIssueMutation{}
 
 	// set state
@@ -728,6 +888,30 @@ func updateGithubIssueStatus(ctx context.Context, gc *githubv4.Client, id string
 	return nil
 }
 
+// closeGithubIssue close an issue, with the state reason matching the resolution
+func closeGithubIssue(ctx context.Context, gc *githubv4.Client, id string, resolution bug.Resolution) error {
+	m := &closeIssueMutation{}
+
+	reason := githubv4.String("NOT_PLANNED")
+	if resolution == bug.ResolutionFixed {
+		reason = "COMPLETED"
+	}
+
+	input := CloseIssueInput{
+		IssueID:     id,
+		StateReason: &reason,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	if err := gc.Mutate(ctx, m, input, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func updateGithubIssueBody(ctx context.Context, gc *githubv4.Client, id string, body string) error {
 	m := &updateIssueMutation{}
 	input := githubv4.UpdateIssueInput{
@@ -812,3 +996,256 @@ func (ge *githubExporter) updateGithubIssueLabels(ctx context.Context, gc *githu
 
 	return wg.Wait()
 }
+
+// githubLogin return the Github login of an identity, preferably from the
+// metadata set when importing it
+func githubLogin(i identity.Interface) string {
+	if id, ok := i.(*identity.Identity); ok {
+		if login, ok := id.ImmutableMetadata()[metaKeyGithubLogin]; ok {
+			return login
+		}
+	}
+	return i.Login()
+}
+
+// getUsersIDs return the github node IDs of the given identities
+func (ge *githubExporter) getUsersIDs(ctx context.Context, gc *githubv4.Client, identities []identity.Interface) ([]githubv4.ID, error) {
+	ids := make([]githubv4.ID, 0, len(identities))
+
+	for _, i := range identities {
+		login := githubLogin(i)
+		if login == "" {
+			return nil, fmt.Errorf("no github login for identity %s", i.DisplayName())
+		}
+
+		id, ok := ge.cachedUsers[login]
+		if !ok {
+			q := &userQuery{}
+			variables := map[string]interface{}{
+				"login": githubv4.String(login),
+			}
+
+			reqCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+			err := gc.Query(reqCtx, q, variables)
+			cancel()
+			if err != nil {
+				return nil, errors.Wrapf(err, "querying github user %s", login)
+			}
+
+			id = q.User.ID
+			ge.cachedUsers[login] = id
+		}
+
+		ids = append(ids, githubv4.ID(id))
+	}
+
+	return ids, nil
+}
+
+// update github issue assignees
+func (ge *githubExporter) updateGithubIssueAssignees(ctx context.Context, gc *githubv4.Client, assignableID string, added, removed []identity.Interface) error {
+	if len(added) > 0 {
+		addedIDs, err := ge.getUsersIDs(ctx, gc, added)
+		if err != nil {
+			return err
+		}
+
+		m := &addAssigneesToAssignableMutation{}
+		input := githubv4.AddAssigneesToAssignableInput{
+			AssignableID: assignableID,
+			AssigneeIDs:  addedIDs,
+		}
+
+		reqCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+
+		if err := gc.Mutate(reqCtx, m, input, nil); err != nil {
+			return err
+		}
+	}
+
+	if len(removed) > 0 {
+		removedIDs, err := ge.getUsersIDs(ctx, gc, removed)
+		if err != nil {
+			return err
+		}
+
+		m := &removeAssigneesFromAssignableMutation{}
+		input := githubv4.RemoveAssigneesFromAssignableInput{
+			AssignableID: assignableID,
+			AssigneeIDs:  removedIDs,
+		}
+
+		reqCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+
+		if err := gc.Mutate(reqCtx, m, input, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getOrCreateGithubMilestone return the number of the github milestone with the
+// same title as the given milestone, creating it if needed
+func (ge *githubExporter) getOrCreateGithubMilestone(ctx context.Context, m *cache.MilestoneCache) (int, error) {
+	if ge.cachedMilestones == nil {
+		ge.cachedMilestones = make(map[string]int)
+		if err := ge.cacheGithubMilestones(ctx, ge.defaultClient); err != nil {
+			return 0, err
+		}
+	}
+
+	if number, ok := ge.cachedMilestones[m.Title()]; ok {
+		return number, nil
+	}
+
+	number, err := ge.createGithubMilestone(ctx, m)
+	if err != nil {
+		return 0, err
+	}
+
+	ge.cachedMilestones[m.Title()] = number
+	return number, nil
+}
+
+func (ge *githubExporter) cacheGithubMilestones(ctx context.Context, gc *githubv4.Client) error {
+	variables := map[string]interface{}{
+		"owner": githubv4.String(ge.conf[confKeyOwner]),
+		"name":  githubv4.String(ge.conf[confKeyProject]),
+		"first": githubv4.Int(10),
+		"after": (*githubv4.String)(nil),
+	}
+
+	q := milestonesQuery{}
+
+	hasNextPage := true
+	for hasNextPage {
+		// create a new timeout context at each iteration
+		ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+
+		if err := gc.Query(ctx, &q, variables); err != nil {
+			cancel()
+			return err
+		}
+		cancel()
+
+		for _, milestone := range q.Repository.Milestones.Nodes {
+			ge.cachedMilestones[milestone.Title] = milestone.Number
+		}
+
+		hasNextPage = q.Repository.Milestones.PageInfo.HasNextPage
+		variables["after"] = q.Repository.Milestones.PageInfo.EndCursor
+	}
+
+	return nil
+}
+
+// create a new milestone and return its github number
+// NOTE: there is no mutation to create a milestone in the github api v4
+func (ge *githubExporter) createGithubMilestone(ctx context.Context, m *cache.MilestoneCache) (int, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s/milestones", githubV3Url, ge.conf[confKeyOwner], ge.conf[confKeyProject])
+	client := &http.Client{}
+
+	params := struct {
+		Title       string     `json:"title"`
+		State       string     `json:"state"`
+		Description string     `json:"description,omitempty"`
+		DueOn       *time.Time `json:"due_on,omitempty"`
+	}{
+		Title:       m.Title(),
+		State:       m.Status().String(),
+		Description: m.Description(),
+	}
+
+	if due := m.DueDate(); !due.IsZero() {
+		params.DueOn = &due
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	req = req.WithContext(ctx)
+
+	// need the token for private repositories
+	req.Header.Set("Authorization", fmt.Sprintf("token %s", ge.defaultToken.Value))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return 0, fmt.Errorf("error creating milestone: response status %v", resp.StatusCode)
+	}
+
+	aux := struct {
+		Number int `json:"number"`
+	}{}
+
+	data, _ = ioutil.ReadAll(resp.Body)
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return 0, err
+	}
+
+	return aux.Number, nil
+}
+
+// update the milestone of a github issue, a nil number remove it
+// NOTE: the github api v4 doesn't allow to remove the milestone of an issue
+func (ge *githubExporter) updateGithubIssueMilestone(ctx context.Context, issueURL string, number *int) error {
+	issueNumber := path.Base(issueURL)
+	if _, err := strconv.Atoi(issueNumber); err != nil {
+		return fmt.Errorf("unexpected issue url %s", issueURL)
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/%s/issues/%s", githubV3Url, ge.conf[confKeyOwner], ge.conf[confKeyProject], issueNumber)
+	client := &http.Client{}
+
+	params := struct {
+		Milestone *int `json:"milestone"`
+	}{
+		Milestone: number,
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PATCH", url, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Authorization", fmt.Sprintf("token %s", ge.defaultToken.Value))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error updating milestone: response status %v", resp.StatusCode)
+	}
+
+	return nil
+}
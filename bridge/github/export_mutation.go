@@ -1,5 +1,7 @@
 package github
 
+import "github.com/shurcooL/githubv4"
+
 type createIssueMutation struct {
 	CreateIssue struct {
 		Issue struct {
@@ -18,6 +20,23 @@ type updateIssueMutation struct {
 	} `graphql:"updateIssue(input:$input)"`
 }
 
+type closeIssueMutation struct {
+	CloseIssue struct {
+		Issue struct {
+			ID  string `graphql:"id"`
+			URL string `graphql:"url"`
+		}
+	} `graphql:"closeIssue(input:$input)"`
+}
+
+// CloseIssueInput is the input of the closeIssue mutation. It's defined here
+// as the githubv4 package doesn't know about the state reason. As the name of
+// the type is used in the query, it must match the GraphQL one.
+type CloseIssueInput struct {
+	IssueID     githubv4.ID      `json:"issueId"`
+	StateReason *githubv4.String `json:"stateReason,omitempty"`
+}
+
 type addCommentToIssueMutation struct {
 	AddComment struct {
 		CommentEdge struct {
@@ -38,6 +57,12 @@ type updateIssueCommentMutation struct {
 	} `graphql:"updateIssueComment(input:$input)"`
 }
 
+type deleteIssueCommentMutation struct {
+	DeleteIssueComment struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"deleteIssueComment(input:$input)"`
+}
+
 type removeLabelsFromLabelableMutation struct {
 	AddLabels struct {
 		Labelable struct {
@@ -54,6 +79,22 @@ type addLabelsToLabelableMutation struct {
 	} `graphql:"addLabelsToLabelable(input:$input)"`
 }
 
+type addAssigneesToAssignableMutation struct {
+	AddAssignees struct {
+		Assignable struct {
+			Typename string `graphql:"__typename"`
+		}
+	} `graphql:"addAssigneesToAssignable(input:$input)"`
+}
+
+type removeAssigneesFromAssignableMutation struct {
+	RemoveAssignees struct {
+		Assignable struct {
+			Typename string `graphql:"__typename"`
+		}
+	} `graphql:"removeAssigneesFromAssignable(input:$input)"`
+}
+
 /**
 type createLabelMutation struct {
 	CreateLabel struct {
@@ -52,6 +52,8 @@ func buildClient(token *auth.Token) *githubv4.Client {
 		&oauth2.Token{AccessToken: token.Value},
 	)
 	httpClient := oauth2.NewClient(context.TODO(), src)
+	// githubv4 doesn't expose the responses to tell the rate limiting apart
+	httpClient.Transport = &core.RateLimitTransport{Transport: httpClient.Transport}
 
 	return githubv4.NewClient(httpClient)
 }
@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/shurcooL/githubv4"
@@ -12,6 +13,7 @@ import (
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/milestone"
 	"github.com/MichaelMure/git-bug/util/text"
 )
 
@@ -27,10 +29,25 @@ type githubImporter struct {
 
 	// send only channel
 	out chan<- core.ImportResult
+
+	// select the issues to import
+	filter *core.ImportFilter
+
+	// only describe the changes, and the identities already described
+	dryRun           bool
+	dryRunIdentities map[string]bool
 }
 
-func (gi *githubImporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration) error {
+func (gi *githubImporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
 	gi.conf = conf
+	gi.dryRun = dryRun
+	gi.dryRunIdentities = make(map[string]bool)
+
+	filter, err := core.NewImportFilter(conf)
+	if err != nil {
+		return err
+	}
+	gi.filter = filter
 
 	creds, err := auth.List(repo,
 		auth.WithTarget(target),
@@ -53,6 +70,12 @@ func (gi *githubImporter) Init(_ context.Context, repo *cache.RepoCache, conf co
 // ImportAll iterate over all the configured repository issues and ensure the creation of the
 // missing issues / timeline items / edits / label events ...
 func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ImportResult, error) {
+	return gi.importIssues(ctx, repo, since, 0), nil
+}
+
+// importIssues import the issues updated since the given time, or only the
+// one with the given number if not zero
+func (gi *githubImporter) importIssues(ctx context.Context, repo *cache.RepoCache, since time.Time, number int) <-chan core.ImportResult {
 	gi.iterator = NewIterator(ctx, gi.client, 10, gi.conf[confKeyOwner], gi.conf[confKeyProject], since)
 	out := make(chan core.ImportResult)
 	gi.out = out
@@ -63,6 +86,21 @@ func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 		// Loop over all matching issues
 		for gi.iterator.NextIssue() {
 			issue := gi.iterator.IssueValue()
+
+			if number != 0 && int(issue.Number) != number {
+				continue
+			}
+
+			reason, err := gi.skipIssue(repo, issue)
+			if err != nil {
+				out <- core.NewImportError(err, "")
+				return
+			}
+			if reason != "" {
+				out <- core.NewImportNothing("", fmt.Sprintf("issue %s: %s", issue.Url.String(), reason))
+				continue
+			}
+
 			// create issue
 			b, err := gi.ensureIssue(repo, issue)
 			if err != nil {
@@ -71,6 +109,12 @@ func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 				return
 			}
 
+			if b == nil {
+				// a new bug in dry-run mode, its content would be imported
+				// with it
+				continue
+			}
+
 			// loop over timeline items
 			for gi.iterator.NextTimelineItem() {
 				item := gi.iterator.TimelineItemValue()
@@ -82,6 +126,10 @@ func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 				}
 			}
 
+			if gi.dryRun {
+				continue
+			}
+
 			if !b.NeedCommit() {
 				out <- core.NewImportNothing(b.Id(), "no imported operation")
 			} else if err := b.Commit(); err != nil {
@@ -97,7 +145,34 @@ func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 		}
 	}()
 
-	return out, nil
+	return out
+}
+
+// skipIssue return why an issue is filtered out of the import, if it is. The
+// issues already imported are always kept up to date.
+func (gi *githubImporter) skipIssue(repo *cache.RepoCache, issue issueTimeline) (string, error) {
+	_, err := gi.resolveIssue(repo, issue)
+	if err == nil {
+		return "", nil
+	}
+	if err != bug.ErrBugNotExist {
+		return "", err
+	}
+
+	labels := make([]string, len(issue.Labels.Nodes))
+	for i, label := range issue.Labels.Nodes {
+		labels[i] = string(label.Name)
+	}
+	open := issue.State != githubv4.IssueStateClosed
+	return gi.filter.Skip(labels, open, actorLogin(issue.Author)), nil
+}
+
+// resolveIssue return the bug imported from an issue
+func (gi *githubImporter) resolveIssue(repo *cache.RepoCache, issue issueTimeline) (*cache.BugCache, error) {
+	return repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
+		return excerpt.CreateMetadata[core.MetaKeyOrigin] == target &&
+			excerpt.CreateMetadata[metaKeyGithubId] == parseId(issue.Id)
+	})
 }
 
 func (gi *githubImporter) ensureIssue(repo *cache.RepoCache, issue issueTimeline) (*cache.BugCache, error) {
@@ -108,10 +183,7 @@ func (gi *githubImporter) ensureIssue(repo *cache.RepoCache, issue issueTimeline
 	}
 
 	// resolve bug
-	b, err := repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
-		return excerpt.CreateMetadata[core.MetaKeyOrigin] == target &&
-			excerpt.CreateMetadata[metaKeyGithubId] == parseId(issue.Id)
-	})
+	b, err := gi.resolveIssue(repo, issue)
 	if err != nil && err != bug.ErrBugNotExist {
 		return nil, err
 	}
@@ -124,6 +196,10 @@ func (gi *githubImporter) ensureIssue(repo *cache.RepoCache, issue issueTimeline
 
 	// if issueEdits is empty
 	if len(issueEdits) == 0 {
+		if err == bug.ErrBugNotExist && gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventBug, "", fmt.Sprintf("create the bug \"%s\"", issue.Title))
+			return nil, nil
+		}
 		if err == bug.ErrBugNotExist {
 			cleanText, err := text.Cleanup(string(issue.Body))
 			if err != nil {
@@ -163,6 +239,10 @@ func (gi *githubImporter) ensureIssue(repo *cache.RepoCache, issue issueTimeline
 			}
 
 			// if the bug doesn't exist
+			if b == nil && gi.dryRun {
+				gi.out <- core.NewImportDryRun(core.ImportEventBug, "", fmt.Sprintf("create the bug \"%s\"", issue.Title))
+				return nil, nil
+			}
 			if b == nil {
 				// we create the bug as soon as we have a legit first edition
 				b, _, err = repo.NewBugRaw(
@@ -204,6 +284,17 @@ func (gi *githubImporter) ensureIssue(repo *cache.RepoCache, issue issueTimeline
 		}
 	}
 
+	// reactions on the issue body
+	createOpID, err := b.ResolveOperationWithMetadata(metaKeyGithubId, parseId(issue.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	err = gi.ensureReactions(repo, b, createOpID, issue.Reactions)
+	if err != nil {
+		return nil, err
+	}
+
 	return b, nil
 }
 
@@ -234,6 +325,11 @@ func (gi *githubImporter) ensureTimelineItem(repo *cache.RepoCache, b *cache.Bug
 		if err != cache.ErrNoMatchingOp {
 			return err
 		}
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventLabelChange, b.Id(),
+				fmt.Sprintf("add the label %s", item.LabeledEvent.Label.Name))
+			return nil
+		}
 		author, err := gi.ensurePerson(repo, item.LabeledEvent.Actor)
 		if err != nil {
 			return err
@@ -263,6 +359,11 @@ func (gi *githubImporter) ensureTimelineItem(repo *cache.RepoCache, b *cache.Bug
 		if err != cache.ErrNoMatchingOp {
 			return err
 		}
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventLabelChange, b.Id(),
+				fmt.Sprintf("remove the label %s", item.UnlabeledEvent.Label.Name))
+			return nil
+		}
 		author, err := gi.ensurePerson(repo, item.UnlabeledEvent.Actor)
 		if err != nil {
 			return err
@@ -284,6 +385,166 @@ func (gi *githubImporter) ensureTimelineItem(repo *cache.RepoCache, b *cache.Bug
 		gi.out <- core.NewImportLabelChange(op.Id())
 		return nil
 
+	case "AssignedEvent":
+		id := parseId(item.AssignedEvent.Id)
+		_, err := b.ResolveOperationWithMetadata(metaKeyGithubId, id)
+		if err == nil {
+			return nil
+		}
+		if err != cache.ErrNoMatchingOp {
+			return err
+		}
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventAssigneeChange, b.Id(), "add an assignee")
+			return nil
+		}
+		author, err := gi.ensurePerson(repo, item.AssignedEvent.Actor)
+		if err != nil {
+			return err
+		}
+		assignee, err := gi.ensureAssignee(repo, item.AssignedEvent.Assignee)
+		if err != nil {
+			return err
+		}
+
+		op, err := b.ForceChangeAssigneesRaw(
+			author,
+			item.AssignedEvent.CreatedAt.Unix(),
+			[]*cache.IdentityCache{assignee},
+			nil,
+			map[string]string{metaKeyGithubId: id},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportAssigneeChange(op.Id())
+		return nil
+
+	case "UnassignedEvent":
+		id := parseId(item.UnassignedEvent.Id)
+		_, err := b.ResolveOperationWithMetadata(metaKeyGithubId, id)
+		if err == nil {
+			return nil
+		}
+		if err != cache.ErrNoMatchingOp {
+			return err
+		}
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventAssigneeChange, b.Id(), "remove an assignee")
+			return nil
+		}
+		author, err := gi.ensurePerson(repo, item.UnassignedEvent.Actor)
+		if err != nil {
+			return err
+		}
+		assignee, err := gi.ensureAssignee(repo, item.UnassignedEvent.Assignee)
+		if err != nil {
+			return err
+		}
+
+		op, err := b.ForceChangeAssigneesRaw(
+			author,
+			item.UnassignedEvent.CreatedAt.Unix(),
+			nil,
+			[]*cache.IdentityCache{assignee},
+			map[string]string{metaKeyGithubId: id},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportAssigneeChange(op.Id())
+		return nil
+
+	case "MilestonedEvent":
+		id := parseId(item.MilestonedEvent.Id)
+		_, err := b.ResolveOperationWithMetadata(metaKeyGithubId, id)
+		if err == nil {
+			return nil
+		}
+		if err != cache.ErrNoMatchingOp {
+			return err
+		}
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventMilestoneChange, b.Id(),
+				fmt.Sprintf("set the milestone \"%s\"", item.MilestonedEvent.MilestoneTitle))
+			return nil
+		}
+		author, err := gi.ensurePerson(repo, item.MilestonedEvent.Actor)
+		if err != nil {
+			return err
+		}
+		m, err := gi.ensureMilestone(repo, author, item.MilestonedEvent.CreatedAt.Unix(), string(item.MilestonedEvent.MilestoneTitle))
+		if err != nil {
+			return err
+		}
+
+		if b.Snapshot().Milestone == m.Id() {
+			gi.out <- core.NewImportNothing(b.Id(), "milestone already set")
+			return nil
+		}
+
+		op, err := b.SetMilestoneRaw(
+			author,
+			item.MilestonedEvent.CreatedAt.Unix(),
+			m,
+			map[string]string{metaKeyGithubId: id},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportMilestoneChange(op.Id())
+		return nil
+
+	case "DemilestonedEvent":
+		id := parseId(item.DemilestonedEvent.Id)
+		_, err := b.ResolveOperationWithMetadata(metaKeyGithubId, id)
+		if err == nil {
+			return nil
+		}
+		if err != cache.ErrNoMatchingOp {
+			return err
+		}
+
+		// Github emit a DemilestonedEvent when the milestone of an issue is
+		// replaced, possibly after the MilestonedEvent of the new one.
+		current := b.Snapshot().Milestone
+		if current == "" {
+			return nil
+		}
+		excerpt, err := repo.ResolveMilestoneExcerpt(current)
+		if err != nil {
+			return err
+		}
+		if excerpt.Title != string(item.DemilestonedEvent.MilestoneTitle) {
+			return nil
+		}
+
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventMilestoneChange, b.Id(), "unset the milestone")
+			return nil
+		}
+
+		author, err := gi.ensurePerson(repo, item.DemilestonedEvent.Actor)
+		if err != nil {
+			return err
+		}
+
+		op, err := b.SetMilestoneRaw(
+			author,
+			item.DemilestonedEvent.CreatedAt.Unix(),
+			nil,
+			map[string]string{metaKeyGithubId: id},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportMilestoneChange(op.Id())
+		return nil
+
 	case "ClosedEvent":
 		id := parseId(item.ClosedEvent.Id)
 		_, err := b.ResolveOperationWithMetadata(metaKeyGithubId, id)
@@ -293,13 +554,19 @@ func (gi *githubImporter) ensureTimelineItem(repo *cache.RepoCache, b *cache.Bug
 		if err == nil {
 			return nil
 		}
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventStatusChange, b.Id(), "close the bug")
+			return nil
+		}
 		author, err := gi.ensurePerson(repo, item.ClosedEvent.Actor)
 		if err != nil {
 			return err
 		}
-		op, err := b.CloseRaw(
+		op, err := b.ImportStatusRaw(
 			author,
 			item.ClosedEvent.CreatedAt.Unix(),
+			bug.ClosedStatus,
+			convertStateReason(item.ClosedEvent.StateReason),
 			map[string]string{metaKeyGithubId: id},
 		)
 
@@ -319,13 +586,19 @@ func (gi *githubImporter) ensureTimelineItem(repo *cache.RepoCache, b *cache.Bug
 		if err == nil {
 			return nil
 		}
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventStatusChange, b.Id(), "reopen the bug")
+			return nil
+		}
 		author, err := gi.ensurePerson(repo, item.ReopenedEvent.Actor)
 		if err != nil {
 			return err
 		}
-		op, err := b.OpenRaw(
+		op, err := b.ImportStatusRaw(
 			author,
 			item.ReopenedEvent.CreatedAt.Unix(),
+			bug.OpenStatus,
+			"",
 			map[string]string{metaKeyGithubId: id},
 		)
 
@@ -345,6 +618,11 @@ func (gi *githubImporter) ensureTimelineItem(repo *cache.RepoCache, b *cache.Bug
 		if err == nil {
 			return nil
 		}
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventTitleEdition, b.Id(),
+				fmt.Sprintf("change the title to \"%s\"", item.RenamedTitleEvent.CurrentTitle))
+			return nil
+		}
 		author, err := gi.ensurePerson(repo, item.RenamedTitleEvent.Actor)
 		if err != nil {
 			return err
@@ -381,6 +659,10 @@ func (gi *githubImporter) ensureTimelineComment(repo *cache.RepoCache, b *cache.
 
 	// if no edits are given we create the comment
 	if len(edits) == 0 {
+		if err == cache.ErrNoMatchingOp && gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventComment, b.Id(), fmt.Sprintf("add a comment by %s", actorLogin(item.Author)))
+			return nil
+		}
 		if err == cache.ErrNoMatchingOp {
 			cleanText, err := text.Cleanup(string(item.Body))
 			if err != nil {
@@ -403,7 +685,7 @@ func (gi *githubImporter) ensureTimelineComment(repo *cache.RepoCache, b *cache.
 			}
 
 			gi.out <- core.NewImportComment(op.Id())
-			return nil
+			targetOpID = op.Id()
 		}
 
 	} else {
@@ -420,6 +702,10 @@ func (gi *githubImporter) ensureTimelineComment(repo *cache.RepoCache, b *cache.
 			}
 
 			// create comment when target is empty
+			if targetOpID == "" && gi.dryRun {
+				gi.out <- core.NewImportDryRun(core.ImportEventComment, b.Id(), fmt.Sprintf("add a comment by %s", actorLogin(item.Author)))
+				return nil
+			}
 			if targetOpID == "" {
 				cleanText, err := text.Cleanup(string(*edit.Diff))
 				if err != nil {
@@ -452,6 +738,59 @@ func (gi *githubImporter) ensureTimelineComment(repo *cache.RepoCache, b *cache.
 			}
 		}
 	}
+
+	if targetOpID == "" {
+		// the comment couldn't be created from its edits
+		return nil
+	}
+
+	return gi.ensureReactions(repo, b, targetOpID, item.Reactions)
+}
+
+// ensureReactions import the reactions of a comment or of the issue body
+func (gi *githubImporter) ensureReactions(repo *cache.RepoCache, b *cache.BugCache, target entity.Id, reactions reactions) error {
+	for _, reaction := range reactions.Nodes {
+		id := parseId(reaction.Id)
+		_, err := b.ResolveOperationWithMetadata(metaKeyGithubId, id)
+		if err == nil {
+			continue
+		}
+		if err != cache.ErrNoMatchingOp {
+			return err
+		}
+
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventReaction, b.Id(),
+				fmt.Sprintf("add the reaction %s", convertReaction(reaction.Content)))
+			continue
+		}
+
+		var author *cache.IdentityCache
+		if reaction.User == nil {
+			author, err = gi.getGhost(repo)
+		} else {
+			author, err = gi.ensurePerson(repo, &reaction.User.Actor)
+		}
+		if err != nil {
+			return err
+		}
+
+		op, err := b.AddReactionRaw(
+			author,
+			reaction.CreatedAt.Unix(),
+			target,
+			convertReaction(reaction.Content),
+			map[string]string{
+				metaKeyGithubId: id,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportReaction(op.Id())
+	}
+
 	return nil
 }
 
@@ -465,6 +804,13 @@ func (gi *githubImporter) ensureCommentEdit(repo *cache.RepoCache, b *cache.BugC
 		return err
 	}
 
+	if gi.dryRun {
+		if edit.DeletedAt == nil {
+			gi.out <- core.NewImportDryRun(core.ImportEventCommentEdition, b.Id(), "edit a comment")
+		}
+		return nil
+	}
+
 	editor, err := gi.ensurePerson(repo, edit.Editor)
 	if err != nil {
 		return err
@@ -520,6 +866,11 @@ func (gi *githubImporter) ensurePerson(repo *cache.RepoCache, actor *actor) (*ca
 		return nil, err
 	}
 
+	if gi.dryRun {
+		gi.dryRunIdentity(string(actor.Login))
+		return nil, nil
+	}
+
 	// importing a new identity
 
 	var name string
@@ -564,6 +915,47 @@ func (gi *githubImporter) ensurePerson(repo *cache.RepoCache, actor *actor) (*ca
 	return i, nil
 }
 
+// ensureAssignee create a bug.Person from the assignee of a Github event
+func (gi *githubImporter) ensureAssignee(repo *cache.RepoCache, assignee *assignee) (*cache.IdentityCache, error) {
+	if assignee == nil {
+		return gi.getGhost(repo)
+	}
+	return gi.ensurePerson(repo, &assignee.Actor)
+}
+
+// ensureMilestone find the milestone with the given title, or create it. Github
+// timeline events only expose the title of the milestone.
+func (gi *githubImporter) ensureMilestone(repo *cache.RepoCache, author *cache.IdentityCache, unixTime int64, title string) (*cache.MilestoneCache, error) {
+	m, err := repo.ResolveMilestoneTitle(title)
+	if err == nil {
+		return m, nil
+	}
+	if err != milestone.ErrMilestoneNotExist {
+		return nil, err
+	}
+
+	m, err = repo.NewMilestoneRaw(author, unixTime, title, "", time.Time{},
+		map[string]string{
+			core.MetaKeyOrigin: target,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	gi.out <- core.NewImportMilestone(m.Id())
+	return m, nil
+}
+
+// dryRunIdentity describe the creation of the identity of a Github user, once
+func (gi *githubImporter) dryRunIdentity(login string) {
+	if gi.dryRunIdentities[login] {
+		return
+	}
+	gi.dryRunIdentities[login] = true
+	gi.out <- core.NewImportDryRun(core.ImportEventIdentity, "", fmt.Sprintf("create the identity %s", login))
+}
+
 func (gi *githubImporter) getGhost(repo *cache.RepoCache) (*cache.IdentityCache, error) {
 	// Look first in the cache
 	i, err := repo.ResolveIdentityImmutableMetadata(metaKeyGithubLogin, "ghost")
@@ -574,6 +966,11 @@ func (gi *githubImporter) getGhost(repo *cache.RepoCache) (*cache.IdentityCache,
 		return nil, err
 	}
 
+	if gi.dryRun {
+		gi.dryRunIdentity("ghost")
+		return nil, nil
+	}
+
 	var q ghostQuery
 
 	variables := map[string]interface{}{
@@ -604,7 +1001,59 @@ func (gi *githubImporter) getGhost(repo *cache.RepoCache) (*cache.IdentityCache,
 	)
 }
 
+// convertReaction convert a Github reaction to its git-bug equivalent
+func convertReaction(content githubv4.ReactionContent) bug.Reaction {
+	switch content {
+	case githubv4.ReactionContentThumbsUp:
+		return bug.ReactionThumbsUp
+	case githubv4.ReactionContentThumbsDown:
+		return bug.ReactionThumbsDown
+	case githubv4.ReactionContentLaugh:
+		return bug.ReactionLaugh
+	case githubv4.ReactionContentHooray:
+		return bug.ReactionHooray
+	case githubv4.ReactionContentConfused:
+		return bug.ReactionConfused
+	case githubv4.ReactionContentHeart:
+		return bug.ReactionHeart
+	case githubv4.ReactionContentRocket:
+		return bug.ReactionRocket
+	case githubv4.ReactionContentEyes:
+		return bug.ReactionEyes
+	default:
+		return bug.Reaction(strings.ToLower(string(content)))
+	}
+}
+
+// convertStateReason convert the reason a Github issue was closed for to its
+// git-bug equivalent
+func convertStateReason(reason *githubv4.String) bug.Resolution {
+	if reason == nil {
+		return ""
+	}
+
+	switch *reason {
+	case "COMPLETED":
+		return bug.ResolutionFixed
+	case "NOT_PLANNED":
+		return bug.ResolutionWontFix
+	case "DUPLICATE":
+		return bug.ResolutionDuplicate
+	default:
+		return ""
+	}
+}
+
 // parseId convert the unusable githubv4.ID (an interface{}) into a string
 func parseId(id githubv4.ID) string {
 	return fmt.Sprintf("%v", id)
 }
+
+// actorLogin return the login of a Github actor, a null actor being a
+// deleted user
+func actorLogin(a *actor) string {
+	if a == nil {
+		return "ghost"
+	}
+	return string(a.Login)
+}
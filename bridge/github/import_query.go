@@ -23,6 +23,30 @@ type actor struct {
 	} `graphql:"... on Organization"`
 }
 
+// assignee is the union of the kind of users that can be assigned,
+// reduced to their common Actor interface
+type assignee struct {
+	Actor actor `graphql:"... on Actor"`
+}
+
+// reactor is the user who reacted to a comment, reduced to its Actor interface
+type reactor struct {
+	Actor actor `graphql:"... on Actor"`
+}
+
+type reaction struct {
+	Id        githubv4.ID
+	Content   githubv4.ReactionContent
+	CreatedAt githubv4.DateTime
+	User      *reactor
+}
+
+// reactions only fetch the first page of reactions, which should be more
+// than enough in practice
+type reactions struct {
+	Nodes []reaction
+}
+
 type actorEvent struct {
 	Id        githubv4.ID
 	CreatedAt githubv4.DateTime
@@ -51,6 +75,8 @@ type issueComment struct {
 	Body githubv4.String
 	Url  githubv4.URI
 
+	Reactions reactions `graphql:"reactions(first: 100)"`
+
 	UserContentEdits struct {
 		Nodes    []userContentEdit
 		PageInfo pageInfo
@@ -79,10 +105,31 @@ type timelineItem struct {
 		}
 	} `graphql:"... on UnlabeledEvent"`
 
+	// Assignees
+	AssignedEvent struct {
+		actorEvent
+		Assignee *assignee
+	} `graphql:"... on AssignedEvent"`
+	UnassignedEvent struct {
+		actorEvent
+		Assignee *assignee
+	} `graphql:"... on UnassignedEvent"`
+
+	// Milestone
+	MilestonedEvent struct {
+		actorEvent
+		MilestoneTitle githubv4.String
+	} `graphql:"... on MilestonedEvent"`
+	DemilestonedEvent struct {
+		actorEvent
+		MilestoneTitle githubv4.String
+	} `graphql:"... on DemilestonedEvent"`
+
 	// Status
 	ClosedEvent struct {
 		actorEvent
 		// Url githubv4.URI
+		StateReason *githubv4.String
 	} `graphql:"... on  ClosedEvent"`
 	ReopenedEvent struct {
 		actorEvent
@@ -98,9 +145,19 @@ type timelineItem struct {
 
 type issueTimeline struct {
 	authorEvent
-	Title string
-	Body  githubv4.String
-	Url   githubv4.URI
+	Number githubv4.Int
+	Title  string
+	Body   githubv4.String
+	Url    githubv4.URI
+	State  githubv4.IssueState
+
+	Labels struct {
+		Nodes []struct {
+			Name githubv4.String
+		}
+	} `graphql:"labels(first: 100)"`
+
+	Reactions reactions `graphql:"reactions(first: 100)"`
 
 	TimelineItems struct {
 		Edges []struct {
@@ -168,6 +225,12 @@ type ghostQuery struct {
 	} `graphql:"user(login: $login)"`
 }
 
+type userQuery struct {
+	User struct {
+		ID string `graphql:"id"`
+	} `graphql:"user(login: $login)"`
+}
+
 type labelsQuery struct {
 	Repository struct {
 		Labels struct {
@@ -182,6 +245,19 @@ type labelsQuery struct {
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
+type milestonesQuery struct {
+	Repository struct {
+		Milestones struct {
+			Nodes []struct {
+				ID     string `graphql:"id"`
+				Number int    `graphql:"number"`
+				Title  string `graphql:"title"`
+			}
+			PageInfo pageInfo
+		} `graphql:"milestones(first: $first, after: $after)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
 type loginQuery struct {
 	Viewer struct {
 		Login string `graphql:"login"`
@@ -156,7 +156,7 @@ func Test_Importer(t *testing.T) {
 		confKeyOwner:        "MichaelMure",
 		confKeyProject:      "git-bug-test-github-bridge",
 		confKeyDefaultLogin: login,
-	})
+	}, false)
 	require.NoError(t, err)
 
 	start := time.Now()
@@ -0,0 +1,83 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+var _ core.WebhookImpl = &Github{}
+var _ core.WebhookImporter = &githubImporter{}
+
+// the webhook events changing the issues
+var webhookEvents = map[string]bool{
+	"issues":        true,
+	"issue_comment": true,
+}
+
+// the issues updated this long before the event are also listed, to not
+// depend on the exact timestamps
+const webhookSinceMargin = time.Minute
+
+// VerifyWebhook check the HMAC signature of a Github webhook, preferring the
+// sha256 one when both are sent.
+func (*Github) VerifyWebhook(secret string, header http.Header, payload []byte) error {
+	if sig := header.Get("X-Hub-Signature-256"); sig != "" {
+		return core.CheckHMACSignature("sha256", secret, payload, strings.TrimPrefix(sig, "sha256="))
+	}
+	if sig := header.Get("X-Hub-Signature"); sig != "" {
+		return core.CheckHMACSignature("sha1", secret, payload, strings.TrimPrefix(sig, "sha1="))
+	}
+	return core.ErrWebhookSignature
+}
+
+// webhookEvent is the part of a Github webhook payload identifying the issue
+// changed
+type webhookEvent struct {
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Issue struct {
+		Number      int             `json:"number"`
+		UpdatedAt   time.Time       `json:"updated_at"`
+		PullRequest json.RawMessage `json:"pull_request"`
+	} `json:"issue"`
+}
+
+// DecodeWebhook return the event of a webhook payload, or nil if it doesn't
+// change an issue of the configured project
+func (*Github) DecodeWebhook(conf core.Configuration, header http.Header, payload []byte) (interface{}, error) {
+	if !webhookEvents[header.Get("X-GitHub-Event")] {
+		return nil, nil
+	}
+
+	var event webhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(event.Repository.FullName, conf[confKeyOwner]+"/"+conf[confKeyProject]) {
+		return nil, nil
+	}
+
+	// the comments on the pull requests are notified as well
+	if event.Issue.Number == 0 || len(event.Issue.PullRequest) > 0 {
+		return nil, nil
+	}
+
+	return &event, nil
+}
+
+// ImportWebhook import the issue changed by a webhook event. As the Github
+// API can't query the timeline of a single issue the way the import does, the
+// issues updated since the event are listed and only this one is imported.
+func (gi *githubImporter) ImportWebhook(ctx context.Context, repo *cache.RepoCache, e interface{}) (<-chan core.ImportResult, error) {
+	event := e.(*webhookEvent)
+	since := event.Issue.UpdatedAt.Add(-webhookSinceMargin)
+	return gi.importIssues(ctx, repo, since, event.Issue.Number), nil
+}
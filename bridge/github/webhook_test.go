@@ -0,0 +1,105 @@
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+func TestVerifyWebhook(t *testing.T) {
+	payload := []byte(`{"action":"opened","repository":{"full_name":"MichaelMure/git-bug"}}`)
+
+	sign := func(secret string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	tests := []struct {
+		name      string
+		signature string
+		err       error
+	}{
+		{name: "signed", signature: sign("secret")},
+		{name: "wrong secret", signature: sign("other"), err: core.ErrWebhookSignature},
+		{name: "not signed", err: core.ErrWebhookSignature},
+	}
+
+	g := &Github{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("X-GitHub-Event", "issues")
+			if tt.signature != "" {
+				header.Set("X-Hub-Signature-256", tt.signature)
+			}
+
+			err := g.VerifyWebhook("secret", header, payload)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestDecodeWebhook(t *testing.T) {
+	conf := core.Configuration{
+		confKeyOwner:   "MichaelMure",
+		confKeyProject: "git-bug",
+	}
+
+	tests := []struct {
+		name    string
+		event   string
+		payload string
+		number  int
+	}{
+		{
+			name:    "issue",
+			event:   "issues",
+			payload: `{"action":"opened","repository":{"full_name":"MichaelMure/git-bug"},"issue":{"number":12,"updated_at":"2020-01-02T03:04:05Z"}}`,
+			number:  12,
+		},
+		{
+			name:    "comment",
+			event:   "issue_comment",
+			payload: `{"action":"created","repository":{"full_name":"michaelmure/git-bug"},"issue":{"number":3,"updated_at":"2020-01-02T03:04:05Z"}}`,
+			number:  3,
+		},
+		{
+			name:    "pull request comment",
+			event:   "issue_comment",
+			payload: `{"action":"created","repository":{"full_name":"MichaelMure/git-bug"},"issue":{"number":4,"pull_request":{"url":"https://api.github.com/repos/MichaelMure/git-bug/pulls/4"}}}`,
+		},
+		{
+			name:    "other repository",
+			event:   "issues",
+			payload: `{"action":"opened","repository":{"full_name":"MichaelMure/other"},"issue":{"number":12}}`,
+		},
+		{
+			name:    "ping",
+			event:   "ping",
+			payload: `{"zen":"Keep it logically awesome."}`,
+		},
+	}
+
+	g := &Github{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("X-GitHub-Event", tt.event)
+
+			event, err := g.DecodeWebhook(conf, header, []byte(tt.payload))
+			require.NoError(t, err)
+			if tt.number == 0 {
+				require.Nil(t, event)
+				return
+			}
+			require.Equal(t, tt.number, event.(*webhookEvent).Issue.Number)
+		})
+	}
+}
@@ -24,11 +24,12 @@ var (
 
 func (g *Gitlab) ValidParams() map[string]interface{} {
 	return map[string]interface{}{
-		"URL":        nil,
-		"BaseURL":    nil,
-		"Login":      nil,
-		"CredPrefix": nil,
-		"TokenRaw":   nil,
+		"URL":           nil,
+		"BaseURL":       nil,
+		"Login":         nil,
+		"CredPrefix":    nil,
+		"TokenRaw":      nil,
+		"WebhookSecret": nil,
 	}
 }
 
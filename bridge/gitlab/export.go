@@ -35,13 +35,21 @@ type gitlabExporter struct {
 	// cache identifiers used to speed up exporting operations
 	// cleared for each bug
 	cachedOperationIDs map[string]string
+
+	// cache gitlab milestones ID by title
+	cachedMilestones map[string]int
+
+	// only describe the changes
+	dryRun bool
 }
 
 // Init .
-func (ge *gitlabExporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration) error {
+func (ge *gitlabExporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
 	ge.conf = conf
+	ge.dryRun = dryRun
 	ge.identityClient = make(map[entity.Id]*gitlab.Client)
 	ge.cachedOperationIDs = make(map[string]string)
+	ge.cachedMilestones = make(map[string]int)
 
 	// get repository node id
 	ge.repositoryID = ge.conf[confKeyProjectID]
@@ -103,7 +111,7 @@ func (ge *gitlabExporter) getIdentityClient(userId entity.Id) (*gitlab.Client, e
 }
 
 // ExportAll export all event made by the current user to Gitlab
-func (ge *gitlabExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ExportResult, error) {
+func (ge *gitlabExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, ids []entity.Id, since time.Time) (<-chan core.ExportResult, error) {
 	out := make(chan core.ExportResult)
 
 	go func() {
@@ -114,9 +122,7 @@ func (ge *gitlabExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 			allIdentitiesIds = append(allIdentitiesIds, id)
 		}
 
-		allBugsIds := repo.AllBugsIds()
-
-		for _, id := range allBugsIds {
+		for _, id := range ids {
 			select {
 			case <-ctx.Done():
 				return
@@ -138,7 +144,7 @@ func (ge *gitlabExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 
 				if snapshot.HasAnyActor(allIdentitiesIds...) {
 					// try to export the bug and it associated events
-					ge.exportBug(ctx, b, out)
+					ge.exportBug(ctx, repo, b, out)
 				}
 			}
 		}
@@ -148,7 +154,7 @@ func (ge *gitlabExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 }
 
 // exportBug publish bugs and related events
-func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out chan<- core.ExportResult) {
+func (ge *gitlabExporter) exportBug(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, out chan<- core.ExportResult) {
 	snapshot := b.Snapshot()
 
 	var bugUpdated bool
@@ -211,42 +217,47 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			return
 		}
 
-		// create bug
-		_, id, url, err := createGitlabIssue(ctx, client, ge.repositoryID, createOp.Title, createOp.Message)
-		if err != nil {
-			err := errors.Wrap(err, "exporting gitlab issue")
-			out <- core.NewExportError(err, b.Id())
-			return
-		}
+		if ge.dryRun {
+			out <- core.NewExportDryRun(core.ExportEventBug, b.Id(), fmt.Sprintf("create the issue \"%s\"", createOp.Title))
+			bugUpdated = true
+		} else {
+			// create bug
+			_, id, url, err := createGitlabIssue(ctx, client, ge.repositoryID, createOp.Title, createOp.Message)
+			if err != nil {
+				err := errors.Wrap(err, "exporting gitlab issue")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
 
-		idString := strconv.Itoa(id)
-		out <- core.NewExportBug(b.Id())
-
-		_, err = b.SetMetadata(
-			createOp.Id(),
-			map[string]string{
-				metaKeyGitlabId:      idString,
-				metaKeyGitlabUrl:     url,
-				metaKeyGitlabProject: ge.repositoryID,
-				metaKeyGitlabBaseUrl: GitlabBaseUrl,
-			},
-		)
-		if err != nil {
-			err := errors.Wrap(err, "marking operation as exported")
-			out <- core.NewExportError(err, b.Id())
-			return
-		}
+			idString := strconv.Itoa(id)
+			out <- core.NewExportBug(b.Id())
+
+			_, err = b.SetMetadata(
+				createOp.Id(),
+				map[string]string{
+					metaKeyGitlabId:      idString,
+					metaKeyGitlabUrl:     url,
+					metaKeyGitlabProject: ge.repositoryID,
+					metaKeyGitlabBaseUrl: GitlabBaseUrl,
+				},
+			)
+			if err != nil {
+				err := errors.Wrap(err, "marking operation as exported")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
 
-		// commit operation to avoid creating multiple issues with multiple pushes
-		if err := b.CommitAsNeeded(); err != nil {
-			err := errors.Wrap(err, "bug commit")
-			out <- core.NewExportError(err, b.Id())
-			return
-		}
+			// commit operation to avoid creating multiple issues with multiple pushes
+			if err := b.CommitAsNeeded(); err != nil {
+				err := errors.Wrap(err, "bug commit")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
 
-		// cache bug gitlab ID and URL
-		bugGitlabID = id
-		bugGitlabIDString = idString
+			// cache bug gitlab ID and URL
+			bugGitlabID = id
+			bugGitlabIDString = idString
+		}
 	}
 
 	bugCreationId = createOp.Id().String()
@@ -254,12 +265,19 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 	ge.cachedOperationIDs[bugCreationId] = bugGitlabIDString
 
 	labelSet := make(map[string]struct{})
+	// as for labels, gitlab need the complete list of assignees at each change,
+	// so we track them across all operations, exported or not
+	var assignees []identity.Interface
 	for _, op := range snapshot.Operations[1:] {
 		// ignore SetMetadata operations
 		if _, ok := op.(*bug.SetMetadataOperation); ok {
 			continue
 		}
 
+		if op, ok := op.(*bug.SetAssigneesOperation); ok {
+			assignees = applyAssigneesChange(assignees, op)
+		}
+
 		// ignore operations already existing in gitlab (due to import or export)
 		// cache the ID of already exported or imported issues and events from Gitlab
 		if id, ok := op.GetMetadata(metaKeyGitlabId); ok {
@@ -277,6 +295,11 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 		var idString, url string
 		switch op := op.(type) {
 		case *bug.AddCommentOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
 
 			// send operation to gitlab
 			id, err = addCommentGitlabIssue(ctx, client, ge.repositoryID, bugGitlabID, op.Message)
@@ -293,6 +316,12 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			ge.cachedOperationIDs[op.Id().String()] = idString
 
 		case *bug.EditCommentOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
 			targetId := op.Target.String()
 
 			// Since gitlab doesn't consider the issue body as a comment
@@ -334,6 +363,12 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			}
 
 		case *bug.SetStatusOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
 			if err := updateGitlabIssueStatus(ctx, client, ge.repositoryID, bugGitlabID, op.Status); err != nil {
 				err := errors.Wrap(err, "editing status")
 				out <- core.NewExportError(err, b.Id())
@@ -344,6 +379,12 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			id = bugGitlabID
 
 		case *bug.SetTitleOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
 			if err := updateGitlabIssueTitle(ctx, client, ge.repositoryID, bugGitlabID, op.Title); err != nil {
 				err := errors.Wrap(err, "editing title")
 				out <- core.NewExportError(err, b.Id())
@@ -354,6 +395,12 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 			id = bugGitlabID
 
 		case *bug.LabelChangeOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
 			// we need to set the actual list of labels at each label change operation
 			// because gitlab update issue requests need directly the latest list of the verison
 
@@ -378,6 +425,117 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, out
 
 			out <- core.NewExportLabelChange(op.Id())
 			id = bugGitlabID
+
+		case *bug.SetAssigneesOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			assigneeIDs, err := ge.getUsersIDs(ctx, client, assignees)
+			if err != nil {
+				err := errors.Wrap(err, "resolving assignees")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			if err := updateGitlabIssueAssignees(ctx, client, ge.repositoryID, bugGitlabID, assigneeIDs); err != nil {
+				err := errors.Wrap(err, "updating assignees")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportAssigneeChange(op.Id())
+			id = bugGitlabID
+
+		case *bug.SetMilestoneOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			// 0 remove the milestone
+			var milestoneID int
+			if op.Milestone != "" {
+				m, err := repo.ResolveMilestone(op.Milestone)
+				if err != nil {
+					err := errors.Wrap(err, "resolving milestone")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+
+				milestoneID, err = ge.getOrCreateGitlabMilestone(ctx, client, m)
+				if err != nil {
+					err := errors.Wrap(err, "creating milestone")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+			}
+
+			if err := updateGitlabIssueMilestone(ctx, client, ge.repositoryID, bugGitlabID, milestoneID); err != nil {
+				err := errors.Wrap(err, "updating milestone")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportMilestoneChange(op.Id())
+			id = bugGitlabID
+
+		case *bug.SetLinksOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "links are not exported to GitLab")
+			continue
+
+		case *bug.AddReactionOperation, *bug.RemoveReactionOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "reactions are not exported to GitLab")
+			continue
+
+		case *bug.RedactCommentOperation:
+			if ge.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			targetId := op.Target.String()
+
+			// the issue body can't be removed, only blanked
+			if targetId == bugCreationId {
+				if err := updateGitlabIssueBody(ctx, client, ge.repositoryID, bugGitlabID, ""); err != nil {
+					err := errors.Wrap(err, "redacting issue body")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+
+				id = bugGitlabID
+
+			} else {
+				commentID, ok := ge.cachedOperationIDs[targetId]
+				if !ok {
+					out <- core.NewExportNothing(op.Id(), "the redacted comment is not on GitLab")
+					continue
+				}
+
+				commentIDint, err := strconv.Atoi(commentID)
+				if err != nil {
+					out <- core.NewExportError(fmt.Errorf("unexpected comment id format"), op.Target)
+					return
+				}
+
+				if err := deleteCommentGitlabIssue(ctx, client, ge.repositoryID, bugGitlabID, commentIDint); err != nil {
+					err := errors.Wrap(err, "deleting comment")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+
+				id = commentIDint
+			}
+
+			out <- core.NewExportCommentRedaction(op.Id())
+
 		default:
 			panic("unhandled operation type case")
 		}
@@ -468,6 +626,14 @@ func editCommentGitlabIssue(ctx context.Context, gc *gitlab.Client, repositoryID
 	return err
 }
 
+func deleteCommentGitlabIssue(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID, noteID int) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	_, err := gc.Notes.DeleteIssueNote(repositoryID, issueID, noteID, gitlab.WithContext(ctx))
+
+	return err
+}
+
 func updateGitlabIssueStatus(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, status bug.Status) error {
 	var state string
 
@@ -535,3 +701,160 @@ func updateGitlabIssueLabels(ctx context.Context, gc *gitlab.Client, repositoryI
 
 	return err
 }
+
+// update gitlab. issue assignees
+func updateGitlabIssueAssignees(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, assigneeIDs []int) error {
+	// an empty list would be omitted from the request, 0 unassign everyone
+	if len(assigneeIDs) == 0 {
+		assigneeIDs = []int{0}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	_, _, err := gc.Issues.UpdateIssue(
+		repositoryID, issueID,
+		&gitlab.UpdateIssueOptions{
+			AssigneeIDs: assigneeIDs,
+		},
+		gitlab.WithContext(ctx),
+	)
+
+	return err
+}
+
+// update gitlab issue milestone, 0 remove it
+func updateGitlabIssueMilestone(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, milestoneID int) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	_, _, err := gc.Issues.UpdateIssue(
+		repositoryID, issueID,
+		&gitlab.UpdateIssueOptions{
+			MilestoneID: &milestoneID,
+		},
+		gitlab.WithContext(ctx),
+	)
+
+	return err
+}
+
+// getOrCreateGitlabMilestone return the ID of the gitlab milestone matching
+// the given milestone, creating it if needed
+func (ge *gitlabExporter) getOrCreateGitlabMilestone(ctx context.Context, gc *gitlab.Client, m *cache.MilestoneCache) (int, error) {
+	// imported from this project
+	metadata := m.ImmutableMetadata()
+	if metadata[metaKeyGitlabProject] == ge.repositoryID {
+		if id, err := strconv.Atoi(metadata[metaKeyGitlabId]); err == nil {
+			return id, nil
+		}
+	}
+
+	if id, ok := ge.cachedMilestones[m.Title()]; ok {
+		return id, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	title := m.Title()
+	milestones, _, err := gc.Milestones.ListMilestones(
+		ge.repositoryID,
+		&gitlab.ListMilestonesOptions{Title: &title},
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(milestones) > 0 {
+		ge.cachedMilestones[title] = milestones[0].ID
+		return milestones[0].ID, nil
+	}
+
+	options := &gitlab.CreateMilestoneOptions{
+		Title: &title,
+	}
+	if description := m.Description(); description != "" {
+		options.Description = &description
+	}
+	if due := m.DueDate(); !due.IsZero() {
+		isoDue := gitlab.ISOTime(due)
+		options.DueDate = &isoDue
+	}
+
+	created, _, err := gc.Milestones.CreateMilestone(ge.repositoryID, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	ge.cachedMilestones[title] = created.ID
+	return created.ID, nil
+}
+
+// applyAssigneesChange return the list of assignees after the given operation
+func applyAssigneesChange(assignees []identity.Interface, op *bug.SetAssigneesOperation) []identity.Interface {
+	result := make([]identity.Interface, 0, len(assignees)+len(op.Added))
+
+	for _, assignee := range assignees {
+		if !containsIdentity(op.Removed, assignee) {
+			result = append(result, assignee)
+		}
+	}
+
+	for _, added := range op.Added {
+		if !containsIdentity(result, added) {
+			result = append(result, added)
+		}
+	}
+
+	return result
+}
+
+func containsIdentity(identities []identity.Interface, i identity.Interface) bool {
+	for _, other := range identities {
+		if other.Id() == i.Id() {
+			return true
+		}
+	}
+	return false
+}
+
+// getUsersIDs return the gitlab user IDs of the given identities
+func (ge *gitlabExporter) getUsersIDs(ctx context.Context, gc *gitlab.Client, identities []identity.Interface) ([]int, error) {
+	ids := make([]int, 0, len(identities))
+
+	for _, i := range identities {
+		// identities imported from gitlab carry their user ID
+		if id, ok := i.(*identity.Identity); ok {
+			if gitlabID, ok := id.ImmutableMetadata()[metaKeyGitlabId]; ok {
+				userID, err := strconv.Atoi(gitlabID)
+				if err != nil {
+					return nil, err
+				}
+				ids = append(ids, userID)
+				continue
+			}
+		}
+
+		login := i.Login()
+		if login == "" {
+			return nil, fmt.Errorf("no gitlab login for identity %s", i.DisplayName())
+		}
+
+		reqCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+		users, _, err := gc.Users.ListUsers(
+			&gitlab.ListUsersOptions{Username: &login},
+			gitlab.WithContext(reqCtx),
+		)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("no gitlab user with login %s", login)
+		}
+
+		ids = append(ids, users[0].ID)
+	}
+
+	return ids, nil
+}
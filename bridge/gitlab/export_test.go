@@ -199,13 +199,13 @@ func TestPushPull(t *testing.T) {
 		confKeyProjectID:     strconv.Itoa(projectID),
 		confKeyGitlabBaseUrl: defaultBaseURL,
 		confKeyDefaultLogin:  login,
-	})
+	}, false)
 	require.NoError(t, err)
 
 	start := time.Now()
 
 	// export all bugs
-	exportEvents, err := exporter.ExportAll(ctx, backend, time.Time{})
+	exportEvents, err := exporter.ExportAll(ctx, backend, backend.AllBugsIds(), time.Time{})
 	require.NoError(t, err)
 
 	for result := range exportEvents {
@@ -227,7 +227,7 @@ func TestPushPull(t *testing.T) {
 		confKeyProjectID:     strconv.Itoa(projectID),
 		confKeyGitlabBaseUrl: defaultBaseURL,
 		confKeyDefaultLogin:  login,
-	})
+	}, false)
 	require.NoError(t, err)
 
 	// import all exported bugs to the second backend
@@ -17,6 +17,7 @@ const (
 	metaKeyGitlabLogin   = "gitlab-login"
 	metaKeyGitlabProject = "gitlab-project-id"
 	metaKeyGitlabBaseUrl = "gitlab-base-url"
+	metaKeyGitlabAwardId = "gitlab-award-id"
 
 	confKeyProjectID     = "project-id"
 	confKeyGitlabBaseUrl = "base-url"
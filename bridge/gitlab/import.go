@@ -14,6 +14,7 @@ import (
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/milestone"
 	"github.com/MichaelMure/git-bug/util/text"
 )
 
@@ -29,10 +30,25 @@ type gitlabImporter struct {
 
 	// send only channel
 	out chan<- core.ImportResult
+
+	// select the issues to import
+	filter *core.ImportFilter
+
+	// only describe the changes, and the identities already described
+	dryRun           bool
+	dryRunIdentities map[int]bool
 }
 
-func (gi *gitlabImporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration) error {
+func (gi *gitlabImporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
 	gi.conf = conf
+	gi.dryRun = dryRun
+	gi.dryRunIdentities = make(map[int]bool)
+
+	filter, err := core.NewImportFilter(conf)
+	if err != nil {
+		return err
+	}
+	gi.filter = filter
 
 	creds, err := auth.List(repo,
 		auth.WithTarget(target),
@@ -60,6 +76,11 @@ func (gi *gitlabImporter) Init(_ context.Context, repo *cache.RepoCache, conf co
 // of the missing issues / comments / label events / title changes ...
 func (gi *gitlabImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ImportResult, error) {
 	gi.iterator = iterator.NewIterator(ctx, gi.client, 10, gi.conf[confKeyProjectID], since)
+	return gi.importIssues(ctx, repo), nil
+}
+
+// importIssues import the issues of the iterator
+func (gi *gitlabImporter) importIssues(ctx context.Context, repo *cache.RepoCache) <-chan core.ImportResult {
 	out := make(chan core.ImportResult)
 	gi.out = out
 
@@ -70,6 +91,16 @@ func (gi *gitlabImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 		for gi.iterator.NextIssue() {
 			issue := gi.iterator.IssueValue()
 
+			reason, err := gi.skipIssue(repo, issue)
+			if err != nil {
+				out <- core.NewImportError(err, "")
+				return
+			}
+			if reason != "" {
+				out <- core.NewImportNothing("", fmt.Sprintf("issue %s: %s", issue.WebURL, reason))
+				continue
+			}
+
 			// create issue
 			b, err := gi.ensureIssue(repo, issue)
 			if err != nil {
@@ -78,6 +109,12 @@ func (gi *gitlabImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 				return
 			}
 
+			if b == nil {
+				// a new bug in dry-run mode, its content would be imported
+				// with it
+				continue
+			}
+
 			// Loop over all notes
 			for gi.iterator.NextNote() {
 				note := gi.iterator.NoteValue()
@@ -86,6 +123,18 @@ func (gi *gitlabImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 					out <- core.NewImportError(err, entity.Id(strconv.Itoa(note.ID)))
 					return
 				}
+				if err := gi.ensureNoteReactions(ctx, repo, b, issue, note); err != nil {
+					err := fmt.Errorf("note reactions: %v", err)
+					out <- core.NewImportError(err, entity.Id(strconv.Itoa(note.ID)))
+					return
+				}
+			}
+
+			// reactions on the issue description
+			if err := gi.ensureIssueReactions(ctx, repo, b, issue); err != nil {
+				err := fmt.Errorf("issue reactions: %v", err)
+				out <- core.NewImportError(err, "")
+				return
 			}
 
 			// Loop over all label events
@@ -98,6 +147,10 @@ func (gi *gitlabImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 				}
 			}
 
+			if gi.dryRun {
+				continue
+			}
+
 			if !b.NeedCommit() {
 				out <- core.NewImportNothing(b.Id(), "no imported operation")
 			} else if err := b.Commit(); err != nil {
@@ -113,7 +166,35 @@ func (gi *gitlabImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 		}
 	}()
 
-	return out, nil
+	return out
+}
+
+// skipIssue return why an issue is filtered out of the import, if it is. The
+// issues already imported are always kept up to date.
+func (gi *gitlabImporter) skipIssue(repo *cache.RepoCache, issue *gitlab.Issue) (string, error) {
+	_, err := gi.resolveIssue(repo, issue)
+	if err == nil {
+		return "", nil
+	}
+	if err != bug.ErrBugNotExist {
+		return "", err
+	}
+
+	var author string
+	if issue.Author != nil {
+		author = issue.Author.Username
+	}
+	return gi.filter.Skip(issue.Labels, issue.State != "closed", author), nil
+}
+
+// resolveIssue return the bug imported from an issue
+func (gi *gitlabImporter) resolveIssue(repo *cache.RepoCache, issue *gitlab.Issue) (*cache.BugCache, error) {
+	return repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
+		return excerpt.CreateMetadata[core.MetaKeyOrigin] == target &&
+			excerpt.CreateMetadata[metaKeyGitlabId] == parseID(issue.IID) &&
+			excerpt.CreateMetadata[metaKeyGitlabBaseUrl] == gi.conf[confKeyGitlabBaseUrl] &&
+			excerpt.CreateMetadata[metaKeyGitlabProject] == gi.conf[confKeyProjectID]
+	})
 }
 
 func (gi *gitlabImporter) ensureIssue(repo *cache.RepoCache, issue *gitlab.Issue) (*cache.BugCache, error) {
@@ -124,12 +205,7 @@ func (gi *gitlabImporter) ensureIssue(repo *cache.RepoCache, issue *gitlab.Issue
 	}
 
 	// resolve bug
-	b, err := repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
-		return excerpt.CreateMetadata[core.MetaKeyOrigin] == target &&
-			excerpt.CreateMetadata[metaKeyGitlabId] == parseID(issue.IID) &&
-			excerpt.CreateMetadata[metaKeyGitlabBaseUrl] == gi.conf[confKeyProjectID] &&
-			excerpt.CreateMetadata[metaKeyGitlabProject] == gi.conf[confKeyGitlabBaseUrl]
-	})
+	b, err := gi.resolveIssue(repo, issue)
 	if err == nil {
 		return b, nil
 	}
@@ -137,6 +213,11 @@ func (gi *gitlabImporter) ensureIssue(repo *cache.RepoCache, issue *gitlab.Issue
 		return nil, err
 	}
 
+	if gi.dryRun {
+		gi.out <- core.NewImportDryRun(core.ImportEventBug, "", fmt.Sprintf("create the bug \"%s\"", issue.Title))
+		return nil, nil
+	}
+
 	// if bug was never imported
 	cleanText, err := text.Cleanup(issue.Description)
 	if err != nil {
@@ -190,9 +271,16 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 			return nil
 		}
 
-		op, err := b.CloseRaw(
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventStatusChange, b.Id(), "close the bug")
+			return nil
+		}
+
+		op, err := b.ImportStatusRaw(
 			author,
 			note.CreatedAt.Unix(),
+			bug.ClosedStatus,
+			"",
 			map[string]string{
 				metaKeyGitlabId: gitlabID,
 			},
@@ -208,9 +296,16 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 			return nil
 		}
 
-		op, err := b.OpenRaw(
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventStatusChange, b.Id(), "reopen the bug")
+			return nil
+		}
+
+		op, err := b.ImportStatusRaw(
 			author,
 			note.CreatedAt.Unix(),
+			bug.OpenStatus,
+			"",
 			map[string]string{
 				metaKeyGitlabId: gitlabID,
 			},
@@ -229,6 +324,11 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 		// we should check for "changed the description" notes and compare issue texts
 		// TODO: Check only one time and ignore next 'description change' within one issue
 		if errResolve == cache.ErrNoMatchingOp && issue.Description != firstComment.Message {
+			if gi.dryRun {
+				gi.out <- core.NewImportDryRun(core.ImportEventCommentEdition, b.Id(), "edit the description")
+				return nil
+			}
+
 			// comment edition
 			op, err := b.EditCommentRaw(
 				author,
@@ -254,6 +354,10 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 
 		// if we didn't import the comment
 		if errResolve == cache.ErrNoMatchingOp {
+			if gi.dryRun {
+				gi.out <- core.NewImportDryRun(core.ImportEventComment, b.Id(), fmt.Sprintf("add a comment by %s", note.Author.Username))
+				return nil
+			}
 
 			// add comment operation
 			op, err := b.AddCommentRaw(
@@ -282,6 +386,11 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 
 		// compare local bug comment with the new note body
 		if comment.Message != cleanText {
+			if gi.dryRun {
+				gi.out <- core.NewImportDryRun(core.ImportEventCommentEdition, b.Id(), "edit a comment")
+				return nil
+			}
+
 			// comment edition
 			op, err := b.EditCommentRaw(
 				author,
@@ -305,6 +414,11 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 			return nil
 		}
 
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventTitleEdition, b.Id(), fmt.Sprintf("change the title to \"%s\"", body))
+			return nil
+		}
+
 		op, err := b.SetTitleRaw(
 			author,
 			note.CreatedAt.Unix(),
@@ -319,11 +433,104 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 
 		gi.out <- core.NewImportTitleEdition(op.Id())
 
+	case NOTE_ASSIGNED, NOTE_UNASSIGNED:
+		if errResolve == nil {
+			return nil
+		}
+
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventAssigneeChange, b.Id(), "change the assignees")
+			return nil
+		}
+
+		addedNames, removedNames := getAssigneeChanges(body)
+
+		added, err := gi.ensurePersonsByUsername(repo, addedNames)
+		if err != nil {
+			return err
+		}
+		removed, err := gi.ensurePersonsByUsername(repo, removedNames)
+		if err != nil {
+			return err
+		}
+
+		op, err := b.ForceChangeAssigneesRaw(
+			author,
+			note.CreatedAt.Unix(),
+			added,
+			removed,
+			map[string]string{
+				metaKeyGitlabId: gitlabID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportAssigneeChange(op.Id())
+
+	case NOTE_CHANGED_MILESTONE:
+		if errResolve == nil {
+			return nil
+		}
+
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventMilestoneChange, b.Id(), fmt.Sprintf("set the milestone %s", body))
+			return nil
+		}
+
+		m, err := gi.ensureMilestone(repo, author, note.CreatedAt.Unix(), body)
+		if err != nil {
+			return err
+		}
+
+		if b.Snapshot().Milestone == m.Id() {
+			return nil
+		}
+
+		op, err := b.SetMilestoneRaw(
+			author,
+			note.CreatedAt.Unix(),
+			m,
+			map[string]string{
+				metaKeyGitlabId: gitlabID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportMilestoneChange(op.Id())
+
+	case NOTE_REMOVED_MILESTONE:
+		if errResolve == nil {
+			return nil
+		}
+
+		if b.Snapshot().Milestone == "" {
+			return nil
+		}
+
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventMilestoneChange, b.Id(), "unset the milestone")
+			return nil
+		}
+
+		op, err := b.SetMilestoneRaw(
+			author,
+			note.CreatedAt.Unix(),
+			nil,
+			map[string]string{
+				metaKeyGitlabId: gitlabID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportMilestoneChange(op.Id())
+
 	case NOTE_UNKNOWN,
-		NOTE_ASSIGNED,
-		NOTE_UNASSIGNED,
-		NOTE_CHANGED_MILESTONE,
-		NOTE_REMOVED_MILESTONE,
 		NOTE_CHANGED_DUEDATE,
 		NOTE_REMOVED_DUEDATE,
 		NOTE_LOCKED,
@@ -346,6 +553,12 @@ func (gi *gitlabImporter) ensureLabelEvent(repo *cache.RepoCache, b *cache.BugCa
 		return err
 	}
 
+	if gi.dryRun {
+		gi.out <- core.NewImportDryRun(core.ImportEventLabelChange, b.Id(),
+			fmt.Sprintf("%s the label %s", labelEvent.Action, labelEvent.Label.Name))
+		return nil
+	}
+
 	// ensure issue author
 	author, err := gi.ensurePerson(repo, labelEvent.User.ID)
 	if err != nil {
@@ -397,6 +610,14 @@ func (gi *gitlabImporter) ensurePerson(repo *cache.RepoCache, id int) (*cache.Id
 		return nil, err
 	}
 
+	if gi.dryRun {
+		if !gi.dryRunIdentities[id] {
+			gi.dryRunIdentities[id] = true
+			gi.out <- core.NewImportDryRun(core.ImportEventIdentity, "", fmt.Sprintf("create the identity %s", user.Username))
+		}
+		return nil, nil
+	}
+
 	i, err = repo.NewIdentityRaw(
 		user.Name,
 		user.PublicEmail,
@@ -416,6 +637,270 @@ func (gi *gitlabImporter) ensurePerson(repo *cache.RepoCache, id int) (*cache.Id
 	return i, nil
 }
 
+// ensureIssueReactions import the award emojis of the issue description
+func (gi *gitlabImporter) ensureIssueReactions(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue) error {
+	awards, err := gi.listAwardEmojis(func(opt *gitlab.ListAwardEmojiOptions) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+		return gi.client.AwardEmoji.ListIssueAwardEmoji(gi.conf[confKeyProjectID], issue.IID, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return err
+	}
+
+	createOp := b.Snapshot().Operations[0]
+
+	return gi.ensureReactions(repo, b, createOp.Id(), awards)
+}
+
+// ensureNoteReactions import the award emojis of a comment
+func (gi *gitlabImporter) ensureNoteReactions(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue, note *gitlab.Note) error {
+	if noteType, _ := GetNoteType(note); noteType != NOTE_COMMENT {
+		return nil
+	}
+
+	target, err := b.ResolveOperationWithMetadata(metaKeyGitlabId, parseID(note.ID))
+	if err == cache.ErrNoMatchingOp {
+		// empty comments are not imported
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	awards, err := gi.listAwardEmojis(func(opt *gitlab.ListAwardEmojiOptions) ([]*gitlab.AwardEmoji, *gitlab.Response, error) {
+		return gi.client.AwardEmoji.ListIssuesAwardEmojiOnNote(gi.conf[confKeyProjectID], issue.IID, note.ID, opt, gitlab.WithContext(ctx))
+	})
+	if err != nil {
+		return err
+	}
+
+	return gi.ensureReactions(repo, b, target, awards)
+}
+
+// listAwardEmojis collect all the pages of award emojis
+func (gi *gitlabImporter) listAwardEmojis(list func(opt *gitlab.ListAwardEmojiOptions) ([]*gitlab.AwardEmoji, *gitlab.Response, error)) ([]*gitlab.AwardEmoji, error) {
+	var result []*gitlab.AwardEmoji
+
+	opt := &gitlab.ListAwardEmojiOptions{
+		Page:    1,
+		PerPage: 100,
+	}
+
+	for {
+		awards, resp, err := list(opt)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, awards...)
+
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// ensureReactions import the given award emojis as reactions on the target comment
+func (gi *gitlabImporter) ensureReactions(repo *cache.RepoCache, b *cache.BugCache, target entity.Id, awards []*gitlab.AwardEmoji) error {
+	for _, award := range awards {
+		awardID := parseID(award.ID)
+
+		_, err := b.ResolveOperationWithMetadata(metaKeyGitlabAwardId, awardID)
+		if err == nil {
+			continue
+		}
+		if err != cache.ErrNoMatchingOp {
+			return err
+		}
+
+		if gi.dryRun {
+			gi.out <- core.NewImportDryRun(core.ImportEventReaction, b.Id(),
+				fmt.Sprintf("add the reaction %s", convertAwardEmoji(award.Name)))
+			continue
+		}
+
+		author, err := gi.ensurePerson(repo, award.User.ID)
+		if err != nil {
+			return err
+		}
+
+		unixTime := time.Now().Unix()
+		if award.CreatedAt != nil {
+			unixTime = award.CreatedAt.Unix()
+		}
+
+		op, err := b.AddReactionRaw(
+			author,
+			unixTime,
+			target,
+			convertAwardEmoji(award.Name),
+			map[string]string{
+				metaKeyGitlabAwardId: awardID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportReaction(op.Id())
+	}
+
+	return nil
+}
+
+// convertAwardEmoji convert the name of a Gitlab award emoji to the
+// equivalent reaction if there is one
+func convertAwardEmoji(name string) bug.Reaction {
+	switch name {
+	case "thumbsup":
+		return bug.ReactionThumbsUp
+	case "thumbsdown":
+		return bug.ReactionThumbsDown
+	case "laughing", "smile":
+		return bug.ReactionLaugh
+	case "tada":
+		return bug.ReactionHooray
+	default:
+		return bug.Reaction(name)
+	}
+}
+
+// ensurePersonsByUsername create the bug.Person matching the given Gitlab usernames
+func (gi *gitlabImporter) ensurePersonsByUsername(repo *cache.RepoCache, usernames []string) ([]*cache.IdentityCache, error) {
+	result := make([]*cache.IdentityCache, 0, len(usernames))
+
+	for _, username := range usernames {
+		// Look first in the cache
+		i, err := repo.ResolveIdentityImmutableMetadata(metaKeyGitlabLogin, username)
+		if err == nil {
+			result = append(result, i)
+			continue
+		}
+		if entity.IsErrMultipleMatch(err) {
+			return nil, err
+		}
+
+		username := username
+		users, _, err := gi.client.Users.ListUsers(&gitlab.ListUsersOptions{Username: &username})
+		if err != nil {
+			return nil, err
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("unknown gitlab user %s", username)
+		}
+
+		i, err = gi.ensurePerson(repo, users[0].ID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, i)
+	}
+
+	return result, nil
+}
+
+// ensureMilestone find or create the milestone referenced in a note, either by
+// title or iid
+func (gi *gitlabImporter) ensureMilestone(repo *cache.RepoCache, author *cache.IdentityCache, unixTime int64, ref string) (*cache.MilestoneCache, error) {
+	glMilestone, err := gi.findGitlabMilestone(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	title := ref
+	if glMilestone != nil {
+		title = glMilestone.Title
+
+		// Look first in the cache
+		m, err := repo.ResolveMilestoneImmutableMetadata(metaKeyGitlabId, parseID(glMilestone.ID))
+		if err == nil {
+			return m, nil
+		}
+		if entity.IsErrMultipleMatch(err) {
+			return nil, err
+		}
+	}
+
+	m, err := repo.ResolveMilestoneTitle(title)
+	if err == nil {
+		return m, nil
+	}
+	if err != milestone.ErrMilestoneNotExist {
+		return nil, err
+	}
+
+	metadata := map[string]string{
+		core.MetaKeyOrigin:   target,
+		metaKeyGitlabProject: gi.conf[confKeyProjectID],
+		metaKeyGitlabBaseUrl: gi.conf[confKeyGitlabBaseUrl],
+	}
+
+	var description string
+	var dueDate time.Time
+	if glMilestone != nil {
+		description = glMilestone.Description
+		if glMilestone.DueDate != nil {
+			dueDate = time.Time(*glMilestone.DueDate)
+		}
+		metadata[metaKeyGitlabId] = parseID(glMilestone.ID)
+		metadata[metaKeyGitlabUrl] = glMilestone.WebURL
+	}
+
+	m, err = repo.NewMilestoneRaw(author, unixTime, title, description, dueDate, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if glMilestone != nil && glMilestone.State == "closed" {
+		err = m.MutateRaw(author, unixTime, func(orig milestone.Mutator) milestone.Mutator {
+			orig.Status = bug.ClosedStatus
+			return orig
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := m.Commit(); err != nil {
+			return nil, err
+		}
+	}
+
+	gi.out <- core.NewImportMilestone(m.Id())
+	return m, nil
+}
+
+// findGitlabMilestone query the project milestone matching a reference, if any
+func (gi *gitlabImporter) findGitlabMilestone(ref string) (*gitlab.Milestone, error) {
+	milestones, _, err := gi.client.Milestones.ListMilestones(
+		gi.conf[confKeyProjectID],
+		&gitlab.ListMilestonesOptions{Title: &ref},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(milestones) > 0 {
+		return milestones[0], nil
+	}
+
+	iid, err := strconv.Atoi(ref)
+	if err != nil {
+		// group milestones are not listed in the project milestones
+		return nil, nil
+	}
+
+	milestones, _, err = gi.client.Milestones.ListMilestones(
+		gi.conf[confKeyProjectID],
+		&gitlab.ListMilestonesOptions{IIDs: []int{iid}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(milestones) > 0 {
+		return milestones[0], nil
+	}
+
+	return nil, nil
+}
+
 func parseID(id int) string {
 	return fmt.Sprintf("%d", id)
 }
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/xanzy/go-gitlab"
@@ -109,15 +110,15 @@ func GetNoteType(n *gitlab.Note) (NoteType, string) {
 	}
 
 	if strings.HasPrefix(n.Body, "assigned to @") {
-		return NOTE_ASSIGNED, ""
+		return NOTE_ASSIGNED, n.Body
 	}
 
 	if strings.HasPrefix(n.Body, "unassigned @") {
-		return NOTE_UNASSIGNED, ""
+		return NOTE_UNASSIGNED, n.Body
 	}
 
-	if strings.HasPrefix(n.Body, "changed milestone to %") {
-		return NOTE_CHANGED_MILESTONE, ""
+	if strings.HasPrefix(n.Body, "changed milestone to ") {
+		return NOTE_CHANGED_MILESTONE, getMilestoneReference(n.Body)
 	}
 
 	if strings.HasPrefix(n.Body, "removed milestone") {
@@ -145,3 +146,45 @@ func getNewTitle(diff string) string {
 	newTitle = strings.Replace(newTitle, "+}", "", -1)
 	return strings.TrimSuffix(newTitle, "**")
 }
+
+var usernameRegexp = regexp.MustCompile(`@([\w.-]+)`)
+
+// getAssigneeChanges parses the body of an assignment note and return the
+// usernames assigned and unassigned
+// examples: "assigned to @alice"
+//           "assigned to @alice and @bob"
+//           "assigned to @bob and unassigned @alice"
+//           "unassigned @alice"
+func getAssigneeChanges(body string) (added []string, removed []string) {
+	var assigned, unassigned string
+
+	if i := strings.Index(body, "unassigned "); i >= 0 {
+		assigned, unassigned = body[:i], body[i:]
+	} else {
+		assigned = body
+	}
+
+	for _, match := range usernameRegexp.FindAllStringSubmatch(assigned, -1) {
+		added = append(added, match[1])
+	}
+	for _, match := range usernameRegexp.FindAllStringSubmatch(unassigned, -1) {
+		removed = append(removed, match[1])
+	}
+
+	return added, removed
+}
+
+// getMilestoneReference parses the body of a milestone change note and return
+// the milestone reference, either a title or an iid
+// examples: "changed milestone to %v1.0"
+//           "changed milestone to %\"first release\""
+//           "changed milestone to %3"
+//           "changed milestone to group/project%v1.0"
+func getMilestoneReference(body string) string {
+	ref := strings.TrimPrefix(body, "changed milestone to ")
+	if i := strings.Index(ref, "%"); i >= 0 {
+		ref = ref[i+1:]
+	}
+	ref = strings.TrimSpace(ref)
+	return strings.Trim(ref, "\"")
+}
@@ -54,3 +54,77 @@ func TestGetNewTitle(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAssigneeChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		added   []string
+		removed []string
+	}{
+		{
+			name:  "single assignment",
+			body:  "assigned to @alice",
+			added: []string{"alice"},
+		},
+		{
+			name:  "multiple assignment",
+			body:  "assigned to @alice, @bob.smith, and @carol-1",
+			added: []string{"alice", "bob.smith", "carol-1"},
+		},
+		{
+			name:    "unassignment",
+			body:    "unassigned @alice and @bob",
+			removed: []string{"alice", "bob"},
+		},
+		{
+			name:    "reassignment",
+			body:    "assigned to @bob and unassigned @alice",
+			added:   []string{"bob"},
+			removed: []string{"alice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := getAssigneeChanges(tt.body)
+			assert.Equal(t, tt.added, added)
+			assert.Equal(t, tt.removed, removed)
+		})
+	}
+}
+
+func TestGetMilestoneReference(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		ref  string
+	}{
+		{
+			name: "simple title",
+			body: "changed milestone to %v1.0",
+			ref:  "v1.0",
+		},
+		{
+			name: "quoted title",
+			body: `changed milestone to %"first release"`,
+			ref:  "first release",
+		},
+		{
+			name: "iid",
+			body: "changed milestone to %3",
+			ref:  "3",
+		},
+		{
+			name: "cross project",
+			body: "changed milestone to group/project%v1.0",
+			ref:  "v1.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.ref, getMilestoneReference(tt.body))
+		})
+	}
+}
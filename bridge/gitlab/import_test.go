@@ -111,7 +111,7 @@ func TestImport(t *testing.T) {
 		confKeyProjectID:     projectID,
 		confKeyGitlabBaseUrl: defaultBaseURL,
 		confKeyDefaultLogin:  login,
-	})
+	}, false)
 	require.NoError(t, err)
 
 	start := time.Now()
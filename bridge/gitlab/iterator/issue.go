@@ -53,6 +53,7 @@ func (ii *issueIterator) getNext(ctx context.Context, conf config) (bool, error)
 				Page:    ii.page,
 				PerPage: conf.capacity,
 			},
+			IIDs:         conf.iids,
 			Scope:        gitlab.String("all"),
 			UpdatedAfter: &conf.since,
 			Sort:         gitlab.String("asc"),
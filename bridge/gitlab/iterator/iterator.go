@@ -40,6 +40,9 @@ type config struct {
 	// project id
 	project string
 
+	// if given, only the issues with these internal ids are queried
+	iids []int
+
 	// number of issues and notes to query at once
 	capacity int
 }
@@ -61,6 +64,14 @@ func NewIterator(ctx context.Context, client *gitlab.Client, capacity int, proje
 	}
 }
 
+// NewIssueIterator create a new iterator over a single issue, given with its
+// internal id
+func NewIssueIterator(ctx context.Context, client *gitlab.Client, capacity int, projectID string, iid int) *Iterator {
+	i := NewIterator(ctx, client, capacity, projectID, time.Time{})
+	i.conf.iids = []int{iid}
+	return i
+}
+
 // Error return last encountered error
 func (i *Iterator) Error() error {
 	return i.err
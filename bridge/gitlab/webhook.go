@@ -0,0 +1,92 @@
+package gitlab
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/gitlab/iterator"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+var _ core.WebhookImpl = &Gitlab{}
+var _ core.WebhookImporter = &gitlabImporter{}
+
+// the webhook events changing the issues
+var webhookEvents = map[string]bool{
+	"Issue Hook":              true,
+	"Confidential Issue Hook": true,
+	"Note Hook":               true,
+	"Confidential Note Hook":  true,
+}
+
+// VerifyWebhook check the secret token of a Gitlab webhook. Gitlab doesn't
+// sign the payload but send the secret as is.
+func (*Gitlab) VerifyWebhook(secret string, header http.Header, payload []byte) error {
+	token := header.Get("X-Gitlab-Token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return core.ErrWebhookSignature
+	}
+	return nil
+}
+
+// webhookEvent is the part of a Gitlab webhook payload identifying the issue
+// changed. The issue is the object of an issue event, and is given aside the
+// note of a note event.
+type webhookEvent struct {
+	ObjectKind string `json:"object_kind"`
+	Project    struct {
+		ID int `json:"id"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID          int    `json:"iid"`
+		NoteableType string `json:"noteable_type"`
+	} `json:"object_attributes"`
+	Issue struct {
+		IID int `json:"iid"`
+	} `json:"issue"`
+}
+
+// DecodeWebhook return the internal id of the issue changed by the event of
+// a webhook payload, or nil if it doesn't change an issue of the configured
+// project
+func (*Gitlab) DecodeWebhook(conf core.Configuration, header http.Header, payload []byte) (interface{}, error) {
+	if !webhookEvents[header.Get("X-Gitlab-Event")] {
+		return nil, nil
+	}
+
+	var event webhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	if strconv.Itoa(event.Project.ID) != conf[confKeyProjectID] {
+		return nil, nil
+	}
+
+	iid := 0
+	switch event.ObjectKind {
+	case "issue":
+		iid = event.ObjectAttributes.IID
+	case "note":
+		// notes are also made on merge requests and commits
+		if event.ObjectAttributes.NoteableType == "Issue" {
+			iid = event.Issue.IID
+		}
+	}
+
+	if iid == 0 {
+		return nil, nil
+	}
+	return iid, nil
+}
+
+// ImportWebhook import the issue changed by a webhook event
+func (gi *gitlabImporter) ImportWebhook(ctx context.Context, repo *cache.RepoCache, event interface{}) (<-chan core.ImportResult, error) {
+	iid := event.(int)
+	gi.iterator = iterator.NewIssueIterator(ctx, gi.client, 10, gi.conf[confKeyProjectID], iid)
+	return gi.importIssues(ctx, repo), nil
+}
@@ -0,0 +1,78 @@
+package gitlab
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+func TestVerifyWebhook(t *testing.T) {
+	g := &Gitlab{}
+
+	header := http.Header{}
+	header.Set("X-Gitlab-Event", "Issue Hook")
+	header.Set("X-Gitlab-Token", "secret")
+	require.NoError(t, g.VerifyWebhook("secret", header, nil))
+
+	header.Set("X-Gitlab-Token", "other")
+	require.Equal(t, core.ErrWebhookSignature, g.VerifyWebhook("secret", header, nil))
+
+	header.Del("X-Gitlab-Token")
+	require.Equal(t, core.ErrWebhookSignature, g.VerifyWebhook("secret", header, nil))
+}
+
+func TestDecodeWebhook(t *testing.T) {
+	conf := core.Configuration{
+		confKeyProjectID: "42",
+	}
+
+	tests := []struct {
+		name    string
+		event   string
+		payload string
+		iid     interface{}
+	}{
+		{
+			name:    "issue",
+			event:   "Issue Hook",
+			payload: `{"object_kind":"issue","project":{"id":42},"object_attributes":{"iid":7}}`,
+			iid:     7,
+		},
+		{
+			name:    "issue note",
+			event:   "Note Hook",
+			payload: `{"object_kind":"note","project":{"id":42},"object_attributes":{"noteable_type":"Issue"},"issue":{"iid":8}}`,
+			iid:     8,
+		},
+		{
+			name:    "merge request note",
+			event:   "Note Hook",
+			payload: `{"object_kind":"note","project":{"id":42},"object_attributes":{"noteable_type":"MergeRequest"}}`,
+		},
+		{
+			name:    "other project",
+			event:   "Issue Hook",
+			payload: `{"object_kind":"issue","project":{"id":43},"object_attributes":{"iid":7}}`,
+		},
+		{
+			name:    "push",
+			event:   "Push Hook",
+			payload: `{"object_kind":"push","project":{"id":42}}`,
+		},
+	}
+
+	g := &Gitlab{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("X-Gitlab-Event", tt.event)
+
+			iid, err := g.DecodeWebhook(conf, header, []byte(tt.payload))
+			require.NoError(t, err)
+			require.Equal(t, tt.iid, iid)
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/MichaelMure/git-bug/bridge/core"
 	"github.com/MichaelMure/git-bug/bug"
 )
 
@@ -134,6 +135,7 @@ type IssueFields struct {
 	Summary     string      `json:"summary"`
 	Comments    CommentPage `json:"comment"`
 	Labels      []string    `json:"labels"`
+	Status      Status      `json:"status"`
 }
 
 // ChangeLogItem "field-change" data within a changelog entry. A single
@@ -342,7 +344,7 @@ type Client struct {
 func NewClient(ctx context.Context, serverURL string) *Client {
 	cookiJar, _ := cookiejar.New(nil)
 	client := &http.Client{
-		Transport: &ClientTransport{underlyingTransport: http.DefaultTransport},
+		Transport: &ClientTransport{underlyingTransport: &core.RateLimitTransport{Transport: http.DefaultTransport}},
 		Jar:       cookiJar,
 	}
 
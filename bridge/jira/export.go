@@ -41,11 +41,15 @@ type jiraExporter struct {
 
 	// store JIRA project information
 	project *Project
+
+	// only describe the changes
+	dryRun bool
 }
 
 // Init .
-func (je *jiraExporter) Init(ctx context.Context, repo *cache.RepoCache, conf core.Configuration) error {
+func (je *jiraExporter) Init(ctx context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
 	je.conf = conf
+	je.dryRun = dryRun
 	je.identityClient = make(map[entity.Id]*Client)
 	je.cachedOperationIDs = make(map[entity.Id]string)
 	je.cachedLabels = make(map[string]string)
@@ -134,7 +138,7 @@ func (je *jiraExporter) getClientForIdentity(userId entity.Id) (*Client, error)
 }
 
 // ExportAll export all event made by the current user to Jira
-func (je *jiraExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ExportResult, error) {
+func (je *jiraExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, ids []entity.Id, since time.Time) (<-chan core.ExportResult, error) {
 	out := make(chan core.ExportResult)
 
 	go func() {
@@ -145,9 +149,7 @@ func (je *jiraExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, si
 			allIdentitiesIds = append(allIdentitiesIds, id)
 		}
 
-		allBugsIds := repo.AllBugsIds()
-
-		for _, id := range allBugsIds {
+		for _, id := range ids {
 			b, err := repo.ResolveBug(id)
 			if err != nil {
 				out <- core.NewExportError(errors.Wrap(err, "can't load bug"), id)
@@ -260,36 +262,40 @@ func (je *jiraExporter) exportBug(ctx context.Context, b *cache.BugCache, out ch
 			fields[bugIDField] = b.Id().String()
 		}
 
-		// create bug
-		result, err := client.CreateIssue(
-			je.project.ID, createOp.Title, createOp.Message, fields)
-		if err != nil {
-			err := errors.Wrap(err, "exporting jira issue")
-			out <- core.NewExportError(err, b.Id())
-			return err
-		}
+		if je.dryRun {
+			out <- core.NewExportDryRun(core.ExportEventBug, b.Id(), fmt.Sprintf("create the issue \"%s\"", createOp.Title))
+		} else {
+			// create bug
+			result, err := client.CreateIssue(
+				je.project.ID, createOp.Title, createOp.Message, fields)
+			if err != nil {
+				err := errors.Wrap(err, "exporting jira issue")
+				out <- core.NewExportError(err, b.Id())
+				return err
+			}
 
-		id := result.ID
-		out <- core.NewExportBug(b.Id())
-		// mark bug creation operation as exported
-		err = markOperationAsExported(
-			b, createOp.Id(), id, je.project.Key, time.Time{})
-		if err != nil {
-			err := errors.Wrap(err, "marking operation as exported")
-			out <- core.NewExportError(err, b.Id())
-			return err
-		}
+			id := result.ID
+			out <- core.NewExportBug(b.Id())
+			// mark bug creation operation as exported
+			err = markOperationAsExported(
+				b, createOp.Id(), id, je.project.Key, time.Time{})
+			if err != nil {
+				err := errors.Wrap(err, "marking operation as exported")
+				out <- core.NewExportError(err, b.Id())
+				return err
+			}
 
-		// commit operation to avoid creating multiple issues with multiple pushes
-		err = b.CommitAsNeeded()
-		if err != nil {
-			err := errors.Wrap(err, "bug commit")
-			out <- core.NewExportError(err, b.Id())
-			return err
-		}
+			// commit operation to avoid creating multiple issues with multiple pushes
+			err = b.CommitAsNeeded()
+			if err != nil {
+				err := errors.Wrap(err, "bug commit")
+				out <- core.NewExportError(err, b.Id())
+				return err
+			}
 
-		// cache bug jira ID
-		bugJiraID = id
+			// cache bug jira ID
+			bugJiraID = id
+		}
 	}
 
 	// cache operation jira id
@@ -322,6 +328,11 @@ func (je *jiraExporter) exportBug(ctx context.Context, b *cache.BugCache, out ch
 		var exportTime time.Time
 		switch opr := op.(type) {
 		case *bug.AddCommentOperation:
+			if je.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				continue
+			}
+
 			comment, err := client.AddComment(bugJiraID, opr.Message)
 			if err != nil {
 				err := errors.Wrap(err, "adding comment")
@@ -335,6 +346,11 @@ func (je *jiraExporter) exportBug(ctx context.Context, b *cache.BugCache, out ch
 			je.cachedOperationIDs[op.Id()] = id
 
 		case *bug.EditCommentOperation:
+			if je.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				continue
+			}
+
 			if opr.Target == createOp.Id() {
 				// An EditCommentOpreation with the Target set to the create operation
 				// encodes a modification to the long-description/summary.
@@ -372,6 +388,11 @@ func (je *jiraExporter) exportBug(ctx context.Context, b *cache.BugCache, out ch
 			}
 
 		case *bug.SetStatusOperation:
+			if je.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				continue
+			}
+
 			jiraStatus, hasStatus := je.statusMap[opr.Status.String()]
 			if hasStatus {
 				exportTime, err = UpdateIssueStatus(client, bugJiraID, jiraStatus)
@@ -391,6 +412,11 @@ func (je *jiraExporter) exportBug(ctx context.Context, b *cache.BugCache, out ch
 			}
 
 		case *bug.SetTitleOperation:
+			if je.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				continue
+			}
+
 			exportTime, err = client.UpdateIssueTitle(bugJiraID, opr.Title)
 			if err != nil {
 				err := errors.Wrap(err, "editing title")
@@ -401,6 +427,11 @@ func (je *jiraExporter) exportBug(ctx context.Context, b *cache.BugCache, out ch
 			id = bugJiraID
 
 		case *bug.LabelChangeOperation:
+			if je.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				continue
+			}
+
 			exportTime, err = client.UpdateLabels(
 				bugJiraID, opr.Added, opr.Removed)
 			if err != nil {
@@ -411,6 +442,31 @@ func (je *jiraExporter) exportBug(ctx context.Context, b *cache.BugCache, out ch
 			out <- core.NewExportLabelChange(op.Id())
 			id = bugJiraID
 
+		case *bug.SetAssigneesOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "assignees are not exported to Jira")
+			continue
+
+		case *bug.SetMilestoneOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "milestones are not exported to Jira")
+			continue
+
+		case *bug.SetLinksOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "links are not exported to Jira")
+			continue
+
+		case *bug.AddReactionOperation, *bug.RemoveReactionOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "reactions are not exported to Jira")
+			continue
+
+		case *bug.RedactCommentOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "comment redactions are not exported to Jira")
+			continue
+
 		default:
 			panic("unhandled operation type case")
 		}
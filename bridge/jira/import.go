@@ -29,11 +29,26 @@ type jiraImporter struct {
 
 	// send only channel
 	out chan<- core.ImportResult
+
+	// select the issues to import
+	filter *core.ImportFilter
+
+	// only describe the changes, and the identities already described
+	dryRun           bool
+	dryRunIdentities map[string]bool
 }
 
 // Init .
-func (ji *jiraImporter) Init(ctx context.Context, repo *cache.RepoCache, conf core.Configuration) error {
+func (ji *jiraImporter) Init(ctx context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
 	ji.conf = conf
+	ji.dryRun = dryRun
+	ji.dryRunIdentities = make(map[string]bool)
+
+	filter, err := core.NewImportFilter(conf)
+	if err != nil {
+		return err
+	}
+	ji.filter = filter
 
 	var cred auth.Credential
 
@@ -102,6 +117,17 @@ func (ji *jiraImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, si
 		for searchIter =
 			ji.client.IterSearch(jql, defaultPageSize); searchIter.HasNext(); {
 			issue := searchIter.Next()
+
+			reason, err := ji.skipIssue(repo, *issue)
+			if err != nil {
+				out <- core.NewImportError(err, "")
+				return
+			}
+			if reason != "" {
+				out <- core.NewImportNothing("", fmt.Sprintf("issue %s: %s", issue.Key, reason))
+				continue
+			}
+
 			b, err := ji.ensureIssue(repo, *issue)
 			if err != nil {
 				err := fmt.Errorf("issue creation: %v", err)
@@ -109,6 +135,12 @@ func (ji *jiraImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, si
 				return
 			}
 
+			if b == nil {
+				// a new bug in dry-run mode, its content would be imported
+				// with it
+				continue
+			}
+
 			var commentIter *CommentIterator
 			for commentIter =
 				ji.client.IterComments(issue.ID, defaultPageSize); commentIter.HasNext(); {
@@ -163,6 +195,10 @@ func (ji *jiraImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, si
 				out <- core.NewImportError(changelogIter.Err, "")
 			}
 
+			if ji.dryRun {
+				continue
+			}
+
 			if !b.NeedCommit() {
 				out <- core.NewImportNothing(b.Id(), "no imported operation")
 			} else if err := b.Commit(); err != nil {
@@ -191,6 +227,14 @@ func (ji *jiraImporter) ensurePerson(repo *cache.RepoCache, user User) (*cache.I
 		return nil, err
 	}
 
+	if ji.dryRun {
+		if !ji.dryRunIdentities[user.Key] {
+			ji.dryRunIdentities[user.Key] = true
+			ji.out <- core.NewImportDryRun(core.ImportEventIdentity, "", fmt.Sprintf("create the identity %s", user.Key))
+		}
+		return nil, nil
+	}
+
 	i, err = repo.NewIdentityRaw(
 		user.DisplayName,
 		user.EmailAddress,
@@ -209,14 +253,25 @@ func (ji *jiraImporter) ensurePerson(repo *cache.RepoCache, user User) (*cache.I
 	return i, nil
 }
 
-// Create a bug.Bug based from a JIRA issue
-func (ji *jiraImporter) ensureIssue(repo *cache.RepoCache, issue Issue) (*cache.BugCache, error) {
-	author, err := ji.ensurePerson(repo, issue.Fields.Creator)
-	if err != nil {
-		return nil, err
+// skipIssue return why an issue is filtered out of the import, if it is. The
+// issues already imported are always kept up to date.
+func (ji *jiraImporter) skipIssue(repo *cache.RepoCache, issue Issue) (string, error) {
+	_, err := ji.resolveIssue(repo, issue)
+	if err == nil {
+		return "", nil
+	}
+	if err != bug.ErrBugNotExist {
+		return "", err
 	}
 
-	b, err := repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
+	// the issues in the "done" category are the closed ones
+	open := issue.Fields.Status.StatusCategory.Key != "done"
+	return ji.filter.Skip(issue.Fields.Labels, open, issue.Fields.Creator.Key), nil
+}
+
+// resolveIssue return the bug imported from a JIRA issue
+func (ji *jiraImporter) resolveIssue(repo *cache.RepoCache, issue Issue) (*cache.BugCache, error) {
+	return repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
 		if _, ok := excerpt.CreateMetadata[metaKeyJiraBaseUrl]; ok &&
 			excerpt.CreateMetadata[metaKeyJiraBaseUrl] != ji.conf[confKeyBaseUrl] {
 			return false
@@ -226,10 +281,25 @@ func (ji *jiraImporter) ensureIssue(repo *cache.RepoCache, issue Issue) (*cache.
 			excerpt.CreateMetadata[metaKeyJiraId] == issue.ID &&
 			excerpt.CreateMetadata[metaKeyJiraProject] == ji.conf[confKeyProject]
 	})
+}
+
+// Create a bug.Bug based from a JIRA issue
+func (ji *jiraImporter) ensureIssue(repo *cache.RepoCache, issue Issue) (*cache.BugCache, error) {
+	author, err := ji.ensurePerson(repo, issue.Fields.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := ji.resolveIssue(repo, issue)
 	if err != nil && err != bug.ErrBugNotExist {
 		return nil, err
 	}
 
+	if err == bug.ErrBugNotExist && ji.dryRun {
+		ji.out <- core.NewImportDryRun(core.ImportEventBug, "", fmt.Sprintf("create the bug %s \"%s\"", issue.Key, issue.Fields.Summary))
+		return nil, nil
+	}
+
 	if err == bug.ErrBugNotExist {
 		cleanText, err := text.Cleanup(string(issue.Fields.Description))
 		if err != nil {
@@ -283,6 +353,11 @@ func (ji *jiraImporter) ensureComment(repo *cache.RepoCache, b *cache.BugCache,
 
 	// If the comment is a new comment then create it
 	if targetOpID == "" && err == cache.ErrNoMatchingOp {
+		if ji.dryRun {
+			ji.out <- core.NewImportDryRun(core.ImportEventComment, b.Id(), fmt.Sprintf("add a comment from %s", item.Author.Key))
+			return nil
+		}
+
 		var cleanText string
 		if item.Updated != item.Created {
 			// We don't know the original text... we only have the updated text.
@@ -338,6 +413,11 @@ func (ji *jiraImporter) ensureComment(repo *cache.RepoCache, b *cache.BugCache,
 		return err
 	}
 
+	if ji.dryRun {
+		ji.out <- core.NewImportDryRun(core.ImportEventCommentEdition, b.Id(), "edit a comment")
+		return nil
+	}
+
 	// comment edition
 	cleanText, err := text.Cleanup(string(item.Body))
 	if err != nil {
@@ -437,6 +517,9 @@ func (ji *jiraImporter) ensureChange(repo *cache.RepoCache, b *cache.BugCache, e
 
 			opr, isRightType := potentialOp.(*bug.LabelChangeOperation)
 			if isRightType && labelSetsMatch(addedLabels, opr.Added) && labelSetsMatch(removedLabels, opr.Removed) {
+				if ji.dryRun {
+					return nil
+				}
 				_, err := b.SetMetadata(opr.Id(), map[string]string{
 					metaKeyJiraDerivedId: entry.ID,
 				})
@@ -449,6 +532,9 @@ func (ji *jiraImporter) ensureChange(repo *cache.RepoCache, b *cache.BugCache, e
 		case "status":
 			opr, isRightType := potentialOp.(*bug.SetStatusOperation)
 			if isRightType && statusMap[opr.Status.String()] == item.To {
+				if ji.dryRun {
+					return nil
+				}
 				_, err := b.SetMetadata(opr.Id(), map[string]string{
 					metaKeyJiraDerivedId: entry.ID,
 				})
@@ -463,6 +549,9 @@ func (ji *jiraImporter) ensureChange(repo *cache.RepoCache, b *cache.BugCache, e
 			// text, but it's the title
 			opr, isRightType := potentialOp.(*bug.SetTitleOperation)
 			if isRightType && opr.Title == item.To {
+				if ji.dryRun {
+					return nil
+				}
 				_, err := b.SetMetadata(opr.Id(), map[string]string{
 					metaKeyJiraDerivedId: entry.ID,
 				})
@@ -479,6 +568,9 @@ func (ji *jiraImporter) ensureChange(repo *cache.RepoCache, b *cache.BugCache, e
 			if isRightType &&
 				opr.Target == b.Snapshot().Operations[0].Id() &&
 				opr.Message == item.ToString {
+				if ji.dryRun {
+					return nil
+				}
 				_, err := b.SetMetadata(opr.Id(), map[string]string{
 					metaKeyJiraDerivedId: entry.ID,
 				})
@@ -503,6 +595,11 @@ func (ji *jiraImporter) ensureChange(repo *cache.RepoCache, b *cache.BugCache, e
 			return err
 		}
 
+		if ji.dryRun {
+			ji.dryRunChangeItem(b, item, statusMap)
+			continue
+		}
+
 		switch item.Field {
 		case "labels":
 			fromLabels := removeEmpty(strings.Split(item.FromString, " "))
@@ -530,9 +627,11 @@ func (ji *jiraImporter) ensureChange(repo *cache.RepoCache, b *cache.BugCache, e
 			if hasMap {
 				switch statusStr {
 				case bug.OpenStatus.String():
-					op, err := b.OpenRaw(
+					op, err := b.ImportStatusRaw(
 						author,
 						entry.Created.Unix(),
+						bug.OpenStatus,
+						"",
 						map[string]string{
 							metaKeyJiraId:        entry.ID,
 							metaKeyJiraDerivedId: derivedID,
@@ -544,9 +643,11 @@ func (ji *jiraImporter) ensureChange(repo *cache.RepoCache, b *cache.BugCache, e
 					ji.out <- core.NewImportStatusChange(op.Id())
 
 				case bug.ClosedStatus.String():
-					op, err := b.CloseRaw(
+					op, err := b.ImportStatusRaw(
 						author,
 						entry.Created.Unix(),
+						bug.ClosedStatus,
+						"",
 						map[string]string{
 							metaKeyJiraId:        entry.ID,
 							metaKeyJiraDerivedId: derivedID,
@@ -617,6 +718,23 @@ func (ji *jiraImporter) ensureChange(repo *cache.RepoCache, b *cache.BugCache, e
 	return nil
 }
 
+// dryRunChangeItem describe the import of a changelog entry item
+func (ji *jiraImporter) dryRunChangeItem(b *cache.BugCache, item ChangeLogItem, statusMap map[string]string) {
+	switch item.Field {
+	case "labels":
+		ji.out <- core.NewImportDryRun(core.ImportEventLabelChange, b.Id(),
+			fmt.Sprintf("change the labels from \"%s\" to \"%s\"", item.FromString, item.ToString))
+	case "status":
+		if statusStr, hasMap := statusMap[item.To]; hasMap {
+			ji.out <- core.NewImportDryRun(core.ImportEventStatusChange, b.Id(), fmt.Sprintf("change the status to %s", statusStr))
+		}
+	case "summary":
+		ji.out <- core.NewImportDryRun(core.ImportEventTitleEdition, b.Id(), fmt.Sprintf("change the title to \"%s\"", item.ToString))
+	case "description":
+		ji.out <- core.NewImportDryRun(core.ImportEventCommentEdition, b.Id(), "edit the description")
+	}
+}
+
 func getStatusMap(conf core.Configuration) (map[string]string, error) {
 	mapStr, hasConf := conf[confKeyIDMap]
 	if !hasConf {
@@ -1,22 +1,31 @@
 package launchpad
 
 import (
+	"bufio"
+	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
 	"regexp"
+	"strings"
 
 	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/repository"
 )
 
 var ErrBadProjectURL = errors.New("bad Launchpad project URL")
 
 func (Launchpad) ValidParams() map[string]interface{} {
 	return map[string]interface{}{
-		"URL":     nil,
-		"Project": nil,
+		"URL":        nil,
+		"Project":    nil,
+		"CredPrefix": nil,
 	}
 }
 
@@ -24,6 +33,10 @@ func (l *Launchpad) Configure(repo *cache.RepoCache, params core.BridgeParams) (
 	var err error
 	var project string
 
+	// an interactive configuration offer to authenticate, to be able to
+	// export
+	interactive := params.Project == "" && params.URL == ""
+
 	switch {
 	case params.Project != "":
 		project = params.Project
@@ -48,6 +61,33 @@ func (l *Launchpad) Configure(repo *cache.RepoCache, params core.BridgeParams) (
 		return nil, fmt.Errorf("project doesn't exist")
 	}
 
+	var cred auth.Credential
+
+	switch {
+	case params.CredPrefix != "":
+		cred, err = auth.LoadWithPrefix(repo, params.CredPrefix)
+		if err != nil {
+			return nil, err
+		}
+	case interactive:
+		cred, err = promptOAuthOptions(repo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var login string
+	if cred != nil {
+		if _, ok := cred.(*auth.OAuth1); !ok {
+			return nil, fmt.Errorf("the Launchpad bridge only handle OAuth credentials")
+		}
+		value, ok := cred.GetMetadata(auth.MetaKeyLogin)
+		if !ok {
+			return nil, fmt.Errorf("credential doesn't have a login")
+		}
+		login = value
+	}
+
 	conf := make(core.Configuration)
 	conf[core.ConfigKeyTarget] = target
 	conf[confKeyProject] = project
@@ -57,7 +97,20 @@ func (l *Launchpad) Configure(repo *cache.RepoCache, params core.BridgeParams) (
 		return nil, err
 	}
 
-	return conf, nil
+	// without credential, the bridge can only import
+	if cred == nil {
+		return conf, nil
+	}
+
+	// don't forget to store the now known valid credential
+	if !auth.IdExist(repo, cred.ID()) {
+		err = auth.Store(repo, cred)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return conf, core.FinishConfig(repo, metaKeyLaunchpadLogin, login)
 }
 
 func (*Launchpad) ValidateConfig(conf core.Configuration) error {
@@ -73,6 +126,145 @@ func (*Launchpad) ValidateConfig(conf core.Configuration) error {
 	return nil
 }
 
+func promptOAuthOptions(repo repository.RepoKeyring) (auth.Credential, error) {
+	creds, err := auth.List(repo,
+		auth.WithTarget(target),
+		auth.WithKind(auth.KindOAuth1),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, index, err := input.PromptCredential(target, "authorization", creds, []string{
+		"no authorization, import only",
+		"authorize git-bug on Launchpad",
+	})
+	switch {
+	case err != nil:
+		return nil, err
+	case cred != nil:
+		return cred, nil
+	case index == 0:
+		return nil, nil
+	case index == 1:
+		return authorize()
+	default:
+		panic("missed case")
+	}
+}
+
+// authorize go through the OAuth flow of Launchpad, having the user grant
+// access to git-bug in a browser
+func authorize() (*auth.OAuth1, error) {
+	requestToken, requestSecret, err := requestOAuthToken()
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Println("To allow git-bug to act on your behalf, visit the following page and authorize it to change anything:")
+	fmt.Println()
+	fmt.Printf("%s/+authorize-token?oauth_token=%s\n", authRoot, url.QueryEscape(requestToken))
+	fmt.Println()
+	fmt.Print("Press enter once done.")
+
+	_, err = bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := accessOAuthToken(requestToken, requestSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	login, err := getLoginFromCredential(cred)
+	if err != nil {
+		return nil, err
+	}
+	cred.SetMetadata(auth.MetaKeyLogin, login)
+
+	return cred, nil
+}
+
+// requestOAuthToken obtain an unauthorized request token
+func requestOAuthToken() (string, string, error) {
+	values, err := postOAuth("+request-token", url.Values{
+		"oauth_consumer_key":     {consumerKey},
+		"oauth_signature_method": {"PLAINTEXT"},
+		"oauth_signature":        {"&"},
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return values.Get("oauth_token"), values.Get("oauth_token_secret"), nil
+}
+
+// accessOAuthToken exchange an authorized request token for an access token
+func accessOAuthToken(requestToken, requestSecret string) (*auth.OAuth1, error) {
+	values, err := postOAuth("+access-token", url.Values{
+		"oauth_consumer_key":     {consumerKey},
+		"oauth_token":            {requestToken},
+		"oauth_signature_method": {"PLAINTEXT"},
+		"oauth_signature":        {"&" + requestSecret},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewOAuth1(target, consumerKey, values.Get("oauth_token"), values.Get("oauth_token_secret")), nil
+}
+
+func postOAuth(endpoint string, form url.Values) (url.Values, error) {
+	client := &http.Client{
+		Timeout: defaultTimeout,
+	}
+
+	resp, err := client.PostForm(fmt.Sprintf("%s/%s", authRoot, endpoint), form)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("launchpad authorization failed: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	if values.Get("oauth_token") == "" || values.Get("oauth_token_secret") == "" {
+		return nil, fmt.Errorf("no token found in response: %s", string(body))
+	}
+
+	return values, nil
+}
+
+func getLoginFromCredential(cred *auth.OAuth1) (string, error) {
+	lpAPI := new(launchpadAPI)
+
+	err := lpAPI.Init(cred)
+	if err != nil {
+		return "", err
+	}
+
+	me, err := lpAPI.Me(context.Background())
+	if err != nil {
+		return "", err
+	}
+	if me.Login == "" {
+		return "", fmt.Errorf("login not found")
+	}
+
+	return me.Login, nil
+}
+
 func validateProject(project string) (bool, error) {
 	url := fmt.Sprintf("%s/%s", apiRoot, project)
 
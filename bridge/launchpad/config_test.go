@@ -4,6 +4,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
 )
 
 func TestSplitURL(t *testing.T) {
@@ -91,3 +97,79 @@ func TestValidateProject(t *testing.T) {
 		})
 	}
 }
+
+func TestOAuthFlow(t *testing.T) {
+	server, closeServer := newFakeLaunchpad("git-bug")
+	defer closeServer()
+	server.addPerson(LPPerson{Name: "Michael Muré", Login: "michael"}, "", "")
+
+	requestToken, requestSecret, err := requestOAuthToken()
+	require.NoError(t, err)
+
+	// not authorized yet
+	_, err = accessOAuthToken(requestToken, requestSecret)
+	require.Error(t, err)
+
+	server.authorize("michael")
+
+	cred, err := accessOAuthToken(requestToken, requestSecret)
+	require.NoError(t, err)
+	require.Equal(t, consumerKey, cred.ConsumerKey)
+
+	login, err := getLoginFromCredential(cred)
+	require.NoError(t, err)
+	require.Equal(t, "michael", login)
+
+	// a wrong secret is refused
+	cred.TokenSecret = "wrong"
+	_, err = getLoginFromCredential(cred)
+	require.Error(t, err)
+}
+
+func TestConfigureWithCredential(t *testing.T) {
+	server, closeServer := newFakeLaunchpad("git-bug")
+	defer closeServer()
+	server.addPerson(LPPerson{Name: "Michael Muré", Login: "michael"}, "token", "secret")
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	cred := auth.NewOAuth1(target, consumerKey, "token", "secret")
+	cred.SetMetadata(auth.MetaKeyLogin, "michael")
+	err = auth.Store(repo, cred)
+	require.NoError(t, err)
+
+	l := &Launchpad{}
+
+	conf, err := l.Configure(backend, core.BridgeParams{
+		Project:    "git-bug",
+		CredPrefix: cred.ID().Human(),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, core.Configuration{
+		core.ConfigKeyTarget: target,
+		confKeyProject:       "git-bug",
+	}, conf)
+
+	// the user identity is tagged with the login
+	user, err := backend.GetUserIdentity()
+	require.NoError(t, err)
+	require.Equal(t, "michael", user.ImmutableMetadata()[metaKeyLaunchpadLogin])
+
+	// only OAuth credentials are accepted
+	token := auth.NewToken(target, "token")
+	token.SetMetadata(auth.MetaKeyLogin, "michael")
+	err = auth.Store(repo, token)
+	require.NoError(t, err)
+
+	_, err = l.Configure(backend, core.BridgeParams{
+		Project:    "git-bug",
+		CredPrefix: token.ID().Human(),
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,413 @@
+package launchpad
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+var (
+	ErrMissingIdentityToken = errors.New("missing identity token")
+)
+
+// statuses a bug task can be set to
+var launchpadStatuses = []string{
+	"New", "Incomplete", "Opinion", "Invalid",
+	"Won't Fix", "Expired", "Confirmed", "Triaged",
+	"In Progress", "Fix Committed", "Fix Released",
+}
+
+// launchpadExporter implement the Exporter interface
+type launchpadExporter struct {
+	conf core.Configuration
+
+	// cache identities clients
+	identityClient map[entity.Id]*launchpadAPI
+
+	// only describe the changes
+	dryRun bool
+}
+
+// Init .
+func (le *launchpadExporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
+	le.conf = conf
+	le.dryRun = dryRun
+	le.identityClient = make(map[entity.Id]*launchpadAPI)
+
+	// preload all clients
+	err := le.cacheAllClient(repo)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (le *launchpadExporter) cacheAllClient(repo *cache.RepoCache) error {
+	creds, err := auth.List(repo,
+		auth.WithTarget(target),
+		auth.WithKind(auth.KindOAuth1),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, cred := range creds {
+		login, ok := cred.GetMetadata(auth.MetaKeyLogin)
+		if !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "credential %s is not tagged with a Launchpad login\n", cred.ID().Human())
+			continue
+		}
+
+		user, err := repo.ResolveIdentityImmutableMetadata(metaKeyLaunchpadLogin, login)
+		if err == identity.ErrIdentityNotExist {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, ok := le.identityClient[user.Id()]; !ok {
+			lpAPI := new(launchpadAPI)
+			err := lpAPI.Init(cred.(*auth.OAuth1))
+			if err != nil {
+				return err
+			}
+			le.identityClient[user.Id()] = lpAPI
+		}
+	}
+
+	return nil
+}
+
+// getIdentityClient return a Launchpad API client authenticated with the credential of the given identity.
+func (le *launchpadExporter) getIdentityClient(userId entity.Id) (*launchpadAPI, error) {
+	client, ok := le.identityClient[userId]
+	if ok {
+		return client, nil
+	}
+
+	return nil, ErrMissingIdentityToken
+}
+
+// ExportAll export all event made by the current user to Launchpad
+func (le *launchpadExporter) ExportAll(ctx context.Context, repo *cache.RepoCache, ids []entity.Id, since time.Time) (<-chan core.ExportResult, error) {
+	out := make(chan core.ExportResult)
+
+	go func() {
+		defer close(out)
+
+		allIdentitiesIds := make([]entity.Id, 0, len(le.identityClient))
+		for id := range le.identityClient {
+			allIdentitiesIds = append(allIdentitiesIds, id)
+		}
+
+		for _, id := range ids {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				b, err := repo.ResolveBug(id)
+				if err != nil {
+					out <- core.NewExportError(err, id)
+					return
+				}
+
+				snapshot := b.Snapshot()
+
+				// ignore issues created before since date
+				// TODO: compare the Lamport time instead of using the unix time
+				if snapshot.CreateTime.Before(since) {
+					out <- core.NewExportNothing(b.Id(), "bug created before the since date")
+					continue
+				}
+
+				if snapshot.HasAnyActor(allIdentitiesIds...) {
+					// try to export the bug and it associated events
+					le.exportBug(ctx, b, out)
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// exportBug publish bugs and related events
+func (le *launchpadExporter) exportBug(ctx context.Context, b *cache.BugCache, out chan<- core.ExportResult) {
+	snapshot := b.Snapshot()
+
+	var bugUpdated bool
+	var bugID int
+
+	project := le.conf[confKeyProject]
+
+	// skip bug if origin is not allowed
+	origin, ok := snapshot.GetCreateMetadata(core.MetaKeyOrigin)
+	if ok && origin != target {
+		out <- core.NewExportNothing(b.Id(), fmt.Sprintf("issue tagged with origin: %s", origin))
+		return
+	}
+
+	// first operation is always createOp
+	createOp := snapshot.Operations[0].(*bug.CreateOperation)
+	author := snapshot.Author
+
+	// get launchpad bug ID
+	launchpadID, ok := snapshot.GetCreateMetadata(metaKeyLaunchpadID)
+	if ok {
+		var err error
+		bugID, err = strconv.Atoi(launchpadID)
+		if err != nil {
+			out <- core.NewExportError(fmt.Errorf("unexpected launchpad id format: %s", launchpadID), b.Id())
+			return
+		}
+
+	} else {
+		// check that we have a token for operation author
+		client, err := le.getIdentityClient(author.Id())
+		if err != nil {
+			// if bug is still not exported and we do not have the author stop the execution
+			out <- core.NewExportNothing(b.Id(), fmt.Sprintf("missing author token"))
+			return
+		}
+
+		if le.dryRun {
+			out <- core.NewExportDryRun(core.ExportEventBug, b.Id(), fmt.Sprintf("create the bug \"%s\"", createOp.Title))
+			bugUpdated = true
+		} else {
+			// create bug
+			bugID, err = client.CreateBug(ctx, project, createOp.Title, createOp.Message)
+			if err != nil {
+				err := errors.Wrap(err, "exporting launchpad bug")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportBug(b.Id())
+
+			_, err = b.SetMetadata(
+				createOp.Id(),
+				map[string]string{
+					metaKeyLaunchpadID:           strconv.Itoa(bugID),
+					metaKeyLaunchpadUrl:          bugURL(bugID),
+					metaKeyLaunchpadMessageCount: "1",
+				},
+			)
+			if err != nil {
+				err := errors.Wrap(err, "marking operation as exported")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			// commit operation to avoid creating multiple bugs with multiple pushes
+			if err := b.CommitAsNeeded(); err != nil {
+				err := errors.Wrap(err, "bug commit")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+		}
+	}
+
+	for _, op := range snapshot.Operations[1:] {
+		// ignore SetMetadata operations
+		if _, ok := op.(*bug.SetMetadataOperation); ok {
+			continue
+		}
+
+		// ignore operations already existing in launchpad (due to import or export)
+		if _, ok := op.GetMetadata(metaKeyLaunchpadUrl); ok {
+			continue
+		}
+		if _, ok := op.GetMetadata(metaKeyLaunchpadID); ok {
+			continue
+		}
+
+		opAuthor := op.GetAuthor()
+		client, err := le.getIdentityClient(opAuthor.Id())
+		if err != nil {
+			continue
+		}
+
+		metadata := map[string]string{
+			metaKeyLaunchpadUrl: bugURL(bugID),
+		}
+
+		switch op := op.(type) {
+		case *bug.AddCommentOperation:
+			if le.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			messageID, err := client.AddComment(ctx, bugID, op.Message)
+			if err != nil {
+				err := errors.Wrap(err, "adding comment")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportComment(op.Id())
+
+			// the importer recognize the messages by their link. The number
+			// of messages is not recorded, as others might have been posted
+			// since the last import and still need to be imported.
+			metadata[metaKeyLaunchpadID] = messageID
+
+		case *bug.EditCommentOperation:
+			// Launchpad messages can't be edited, only the bug description
+			if op.Target != createOp.Id() {
+				out <- core.NewExportNothing(op.Id(), "comment editions are not supported by Launchpad")
+				continue
+			}
+
+			if le.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			err := client.SetDescription(ctx, bugID, op.Message)
+			if err != nil {
+				err := errors.Wrap(err, "editing description")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportCommentEdition(op.Id())
+
+		case *bug.SetStatusOperation:
+			if le.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			err := client.SetStatus(ctx, project, bugID, launchpadStatus(op))
+			if err != nil {
+				err := errors.Wrap(err, "editing status")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportStatusChange(op.Id())
+
+		case *bug.SetTitleOperation:
+			if le.dryRun {
+				out <- core.NewExportDryRunOperation(op)
+				bugUpdated = true
+				continue
+			}
+
+			err := client.SetTitle(ctx, bugID, op.Title)
+			if err != nil {
+				err := errors.Wrap(err, "editing title")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportTitleEdition(op.Id())
+
+		case *bug.LabelChangeOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "labels are not exported to Launchpad")
+			continue
+
+		case *bug.SetAssigneesOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "assignees are not exported to Launchpad")
+			continue
+
+		case *bug.SetMilestoneOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "milestones are not exported to Launchpad")
+			continue
+
+		case *bug.SetLinksOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "links are not exported to Launchpad")
+			continue
+
+		case *bug.AddReactionOperation, *bug.RemoveReactionOperation:
+			// not supported by this bridge yet
+			out <- core.NewExportNothing(op.Id(), "reactions are not exported to Launchpad")
+			continue
+
+		case *bug.RedactCommentOperation:
+			// the Launchpad API doesn't allow to remove or edit a message,
+			// make sure the user know the content is still public
+			err := fmt.Errorf("comment redactions can't be exported to Launchpad, the comment is still visible on %s", bugURL(bugID))
+			out <- core.NewExportWarning(err, op.Id())
+			continue
+
+		default:
+			panic("unhandled operation type case")
+		}
+
+		// mark operation as exported
+		if _, err := b.SetMetadata(op.Id(), metadata); err != nil {
+			err := errors.Wrap(err, "marking operation as exported")
+			out <- core.NewExportError(err, b.Id())
+			return
+		}
+
+		// commit at each operation export to avoid exporting same events multiple times
+		if err := b.CommitAsNeeded(); err != nil {
+			err := errors.Wrap(err, "bug commit")
+			out <- core.NewExportError(err, b.Id())
+			return
+		}
+
+		bugUpdated = true
+	}
+
+	if !bugUpdated {
+		out <- core.NewExportNothing(b.Id(), "nothing has been exported")
+	}
+}
+
+// launchpadStatus return the Launchpad status matching a status change. A
+// workflow status with the same name as a Launchpad status is used as is,
+// otherwise the bug is closed according to its resolution.
+func launchpadStatus(op *bug.SetStatusOperation) string {
+	if op.WorkflowStatus != "" {
+		for _, status := range launchpadStatuses {
+			name := strings.ToLower(strings.Replace(status, " ", "-", -1))
+			name = strings.Replace(name, "'", "", -1)
+			if name == op.WorkflowStatus {
+				return status
+			}
+		}
+	}
+
+	if op.Status == bug.OpenStatus {
+		return "New"
+	}
+
+	switch op.Resolution {
+	case bug.ResolutionWontFix:
+		return "Won't Fix"
+	case bug.ResolutionInvalid, bug.ResolutionDuplicate:
+		return "Invalid"
+	default:
+		return "Fix Released"
+	}
+}
+
+// bugURL return the web page of a bug
+func bugURL(bugID int) string {
+	return fmt.Sprintf("%s/bugs/%d", webRoot, bugID)
+}
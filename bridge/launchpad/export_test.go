@@ -0,0 +1,219 @@
+package launchpad
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLaunchpadStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		op     *bug.SetStatusOperation
+		status string
+	}{
+		{
+			name:   "open",
+			op:     &bug.SetStatusOperation{Status: bug.OpenStatus},
+			status: "New",
+		},
+		{
+			name:   "closed",
+			op:     &bug.SetStatusOperation{Status: bug.ClosedStatus},
+			status: "Fix Released",
+		},
+		{
+			name:   "won't fix",
+			op:     &bug.SetStatusOperation{Status: bug.ClosedStatus, Resolution: bug.ResolutionWontFix},
+			status: "Won't Fix",
+		},
+		{
+			name:   "duplicate",
+			op:     &bug.SetStatusOperation{Status: bug.ClosedStatus, Resolution: bug.ResolutionDuplicate},
+			status: "Invalid",
+		},
+		{
+			name:   "matching workflow status",
+			op:     &bug.SetStatusOperation{Status: bug.OpenStatus, WorkflowStatus: "in-progress"},
+			status: "In Progress",
+		},
+		{
+			name:   "other workflow status",
+			op:     &bug.SetStatusOperation{Status: bug.OpenStatus, WorkflowStatus: "review"},
+			status: "New",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.status, launchpadStatus(tt.op))
+		})
+	}
+}
+
+func TestPushPull(t *testing.T) {
+	server, closeServer := newFakeLaunchpad("git-bug")
+	defer closeServer()
+
+	login := "test-identity"
+	server.addPerson(LPPerson{Name: "test identity", Login: login}, "test-token", "test-secret")
+
+	// create repo backend
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	// set author identity
+	author, err := backend.NewIdentity("test identity", "test@test.org")
+	require.NoError(t, err)
+	author.SetMetadata(metaKeyLaunchpadLogin, login)
+	err = author.Commit()
+	require.NoError(t, err)
+
+	err = backend.SetUserIdentity(author)
+	require.NoError(t, err)
+
+	cred := auth.NewOAuth1(target, consumerKey, "test-token", "test-secret")
+	cred.SetMetadata(auth.MetaKeyLogin, login)
+	err = auth.Store(repo, cred)
+	require.NoError(t, err)
+
+	// a bug with everything
+	b, createOp, err := backend.NewBug("bug title", "bug description")
+	require.NoError(t, err)
+	_, err = b.EditComment(createOp.Id(), "bug description edited")
+	require.NoError(t, err)
+	commentOp, err := b.AddComment("first comment")
+	require.NoError(t, err)
+	_, err = b.EditComment(commentOp.Id(), "first comment edited")
+	require.NoError(t, err)
+	_, err = b.SetTitle("bug title edited")
+	require.NoError(t, err)
+	_, _, err = b.ChangeLabels([]string{"bug"}, nil)
+	require.NoError(t, err)
+	_, err = b.CloseWithResolution(bug.ResolutionWontFix)
+	require.NoError(t, err)
+	_, err = b.AddComment("second comment")
+	require.NoError(t, err)
+
+	conf := core.Configuration{
+		core.ConfigKeyTarget: target,
+		confKeyProject:       "git-bug",
+	}
+
+	ctx := context.Background()
+
+	exporter := &launchpadExporter{}
+	err = exporter.Init(ctx, backend, conf, false)
+	require.NoError(t, err)
+
+	exportEvents, err := exporter.ExportAll(ctx, backend, backend.AllBugsIds(), time.Time{})
+	require.NoError(t, err)
+
+	var events []core.ExportEvent
+	for result := range exportEvents {
+		require.NoError(t, result.Err)
+		events = append(events, result.Event)
+	}
+	require.Contains(t, events, core.ExportEventBug)
+
+	require.Len(t, server.bugs, 1)
+	exported := server.bugs[0]
+	require.Equal(t, "bug title edited", exported.title)
+	require.Equal(t, "bug description edited", exported.description)
+	require.Equal(t, "Won't Fix", exported.status)
+	require.Len(t, exported.messages, 3)
+	// Launchpad messages can't be edited
+	require.Equal(t, "first comment", exported.messages[1].content)
+	require.Equal(t, "second comment", exported.messages[2].content)
+
+	launchpadID, ok := b.Snapshot().GetCreateMetadata(metaKeyLaunchpadID)
+	require.True(t, ok)
+	require.Equal(t, "1", launchpadID)
+
+	// import in a second repository
+	repoTwo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repoTwo)
+
+	backendTwo, err := cache.NewRepoCache(repoTwo)
+	require.NoError(t, err)
+	defer backendTwo.Close()
+
+	importer := &launchpadImporter{}
+	err = importer.Init(ctx, backendTwo, conf, false)
+	require.NoError(t, err)
+
+	importEvents, err := importer.ImportAll(ctx, backendTwo, time.Time{})
+	require.NoError(t, err)
+	for result := range importEvents {
+		require.NoError(t, result.Err)
+	}
+
+	imported, err := backendTwo.ResolveBugCreateMetadata(metaKeyLaunchpadID, launchpadID)
+	require.NoError(t, err)
+	snapshot := imported.Snapshot()
+	require.Equal(t, "bug title edited", snapshot.Title)
+	require.Len(t, snapshot.Comments, 3)
+	require.Equal(t, "bug description edited", snapshot.Comments[0].Message)
+	require.Equal(t, "first comment", snapshot.Comments[1].Message)
+	require.Equal(t, login, snapshot.Comments[1].Author.Login())
+
+	// importing back in the original repository doesn't duplicate anything
+	numOps := len(b.Snapshot().Operations)
+
+	importEvents, err = importer.ImportAll(ctx, backend, time.Time{})
+	require.NoError(t, err)
+	for result := range importEvents {
+		require.NoError(t, result.Err)
+		require.NotEqual(t, core.ImportEventBug, result.Event)
+		require.NotEqual(t, core.ImportEventComment, result.Event)
+	}
+
+	require.Len(t, backend.AllBugsIds(), 1)
+	require.Len(t, b.Snapshot().Operations, numOps)
+
+	// and nothing is left to export
+	exportEvents, err = exporter.ExportAll(ctx, backend, backend.AllBugsIds(), time.Time{})
+	require.NoError(t, err)
+
+	for result := range exportEvents {
+		require.NoError(t, result.Err)
+		require.Equal(t, core.ExportEventNothing, result.Event)
+	}
+	require.Len(t, exported.messages, 3)
+
+	// a message posted by someone else before an export is still imported
+	server.addPerson(LPPerson{Name: "someone else", Login: "someone"}, "", "")
+	server.addMessage(exported, "someone", "remote comment")
+	_, err = b.AddComment("third comment")
+	require.NoError(t, err)
+
+	exportEvents, err = exporter.ExportAll(ctx, backend, backend.AllBugsIds(), time.Time{})
+	require.NoError(t, err)
+	for result := range exportEvents {
+		require.NoError(t, result.Err)
+	}
+	require.Len(t, exported.messages, 5)
+
+	importEvents, err = importer.ImportAll(ctx, backend, time.Time{})
+	require.NoError(t, err)
+	for result := range importEvents {
+		require.NoError(t, result.Err)
+	}
+
+	comments := b.Snapshot().Comments
+	require.Len(t, comments, 5)
+	require.Equal(t, "remote comment", comments[4].Message)
+}
@@ -3,6 +3,7 @@ package launchpad
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/MichaelMure/git-bug/bridge/core"
@@ -13,10 +14,24 @@ import (
 
 type launchpadImporter struct {
 	conf core.Configuration
+
+	// select the bugs to import
+	filter *core.ImportFilter
+
+	// only describe the changes
+	dryRun bool
 }
 
-func (li *launchpadImporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration) error {
+func (li *launchpadImporter) Init(_ context.Context, repo *cache.RepoCache, conf core.Configuration, dryRun bool) error {
 	li.conf = conf
+	li.dryRun = dryRun
+
+	filter, err := core.NewImportFilter(conf)
+	if err != nil {
+		return err
+	}
+	li.filter = filter
+
 	return nil
 }
 
@@ -45,115 +60,209 @@ func (li *launchpadImporter) ImportAll(ctx context.Context, repo *cache.RepoCach
 	out := make(chan core.ImportResult)
 	lpAPI := new(launchpadAPI)
 
-	err := lpAPI.Init()
+	err := lpAPI.Init(nil)
 	if err != nil {
 		return nil, err
 	}
 
-	lpBugs, err := lpAPI.SearchTasks(ctx, li.conf["project"])
+	// only the bugs modified since the last import are returned
+	lpBugs, err := lpAPI.SearchTasks(ctx, li.conf[confKeyProject], since)
 	if err != nil {
 		return nil, err
 	}
 
 	go func() {
+		defer close(out)
+
 		for _, lpBug := range lpBugs {
 			select {
 			case <-ctx.Done():
 				return
 			default:
-				lpBugID := fmt.Sprintf("%d", lpBug.ID)
-				b, err := repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
-					return excerpt.CreateMetadata[core.MetaKeyOrigin] == target &&
-						excerpt.CreateMetadata[metaKeyLaunchpadID] == lpBugID
-				})
-				if err != nil && err != bug.ErrBugNotExist {
-					out <- core.NewImportError(err, entity.Id(lpBugID))
+				reason, err := li.skipBug(repo, lpBug)
+				if err != nil {
+					out <- core.NewImportError(err, entity.Id(strconv.Itoa(lpBug.ID)))
 					return
 				}
+				if reason != "" {
+					out <- core.NewImportNothing("", fmt.Sprintf("bug %s: %s", bugURL(lpBug.ID), reason))
+					continue
+				}
 
-				owner, err := li.ensurePerson(repo, lpBug.Owner)
-				if err != nil {
-					out <- core.NewImportError(err, entity.Id(lpBugID))
+				if err := li.importBug(ctx, repo, lpAPI, lpBug, out); err != nil {
+					out <- core.NewImportError(err, entity.Id(strconv.Itoa(lpBug.ID)))
 					return
 				}
+			}
+		}
+	}()
 
-				if err == bug.ErrBugNotExist {
-					createdAt, _ := time.Parse(time.RFC3339, lpBug.CreatedAt)
-					b, _, err = repo.NewBugRaw(
-						owner,
-						createdAt.Unix(),
-						lpBug.Title,
-						lpBug.Description,
-						nil,
-						map[string]string{
-							core.MetaKeyOrigin: target,
-							metaKeyLaunchpadID: lpBugID,
-						},
-					)
-					if err != nil {
-						out <- core.NewImportError(err, entity.Id(lpBugID))
-						return
-					}
-
-					out <- core.NewImportBug(b.Id())
+	return out, nil
+}
 
-				}
+// skipBug return why a bug is filtered out of the import, if it is. The bugs
+// already imported are always kept up to date.
+func (li *launchpadImporter) skipBug(repo *cache.RepoCache, lpBug LPBug) (string, error) {
+	_, err := li.resolveBug(repo, lpBug)
+	if err == nil {
+		return "", nil
+	}
+	if err != bug.ErrBugNotExist {
+		return "", err
+	}
 
-				/* Handle messages */
-				if len(lpBug.Messages) == 0 {
-					return
-				}
+	return li.filter.Skip(lpBug.Tags, !isClosedStatus(lpBug.Status), lpBug.Owner.Login), nil
+}
 
-				// The Launchpad API returns the bug description as the first
-				// comment, so skip it.
-				for _, lpMessage := range lpBug.Messages[1:] {
-					_, err := b.ResolveOperationWithMetadata(metaKeyLaunchpadID, lpMessage.ID)
-					if err != nil && err != cache.ErrNoMatchingOp {
-						out <- core.NewImportError(err, entity.Id(lpMessage.ID))
-						return
-					}
-
-					// If this comment already exists, we are probably
-					// updating an existing bug. We do not want to duplicate
-					// the comments, so let us just skip this one.
-					// TODO: Can Launchpad comments be edited?
-					if err == nil {
-						continue
-					}
-
-					owner, err := li.ensurePerson(repo, lpMessage.Owner)
-					if err != nil {
-						out <- core.NewImportError(err, "")
-						return
-					}
-
-					// This is a new comment, we can add it.
-					createdAt, _ := time.Parse(time.RFC3339, lpMessage.CreatedAt)
-					op, err := b.AddCommentRaw(
-						owner,
-						createdAt.Unix(),
-						lpMessage.Content,
-						nil,
-						map[string]string{
-							metaKeyLaunchpadID: lpMessage.ID,
-						})
-					if err != nil {
-						out <- core.NewImportError(err, b.Id())
-						return
-					}
-
-					out <- core.NewImportComment(op.Id())
-				}
+// resolveBug return the bug imported from a Launchpad bug. The bugs exported
+// by git-bug don't have an origin, so only the id is matched.
+func (li *launchpadImporter) resolveBug(repo *cache.RepoCache, lpBug LPBug) (*cache.BugCache, error) {
+	lpBugID := strconv.Itoa(lpBug.ID)
+	return repo.ResolveBugMatcher(func(excerpt *cache.BugExcerpt) bool {
+		return excerpt.CreateMetadata[metaKeyLaunchpadID] == lpBugID
+	})
+}
 
-				if !b.NeedCommit() {
-					out <- core.NewImportNothing(b.Id(), "no imported operation")
-				} else if err := b.Commit(); err != nil {
-					out <- core.NewImportError(err, "")
-					return
-				}
+func (li *launchpadImporter) importBug(ctx context.Context, repo *cache.RepoCache, lpAPI *launchpadAPI, lpBug LPBug, out chan<- core.ImportResult) error {
+	lpBugID := strconv.Itoa(lpBug.ID)
+
+	b, err := li.resolveBug(repo, lpBug)
+	if err != nil && err != bug.ErrBugNotExist {
+		return err
+	}
+
+	if err == bug.ErrBugNotExist && li.dryRun {
+		out <- core.NewImportDryRun(core.ImportEventBug, "", fmt.Sprintf("create the bug \"%s\"", lpBug.Title))
+		return nil
+	}
+
+	if err == bug.ErrBugNotExist {
+		owner, err := li.ensurePerson(repo, lpBug.Owner)
+		if err != nil {
+			return err
+		}
+
+		createdAt, _ := time.Parse(time.RFC3339, lpBug.CreatedAt)
+		b, _, err = repo.NewBugRaw(
+			owner,
+			createdAt.Unix(),
+			lpBug.Title,
+			lpBug.Description,
+			nil,
+			map[string]string{
+				core.MetaKeyOrigin:           target,
+				metaKeyLaunchpadID:           lpBugID,
+				metaKeyLaunchpadMessageCount: "1",
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		out <- core.NewImportBug(b.Id())
+	}
+
+	/* Handle messages */
+	seen := seenMessageCount(b.Snapshot())
+	if lpBug.MessageCount > seen {
+		messages, err := lpAPI.QueryMessages(ctx, lpBug.ID, seen)
+		if err != nil {
+			return err
+		}
+
+		for i, lpMessage := range messages {
+			// The Launchpad API returns the bug description as the first
+			// comment, so skip it.
+			if seen+i == 0 {
+				continue
+			}
+
+			err := li.ensureComment(repo, b, lpMessage, seen+i+1, out)
+			if err != nil {
+				return err
 			}
 		}
-	}()
+	}
 
-	return out, nil
+	if li.dryRun {
+		return nil
+	}
+
+	if !b.NeedCommit() {
+		out <- core.NewImportNothing(b.Id(), "no imported operation")
+		return nil
+	}
+
+	return b.Commit()
+}
+
+func (li *launchpadImporter) ensureComment(repo *cache.RepoCache, b *cache.BugCache, lpMessage LPMessage, count int, out chan<- core.ImportResult) error {
+	_, err := b.ResolveOperationWithMetadata(metaKeyLaunchpadID, lpMessage.ID)
+	if err != nil && err != cache.ErrNoMatchingOp {
+		return err
+	}
+
+	// If this comment already exists, we are probably updating an existing
+	// bug. We do not want to duplicate the comments, so let us just skip this
+	// one.
+	// TODO: Can Launchpad comments be edited?
+	if err == nil {
+		return nil
+	}
+
+	if li.dryRun {
+		out <- core.NewImportDryRun(core.ImportEventComment, b.Id(), fmt.Sprintf("add a comment from %s", lpMessage.Owner.Login))
+		return nil
+	}
+
+	owner, err := li.ensurePerson(repo, lpMessage.Owner)
+	if err != nil {
+		return err
+	}
+
+	// This is a new comment, we can add it.
+	createdAt, _ := time.Parse(time.RFC3339, lpMessage.CreatedAt)
+	op, err := b.AddCommentRaw(
+		owner,
+		createdAt.Unix(),
+		lpMessage.Content,
+		nil,
+		map[string]string{
+			metaKeyLaunchpadID:           lpMessage.ID,
+			metaKeyLaunchpadMessageCount: strconv.Itoa(count),
+		})
+	if err != nil {
+		return err
+	}
+
+	out <- core.NewImportComment(op.Id())
+
+	return nil
+}
+
+// isClosedStatus tell if a Launchpad status is one of a resolved bug task
+func isClosedStatus(status string) bool {
+	switch status {
+	case "Invalid", "Won't Fix", "Expired", "Opinion", "Fix Released":
+		return true
+	default:
+		return false
+	}
+}
+
+// seenMessageCount return the number of Launchpad messages already known
+// for a bug, that is the highest count recorded on its operations
+func seenMessageCount(snapshot *bug.Snapshot) int {
+	seen := 0
+	for _, op := range snapshot.Operations {
+		value, ok := op.GetMetadata(metaKeyLaunchpadMessageCount)
+		if !ok {
+			continue
+		}
+		count, err := strconv.Atoi(value)
+		if err == nil && count > seen {
+			seen = count
+		}
+	}
+	return seen
 }
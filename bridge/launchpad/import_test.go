@@ -0,0 +1,91 @@
+package launchpad
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestImport(t *testing.T) {
+	server, closeServer := newFakeLaunchpad("git-bug")
+	defer closeServer()
+
+	server.addPerson(LPPerson{Name: "Alice", Login: "alice"}, "", "")
+	server.addPerson(LPPerson{Name: "Bob", Login: "bob"}, "", "")
+
+	bug1 := server.addBug("alice", "first bug", "first description")
+	server.addMessage(bug1, "bob", "first comment")
+	bug2 := server.addBug("bob", "second bug", "second description")
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	conf := core.Configuration{
+		core.ConfigKeyTarget: target,
+		confKeyProject:       "git-bug",
+	}
+
+	ctx := context.Background()
+
+	importer := &launchpadImporter{}
+	err = importer.Init(ctx, backend, conf, false)
+	require.NoError(t, err)
+
+	importSince := func(since time.Time) {
+		events, err := importer.ImportAll(ctx, backend, since)
+		require.NoError(t, err)
+		for result := range events {
+			require.NoError(t, result.Err)
+		}
+	}
+
+	importSince(time.Time{})
+
+	require.Len(t, backend.AllBugsIds(), 2)
+
+	b1, err := backend.ResolveBugCreateMetadata(metaKeyLaunchpadID, "1")
+	require.NoError(t, err)
+	snapshot := b1.Snapshot()
+	require.Equal(t, "first bug", snapshot.Title)
+	require.Len(t, snapshot.Comments, 2)
+	require.Equal(t, "first description", snapshot.Comments[0].Message)
+	require.Equal(t, "first comment", snapshot.Comments[1].Message)
+	require.Equal(t, "bob", snapshot.Comments[1].Author.Login())
+
+	// the description is already known with the bug, so the messages are
+	// only fetched when there is more
+	require.Equal(t, []int{1}, server.messageQueries[bug1.id])
+	require.Empty(t, server.messageQueries[bug2.id])
+
+	// a new message on the first bug
+	lastImport := server.clock
+	server.addMessage(bug1, "alice", "second comment")
+
+	importSince(lastImport)
+
+	// only the new message of the first bug is fetched
+	require.Equal(t, []int{1, 2}, server.messageQueries[bug1.id])
+	require.Empty(t, server.messageQueries[bug2.id])
+
+	snapshot = b1.Snapshot()
+	require.Len(t, snapshot.Comments, 3)
+	require.Equal(t, "second comment", snapshot.Comments[2].Message)
+
+	// a full import doesn't fetch known messages or duplicate anything
+	importSince(time.Time{})
+
+	require.Equal(t, []int{1, 2}, server.messageQueries[bug1.id])
+	require.Empty(t, server.messageQueries[bug2.id])
+	require.Len(t, backend.AllBugsIds(), 2)
+	require.Len(t, b1.Snapshot().Comments, 3)
+}
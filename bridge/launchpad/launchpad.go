@@ -11,10 +11,18 @@ const (
 	target = "launchpad-preview"
 
 	metaKeyLaunchpadID    = "launchpad-id"
+	metaKeyLaunchpadUrl   = "launchpad-url"
 	metaKeyLaunchpadLogin = "launchpad-login"
+	// number of messages of the bug once the tagged operation is imported,
+	// to only fetch the new ones
+	metaKeyLaunchpadMessageCount = "launchpad-message-count"
 
 	confKeyProject = "project"
 
+	// name under which git-bug identify itself to Launchpad when requesting
+	// an access
+	consumerKey = "git-bug"
+
 	defaultTimeout = 60 * time.Second
 )
 
@@ -35,5 +43,5 @@ func (*Launchpad) NewImporter() core.Importer {
 }
 
 func (*Launchpad) NewExporter() core.Exporter {
-	return nil
+	return &launchpadExporter{}
 }
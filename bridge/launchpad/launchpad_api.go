@@ -4,24 +4,46 @@ package launchpad
  * A wrapper around the Launchpad API. The documentation can be found at:
  * https://launchpad.net/+apidoc/devel.html
  *
+ * Read requests are anonymous, write requests are authenticated with an
+ * OAuth 1.0 token, using the PLAINTEXT signature method as described at:
+ * https://help.launchpad.net/API/SigningRequests
+ *
  * TODO:
  * - Retrieve bug status
  * - Retrieve activity log
  * - SearchTasks should yield bugs one by one
- *
- * TODO (maybe):
- * - Authentication (this might help retrieving email adresses)
  */
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
 )
 
-const apiRoot = "https://api.launchpad.net/devel"
+var (
+	apiRoot = "https://api.launchpad.net/devel"
+	// root of the OAuth token endpoints
+	authRoot = "https://launchpad.net"
+	// root of the bug pages
+	webRoot = "https://bugs.launchpad.net"
+)
+
+// realm of the OAuth signature, always the production API, even for other
+// Launchpad instances
+const oauthRealm = "https://api.launchpad.net/"
 
 // Person describes a person on Launchpad (a bug owner, a message author, ...).
 type LPPerson struct {
@@ -36,25 +58,32 @@ var personCache = make(map[string]LPPerson)
 
 // LPBug describes a Launchpad bug.
 type LPBug struct {
-	Title       string   `json:"title"`
-	ID          int      `json:"id"`
-	Owner       LPPerson `json:"owner_link"`
-	Description string   `json:"description"`
-	CreatedAt   string   `json:"date_created"`
-	Messages    []LPMessage
+	Title       string `json:"title"`
+	ID          int    `json:"id"`
+	OwnerLink   string `json:"owner_link"`
+	Owner       LPPerson
+	Description string `json:"description"`
+	CreatedAt   string `json:"date_created"`
+	// number of messages, including the description
+	MessageCount int      `json:"message_count"`
+	Tags         []string `json:"tags"`
+	// status of the bug task of the project
+	Status string `json:"-"`
 }
 
 // LPMessage describes a comment on a bug report
 type LPMessage struct {
-	Content   string   `json:"content"`
-	CreatedAt string   `json:"date_created"`
-	Owner     LPPerson `json:"owner_link"`
-	ID        string   `json:"self_link"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"date_created"`
+	OwnerLink string `json:"owner_link"`
+	Owner     LPPerson
+	ID        string `json:"self_link"`
 }
 
 type launchpadBugEntry struct {
 	BugLink  string `json:"bug_link"`
 	SelfLink string `json:"self_link"`
+	Status   string `json:"status"`
 }
 
 type launchpadAnswer struct {
@@ -70,16 +99,23 @@ type launchpadMessageAnswer struct {
 
 type launchpadAPI struct {
 	client *http.Client
+	// credential used to sign the requests, nil for anonymous access
+	credential *auth.OAuth1
 }
 
-func (lapi *launchpadAPI) Init() error {
+// Init configure the API client, authenticated with the given credential if
+// not nil
+func (lapi *launchpadAPI) Init(credential *auth.OAuth1) error {
 	lapi.client = &http.Client{
 		Timeout: defaultTimeout,
 	}
+	lapi.credential = credential
 	return nil
 }
 
-func (lapi *launchpadAPI) SearchTasks(ctx context.Context, project string) ([]LPBug, error) {
+// SearchTasks return the bugs of a project, only the ones modified after the
+// since date if not zero. The messages of the bugs are not retrieved.
+func (lapi *launchpadAPI) SearchTasks(ctx context.Context, project string, since time.Time) ([]LPBug, error) {
 	var bugs []LPBug
 
 	// First, let us build the URL. Not all statuses are included by
@@ -96,6 +132,9 @@ func (lapi *launchpadAPI) SearchTasks(ctx context.Context, project string) ([]LP
 	for _, validStatus := range validStatuses {
 		queryParams.Add("status", validStatus)
 	}
+	if !since.IsZero() {
+		queryParams.Add("modified_since", since.UTC().Format(time.RFC3339))
+	}
 	lpURL := fmt.Sprintf("%s/%s?%s", apiRoot, project, queryParams.Encode())
 
 	for {
@@ -121,6 +160,7 @@ func (lapi *launchpadAPI) SearchTasks(ctx context.Context, project string) ([]LP
 		for _, bugEntry := range result.Entries {
 			bug, err := lapi.queryBug(ctx, bugEntry.BugLink)
 			if err == nil {
+				bug.Status = bugEntry.Status
 				bugs = append(bugs, bug)
 			}
 		}
@@ -156,20 +196,24 @@ func (lapi *launchpadAPI) queryBug(ctx context.Context, url string) (LPBug, erro
 		return bug, err
 	}
 
-	/* Fetch messages */
-	messagesCollectionLink := fmt.Sprintf("%s/bugs/%d/messages", apiRoot, bug.ID)
-	messages, err := lapi.queryMessages(ctx, messagesCollectionLink)
+	bug.Owner, err = lapi.queryPerson(ctx, bug.OwnerLink)
 	if err != nil {
 		return bug, err
 	}
-	bug.Messages = messages
 
 	return bug, nil
 }
 
-func (lapi *launchpadAPI) queryMessages(ctx context.Context, messagesURL string) ([]LPMessage, error) {
+// QueryMessages return the messages of a bug, skipping the first ones up to
+// the start index. The first message is the description of the bug.
+func (lapi *launchpadAPI) QueryMessages(ctx context.Context, bugID int, start int) ([]LPMessage, error) {
 	var messages []LPMessage
 
+	messagesURL := fmt.Sprintf("%s/bugs/%d/messages", apiRoot, bugID)
+	if start > 0 {
+		messagesURL = fmt.Sprintf("%s?ws.start=%d", messagesURL, start)
+	}
+
 	for {
 		req, err := http.NewRequest("GET", messagesURL, nil)
 		if err != nil {
@@ -191,7 +235,13 @@ func (lapi *launchpadAPI) queryMessages(ctx context.Context, messagesURL string)
 			return nil, err
 		}
 
-		messages = append(messages, result.Entries...)
+		for _, message := range result.Entries {
+			message.Owner, err = lapi.queryPerson(ctx, message.OwnerLink)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, message)
+		}
 
 		// Launchpad only returns 75 results at a time. We get the next
 		// page and run another query, unless there is no other page.
@@ -202,3 +252,206 @@ func (lapi *launchpadAPI) queryMessages(ctx context.Context, messagesURL string)
 	}
 	return messages, nil
 }
+
+func (lapi *launchpadAPI) queryPerson(ctx context.Context, url string) (LPPerson, error) {
+	if person, ok := personCache[url]; ok {
+		return person, nil
+	}
+
+	var person LPPerson
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return person, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := lapi.client.Do(req)
+	if err != nil {
+		return person, err
+	}
+
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(&person); err != nil {
+		return person, err
+	}
+
+	personCache[url] = person
+
+	return person, nil
+}
+
+// CreateBug report a new bug against a project and return its ID
+func (lapi *launchpadAPI) CreateBug(ctx context.Context, project, title, description string) (int, error) {
+	form := url.Values{}
+	form.Set("ws.op", "createBug")
+	form.Set("target", fmt.Sprintf("%s/%s", apiRoot, project))
+	form.Set("title", title)
+	form.Set("description", description)
+
+	resp, err := lapi.postForm(ctx, fmt.Sprintf("%s/bugs", apiRoot), form)
+	if err != nil {
+		return 0, err
+	}
+	_ = resp.Body.Close()
+
+	// the new bug is only given as a redirection to its page
+	location := resp.Header.Get("Location")
+	id, err := strconv.Atoi(location[strings.LastIndex(location, "/")+1:])
+	if err != nil {
+		return 0, fmt.Errorf("unexpected bug location: %s", location)
+	}
+
+	return id, nil
+}
+
+// AddComment post a new message on a bug and return its self link, as
+// listed in the messages of the bug
+func (lapi *launchpadAPI) AddComment(ctx context.Context, bugID int, content string) (string, error) {
+	form := url.Values{}
+	form.Set("ws.op", "newMessage")
+	form.Set("content", content)
+
+	resp, err := lapi.postForm(ctx, fmt.Sprintf("%s/bugs/%d", apiRoot, bugID), form)
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+
+	// the response points to the message itself, not to its entry in the
+	// bug messages which is what the importer knows about
+	messages, err := lapi.QueryMessages(ctx, bugID, 0)
+	if err != nil {
+		return "", err
+	}
+	if len(messages) == 0 {
+		return "", fmt.Errorf("posted message not found")
+	}
+
+	return messages[len(messages)-1].ID, nil
+}
+
+// SetTitle change the title of a bug
+func (lapi *launchpadAPI) SetTitle(ctx context.Context, bugID int, title string) error {
+	return lapi.patch(ctx, fmt.Sprintf("%s/bugs/%d", apiRoot, bugID), map[string]string{
+		"title": title,
+	})
+}
+
+// SetDescription change the description of a bug
+func (lapi *launchpadAPI) SetDescription(ctx context.Context, bugID int, description string) error {
+	return lapi.patch(ctx, fmt.Sprintf("%s/bugs/%d", apiRoot, bugID), map[string]string{
+		"description": description,
+	})
+}
+
+// SetStatus change the status of the task of a bug for a project
+func (lapi *launchpadAPI) SetStatus(ctx context.Context, project string, bugID int, status string) error {
+	return lapi.patch(ctx, fmt.Sprintf("%s/%s/+bug/%d", apiRoot, project, bugID), map[string]string{
+		"status": status,
+	})
+}
+
+// Me return the person owning the credential used to sign the requests
+func (lapi *launchpadAPI) Me(ctx context.Context) (LPPerson, error) {
+	var person LPPerson
+
+	resp, err := lapi.do(ctx, http.MethodGet, fmt.Sprintf("%s/people/+me", apiRoot), nil, "")
+	if err != nil {
+		return person, err
+	}
+	defer resp.Body.Close()
+
+	err = json.NewDecoder(resp.Body).Decode(&person)
+	return person, err
+}
+
+func (lapi *launchpadAPI) postForm(ctx context.Context, url string, form url.Values) (*http.Response, error) {
+	return lapi.do(ctx, http.MethodPost, url, strings.NewReader(form.Encode()), "application/x-www-form-urlencoded")
+}
+
+func (lapi *launchpadAPI) patch(ctx context.Context, url string, fields map[string]string) error {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	resp, err := lapi.do(ctx, http.MethodPatch, url, bytes.NewReader(data), "application/json")
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+// do send a signed request and return an error if Launchpad didn't accept it
+func (lapi *launchpadAPI) do(ctx context.Context, method, url string, body io.Reader, contentType string) (*http.Response, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	if lapi.credential != nil {
+		header, err := oauthHeader(lapi.credential)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", header)
+	}
+
+	resp, err := lapi.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		err := fmt.Errorf("launchpad: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+		if core.IsRateLimitResponse(resp) {
+			return nil, core.NewRateLimitError(err)
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// oauthHeader build the Authorization header of a request signed with the
+// PLAINTEXT method
+func oauthHeader(cred *auth.OAuth1) (string, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	params := []struct{ key, value string }{
+		{"realm", oauthRealm},
+		{"oauth_consumer_key", cred.ConsumerKey},
+		{"oauth_token", cred.Token},
+		{"oauth_signature_method", "PLAINTEXT"},
+		{"oauth_signature", "&" + cred.TokenSecret},
+		{"oauth_timestamp", strconv.FormatInt(time.Now().Unix(), 10)},
+		{"oauth_nonce", hex.EncodeToString(nonce)},
+		{"oauth_version", "1.0"},
+	}
+
+	parts := make([]string, len(params))
+	for i, param := range params {
+		parts[i] = fmt.Sprintf(`%s="%s"`, param.key, oauthEscape(param.value))
+	}
+
+	return "OAuth " + strings.Join(parts, ", "), nil
+}
+
+// oauthEscape percent-encode a value as required by OAuth 1.0
+func oauthEscape(value string) string {
+	return strings.Replace(url.QueryEscape(value), "+", "%20", -1)
+}
@@ -0,0 +1,404 @@
+package launchpad
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type fakeMessage struct {
+	content string
+	owner   string
+	created time.Time
+}
+
+type fakeBug struct {
+	id          int
+	title       string
+	description string
+	owner       string
+	status      string
+	messages    []fakeMessage
+	modified    time.Time
+}
+
+// fakeLaunchpad is an in-memory stand-in of the Launchpad API and OAuth
+// endpoints, serving a single project
+type fakeLaunchpad struct {
+	*httptest.Server
+
+	project string
+
+	mu     sync.Mutex
+	people map[string]LPPerson
+	// login by access token
+	tokens map[string]string
+	// secrets by access token
+	secrets map[string]string
+	// request token waiting for the user authorization
+	requestToken      string
+	requestSecret     string
+	requestAuthorized string
+	bugs              []*fakeBug
+	clock             time.Time
+	// start index of the messages requested, by bug
+	messageQueries map[int][]int
+}
+
+// newFakeLaunchpad start a fake server and point the bridge to it, until
+// the returned function is called
+func newFakeLaunchpad(project string) (*fakeLaunchpad, func()) {
+	f := &fakeLaunchpad{
+		project: project,
+		people:  make(map[string]LPPerson),
+		tokens:  make(map[string]string),
+		secrets: make(map[string]string),
+		clock:   time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC),
+
+		messageQueries: make(map[int][]int),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.handle))
+
+	oldAPIRoot, oldAuthRoot, oldWebRoot := apiRoot, authRoot, webRoot
+	apiRoot = f.URL + "/api"
+	authRoot = f.URL + "/auth"
+	webRoot = f.URL
+
+	return f, func() {
+		apiRoot, authRoot, webRoot = oldAPIRoot, oldAuthRoot, oldWebRoot
+		f.Close()
+	}
+}
+
+// addPerson register a person, authenticated by the given access token if
+// not empty
+func (f *fakeLaunchpad) addPerson(person LPPerson, token, secret string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.people[person.Login] = person
+	if token != "" {
+		f.tokens[token] = person.Login
+		f.secrets[token] = secret
+	}
+}
+
+func (f *fakeLaunchpad) addBug(owner, title, description string) *fakeBug {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.newBug(owner, title, description)
+}
+
+func (f *fakeLaunchpad) newBug(owner, title, description string) *fakeBug {
+	b := &fakeBug{
+		id:          len(f.bugs) + 1,
+		title:       title,
+		description: description,
+		owner:       owner,
+		status:      "New",
+	}
+	// as Launchpad, the description is the first message
+	b.addMessage(description, owner, f.now())
+	f.bugs = append(f.bugs, b)
+	return b
+}
+
+func (f *fakeLaunchpad) addMessage(b *fakeBug, owner, content string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	b.addMessage(content, owner, f.now())
+}
+
+func (b *fakeBug) addMessage(content, owner string, created time.Time) {
+	b.messages = append(b.messages, fakeMessage{content: content, owner: owner, created: created})
+	b.modified = created
+}
+
+func (f *fakeLaunchpad) bug(id int) *fakeBug {
+	for _, b := range f.bugs {
+		if b.id == id {
+			return b
+		}
+	}
+	return nil
+}
+
+func (f *fakeLaunchpad) now() time.Time {
+	f.clock = f.clock.Add(time.Minute)
+	return f.clock
+}
+
+func (f *fakeLaunchpad) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if strings.HasPrefix(r.URL.Path, "/auth/") {
+		f.handleAuth(w, r)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/api")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	// anonymous requests
+	switch {
+	// /{project}
+	case len(parts) == 1 && parts[0] == f.project && r.Method == http.MethodGet:
+		if r.URL.Query().Get("ws.op") != "searchTasks" {
+			writeJSON(w, http.StatusOK, map[string]string{"name": f.project})
+			return
+		}
+		var since time.Time
+		if raw := r.URL.Query().Get("modified_since"); raw != "" {
+			var err error
+			since, err = time.Parse(time.RFC3339, raw)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		var entries []launchpadBugEntry
+		for _, b := range f.bugs {
+			if !b.modified.After(since) {
+				continue
+			}
+			entries = append(entries, launchpadBugEntry{
+				BugLink:  fmt.Sprintf("%s/bugs/%d", apiRoot, b.id),
+				SelfLink: fmt.Sprintf("%s/%s/+bug/%d", apiRoot, f.project, b.id),
+			})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"entries": entries})
+		return
+
+	// /~{login}
+	case len(parts) == 1 && strings.HasPrefix(parts[0], "~") && r.Method == http.MethodGet:
+		person, ok := f.people[strings.TrimPrefix(parts[0], "~")]
+		if !ok {
+			http.Error(w, "person not found", http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, person)
+		return
+
+	// /bugs/{id}
+	case len(parts) == 2 && parts[0] == "bugs" && r.Method == http.MethodGet:
+		b := f.bugFromPath(w, parts[1])
+		if b == nil {
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":            b.id,
+			"title":         b.title,
+			"description":   b.description,
+			"owner_link":    fmt.Sprintf("%s/~%s", apiRoot, b.owner),
+			"date_created":  b.messages[0].created.Format(time.RFC3339),
+			"message_count": len(b.messages),
+		})
+		return
+
+	// /bugs/{id}/messages
+	case len(parts) == 3 && parts[0] == "bugs" && parts[2] == "messages" && r.Method == http.MethodGet:
+		b := f.bugFromPath(w, parts[1])
+		if b == nil {
+			return
+		}
+		start, _ := strconv.Atoi(r.URL.Query().Get("ws.start"))
+		f.messageQueries[b.id] = append(f.messageQueries[b.id], start)
+		entries := []map[string]string{}
+		for i, message := range b.messages {
+			if i < start {
+				continue
+			}
+			entries = append(entries, map[string]string{
+				"content":      message.content,
+				"date_created": message.created.Format(time.RFC3339),
+				"owner_link":   fmt.Sprintf("%s/~%s", apiRoot, message.owner),
+				"self_link":    fmt.Sprintf("%s/bugs/%d/comments/%d", apiRoot, b.id, i),
+			})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"entries": entries})
+		return
+	}
+
+	login, ok := f.authenticate(r)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	// /people/+me
+	case len(parts) == 2 && parts[0] == "people" && parts[1] == "+me" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, f.people[login])
+
+	// /bugs
+	case len(parts) == 1 && parts[0] == "bugs" && r.Method == http.MethodPost:
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("ws.op") != "createBug" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("target") != fmt.Sprintf("%s/%s", apiRoot, f.project) {
+			http.Error(w, "unknown target", http.StatusBadRequest)
+			return
+		}
+		b := f.newBug(login, r.PostForm.Get("title"), r.PostForm.Get("description"))
+		w.Header().Set("Location", fmt.Sprintf("%s/bugs/%d", apiRoot, b.id))
+		w.WriteHeader(http.StatusCreated)
+
+	// /bugs/{id}
+	case len(parts) == 2 && parts[0] == "bugs" && r.Method == http.MethodPost:
+		b := f.bugFromPath(w, parts[1])
+		if b == nil {
+			return
+		}
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("ws.op") != "newMessage" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		b.addMessage(r.PostForm.Get("content"), login, f.now())
+		w.Header().Set("Location", fmt.Sprintf("%s/~%s/+message/%d", apiRoot, login, len(b.messages)))
+		w.WriteHeader(http.StatusCreated)
+
+	case len(parts) == 2 && parts[0] == "bugs" && r.Method == http.MethodPatch:
+		b := f.bugFromPath(w, parts[1])
+		if b == nil {
+			return
+		}
+		var in map[string]string
+		if !readJSON(w, r, &in) {
+			return
+		}
+		if title, ok := in["title"]; ok {
+			b.title = title
+		}
+		if description, ok := in["description"]; ok {
+			b.description = description
+		}
+		b.modified = f.now()
+		w.WriteHeader(http.StatusOK)
+
+	// /{project}/+bug/{id}
+	case len(parts) == 3 && parts[0] == f.project && parts[1] == "+bug" && r.Method == http.MethodPatch:
+		b := f.bugFromPath(w, parts[2])
+		if b == nil {
+			return
+		}
+		var in map[string]string
+		if !readJSON(w, r, &in) {
+			return
+		}
+		valid := false
+		for _, status := range launchpadStatuses {
+			valid = valid || status == in["status"]
+		}
+		if !valid {
+			http.Error(w, "invalid status", http.StatusBadRequest)
+			return
+		}
+		b.status = in["status"]
+		b.modified = f.now()
+		w.WriteHeader(http.StatusOK)
+
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
+
+func (f *fakeLaunchpad) handleAuth(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("oauth_consumer_key") != consumerKey {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/auth/+request-token":
+		f.requestToken = "request-token"
+		f.requestSecret = "request-secret"
+		_, _ = fmt.Fprintf(w, "oauth_token=%s&oauth_token_secret=%s", f.requestToken, f.requestSecret)
+
+	case "/auth/+access-token":
+		if r.PostForm.Get("oauth_token") != f.requestToken ||
+			r.PostForm.Get("oauth_signature") != "&"+f.requestSecret {
+			http.Error(w, "Invalid OAuth signature.", http.StatusUnauthorized)
+			return
+		}
+		if f.requestAuthorized == "" {
+			http.Error(w, "Request token has not yet been reviewed.", http.StatusUnauthorized)
+			return
+		}
+		token := "access-token"
+		f.tokens[token] = f.requestAuthorized
+		f.secrets[token] = "access-secret"
+		_, _ = fmt.Fprintf(w, "oauth_token=%s&oauth_token_secret=%s", token, f.secrets[token])
+
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
+
+// authorize simulate a person granting access to the pending request token
+func (f *fakeLaunchpad) authorize(login string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requestAuthorized = login
+}
+
+// authenticate check the OAuth signature of a request and return the login
+// of the person it belong to
+func (f *fakeLaunchpad) authenticate(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "OAuth ") {
+		return "", false
+	}
+
+	params := make(map[string]string)
+	for _, part := range strings.Split(strings.TrimPrefix(header, "OAuth "), ", ") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return "", false
+		}
+		value, err := url.QueryUnescape(strings.Trim(kv[1], `"`))
+		if err != nil {
+			return "", false
+		}
+		params[kv[0]] = value
+	}
+
+	token := params["oauth_token"]
+	login, ok := f.tokens[token]
+	if !ok || params["oauth_signature_method"] != "PLAINTEXT" ||
+		params["oauth_signature"] != "&"+f.secrets[token] ||
+		params["oauth_consumer_key"] != consumerKey {
+		return "", false
+	}
+
+	return login, true
+}
+
+func (f *fakeLaunchpad) bugFromPath(w http.ResponseWriter, raw string) *fakeBug {
+	id, _ := strconv.Atoi(raw)
+	b := f.bug(id)
+	if b == nil {
+		http.Error(w, "bug not found", http.StatusNotFound)
+	}
+	return b
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
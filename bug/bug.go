@@ -212,6 +212,7 @@ func read(repo repository.ClockedRepo, identityResolver identity.Resolver, ref s
 
 		// tag the pack with the commit hash
 		opp.commitHash = hash
+		opp.editTime = lamport.Time(editTime)
 
 		bug.packs = append(bug.packs, *opp)
 	}
@@ -536,6 +537,7 @@ func (bug *Bug) Commit(repo repository.ClockedRepo) error {
 	}
 
 	bug.staging.commitHash = hash
+	bug.staging.editTime = bug.editTime
 	bug.packs = append(bug.packs, bug.staging)
 	bug.staging = OperationPack{}
 
@@ -690,6 +692,23 @@ func (bug *Bug) EditLamportTime() lamport.Time {
 	return bug.editTime
 }
 
+// OperationsEditTime return the edit Lamport time of the commit holding each
+// operation, in the order of the operations. Unlike the ids of the
+// operations, it doesn't change when the history is rebased by a merge or
+// rewritten by a purge. The staged operations have none yet.
+func (bug *Bug) OperationsEditTime() []lamport.Time {
+	var result []lamport.Time
+	for _, pack := range bug.packs {
+		for range pack.Operations {
+			result = append(result, pack.editTime)
+		}
+	}
+	for range bug.staging.Operations {
+		result = append(result, 0)
+	}
+	return result
+}
+
 // Lookup for the very first operation of the bug.
 // For a valid Bug, this operation should be a CreateOp
 func (bug *Bug) FirstOp() Operation {
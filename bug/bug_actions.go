@@ -20,12 +20,19 @@ func Fetch(repo repository.Repo, remote string) (string, error) {
 	return repo.FetchRefs(remote, fetchRefSpec)
 }
 
-// Push update a remote with the local changes
+// Push update a remote with the local changes. The bugs purged with
+// PurgeRedactedComments are force pushed, as their history has been rewritten.
 func Push(repo repository.Repo, remote string) (string, error) {
+	stdout, err := forcePushPurged(repo, remote)
+	if err != nil {
+		return stdout, err
+	}
+
 	// "refs/bugs/*:refs/bugs/*"
 	refspec := fmt.Sprintf("%s*:%s*", bugsRefPattern, bugsRefPattern)
 
-	return repo.PushRefs(remote, refspec)
+	out, err := repo.PushRefs(remote, refspec)
+	return stdout + out, err
 }
 
 // Pull will do a Fetch + MergeAll
@@ -74,6 +81,14 @@ func MergeAll(repo repository.ClockedRepo, remote string) <-chan entity.MergeRes
 			return
 		}
 
+		// the remote history of the purged bugs would bring back the purged
+		// content, until they are force pushed
+		purged, err := pendingForcePush(repo, remote)
+		if err != nil {
+			out <- entity.MergeResult{Err: err}
+			return
+		}
+
 		for _, remoteRef := range remoteRefs {
 			refSplit := strings.Split(remoteRef, "/")
 			id := entity.Id(refSplit[len(refSplit)-1])
@@ -124,6 +139,11 @@ func MergeAll(repo repository.ClockedRepo, remote string) <-chan entity.MergeRes
 				return
 			}
 
+			if _, ok := purged[id]; ok {
+				out <- entity.NewMergeStatus(entity.MergeStatusNothing, id, localBug)
+				continue
+			}
+
 			updated, err := localBug.Merge(repo, remoteBug)
 
 			if err != nil {
@@ -16,6 +16,12 @@ type Comment struct {
 	Message string
 	Files   []repository.Hash
 
+	// Emoji reactions to the comment, aggregated by reaction
+	Reactions []ReactionGroup
+
+	// Redacted is true if the message has been hidden by a redaction
+	Redacted bool
+
 	// Creation time of the comment.
 	// Should be used only for human display, never for ordering as we can't rely on it in a distributed system.
 	UnixTime timestamp.Timestamp
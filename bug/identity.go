@@ -22,6 +22,30 @@ func (bug *Bug) EnsureIdentities(resolver identity.Resolver) error {
 
 			base.Author = i
 		}
+
+		if op, ok := op.(*SetAssigneesOperation); ok {
+			if err := resolveStubs(resolver, op.Added); err != nil {
+				return err
+			}
+			if err := resolveStubs(resolver, op.Removed); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolveStubs replace in place the IdentityStub with the full Identity
+func resolveStubs(resolver identity.Resolver, identities []identity.Interface) error {
+	for j, i := range identities {
+		if stub, ok := i.(*identity.IdentityStub); ok {
+			resolved, err := resolver.ResolveIdentity(stub.Id())
+			if err != nil {
+				return err
+			}
+
+			identities[j] = resolved
+		}
 	}
 	return nil
 }
@@ -0,0 +1,115 @@
+package bug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// LinkType is the kind of relationship between two bugs
+type LinkType string
+
+const (
+	LinkDuplicateOf  LinkType = "duplicate-of"
+	LinkDuplicatedBy LinkType = "duplicated-by"
+	LinkBlocks       LinkType = "blocks"
+	LinkBlockedBy    LinkType = "blocked-by"
+	LinkParentOf     LinkType = "parent-of"
+	LinkChildOf      LinkType = "child-of"
+	LinkRelatesTo    LinkType = "relates-to"
+)
+
+// LinkTypes is the list of all the valid link types
+var LinkTypes = []LinkType{
+	LinkDuplicateOf,
+	LinkDuplicatedBy,
+	LinkBlocks,
+	LinkBlockedBy,
+	LinkParentOf,
+	LinkChildOf,
+	LinkRelatesTo,
+}
+
+func LinkTypeFromString(str string) (LinkType, error) {
+	cleaned := LinkType(strings.ToLower(strings.TrimSpace(str)))
+
+	for _, t := range LinkTypes {
+		if t == cleaned {
+			return t, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown link type %s", str)
+}
+
+func (t LinkType) String() string {
+	return string(t)
+}
+
+// Inverse return the link type seen from the other side of the relationship
+func (t LinkType) Inverse() LinkType {
+	switch t {
+	case LinkDuplicateOf:
+		return LinkDuplicatedBy
+	case LinkDuplicatedBy:
+		return LinkDuplicateOf
+	case LinkBlocks:
+		return LinkBlockedBy
+	case LinkBlockedBy:
+		return LinkBlocks
+	case LinkParentOf:
+		return LinkChildOf
+	case LinkChildOf:
+		return LinkParentOf
+	default:
+		return t
+	}
+}
+
+// Description return a human readable description of the link type
+func (t LinkType) Description() string {
+	return strings.Replace(string(t), "-", " ", -1)
+}
+
+func (t LinkType) Validate() error {
+	for _, valid := range LinkTypes {
+		if t == valid {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid link type")
+}
+
+// Link is a typed relationship from a bug to another one
+type Link struct {
+	Type   LinkType  `json:"type"`
+	Target entity.Id `json:"target"`
+}
+
+func (l Link) String() string {
+	return fmt.Sprintf("%s %s", l.Type.Description(), l.Target.Human())
+}
+
+// Inverse return the same link seen from the target, pointing to the given source
+func (l Link) Inverse(source entity.Id) Link {
+	return Link{
+		Type:   l.Type.Inverse(),
+		Target: source,
+	}
+}
+
+func (l Link) Validate() error {
+	if err := l.Type.Validate(); err != nil {
+		return err
+	}
+
+	if err := l.Target.Validate(); err != nil {
+		return errors.Wrap(err, "target")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestReactions(t *testing.T) {
+	snapshot := Snapshot{}
+
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+	isaac := identity.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, isaac.Commit(repo))
+
+	unix := time.Now().Unix()
+
+	create := NewCreateOp(rene, unix, "title", "create", nil)
+	create.Apply(&snapshot)
+
+	comment := NewAddCommentOp(rene, unix, "comment", nil)
+	comment.Apply(&snapshot)
+
+	NewAddReactionOp(rene, unix, comment.Id(), ReactionThumbsUp).Apply(&snapshot)
+	NewAddReactionOp(isaac, unix, comment.Id(), ReactionThumbsUp).Apply(&snapshot)
+	// reacting twice has no effect
+	NewAddReactionOp(isaac, unix, comment.Id(), ReactionThumbsUp).Apply(&snapshot)
+	NewAddReactionOp(isaac, unix, comment.Id(), ReactionHeart).Apply(&snapshot)
+
+	assert.Empty(t, snapshot.Comments[0].Reactions)
+	assert.Empty(t, snapshot.Timeline[0].(*CreateTimelineItem).Reactions)
+
+	expected := []ReactionGroup{
+		{Reaction: ReactionThumbsUp, Users: []identity.Interface{rene, isaac}},
+		{Reaction: ReactionHeart, Users: []identity.Interface{isaac}},
+	}
+	assert.Equal(t, expected, snapshot.Comments[1].Reactions)
+	assert.Equal(t, expected, snapshot.Timeline[1].(*AddCommentTimelineItem).Reactions)
+
+	NewRemoveReactionOp(rene, unix, comment.Id(), ReactionThumbsUp).Apply(&snapshot)
+	NewRemoveReactionOp(isaac, unix, comment.Id(), ReactionHeart).Apply(&snapshot)
+	// removing a missing reaction has no effect
+	NewRemoveReactionOp(rene, unix, comment.Id(), ReactionRocket).Apply(&snapshot)
+
+	expected = []ReactionGroup{
+		{Reaction: ReactionThumbsUp, Users: []identity.Interface{isaac}},
+	}
+	assert.Equal(t, expected, snapshot.Comments[1].Reactions)
+	assert.Equal(t, expected, snapshot.Timeline[1].(*AddCommentTimelineItem).Reactions)
+
+	// reactions don't show in the timeline
+	assert.Len(t, snapshot.Timeline, 2)
+}
+
+func TestAddReactionSerialize(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repo)
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+	before := NewAddReactionOp(rene, unix, "target", ReactionRocket)
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after AddReactionOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the ID
+	before.Id()
+
+	// Replace the identity stub with the real thing
+	assert.Equal(t, rene.Id(), after.base().Author.Id())
+	after.Author = rene
+
+	assert.Equal(t, before, &after)
+}
+
+func TestReactionValidate(t *testing.T) {
+	require.NoError(t, ReactionThumbsUp.Validate())
+	require.NoError(t, Reaction("🎉").Validate())
+	require.Error(t, Reaction("").Validate())
+	require.Error(t, Reaction("thumbs up").Validate())
+}
@@ -75,6 +75,7 @@ func (op *EditCommentOperation) Apply(snapshot *Snapshot) {
 		if snapshot.Comments[i].Id() == op.Target {
 			snapshot.Comments[i].Message = op.Message
 			snapshot.Comments[i].Files = op.Files
+			snapshot.Comments[i].Redacted = false
 			break
 		}
 	}
@@ -0,0 +1,156 @@
+package bug
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+var _ Operation = &RedactCommentOperation{}
+
+// RedactCommentOperation will hide the current and past messages of a comment,
+// for instance after a secret was pasted by mistake. The data is still in the
+// git history until the bug is purged.
+type RedactCommentOperation struct {
+	OpBase
+	Target entity.Id `json:"target"`
+}
+
+// Sign-post method for gqlgen
+func (op *RedactCommentOperation) IsOperation() {}
+
+func (op *RedactCommentOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *RedactCommentOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *RedactCommentOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.Author)
+
+	for i := range snapshot.Comments {
+		if snapshot.Comments[i].id == op.Target {
+			snapshot.Comments[i].Message = ""
+			snapshot.Comments[i].Files = nil
+			snapshot.Comments[i].Redacted = true
+			break
+		}
+	}
+
+	for _, item := range snapshot.Timeline {
+		if item.Id() != op.Target {
+			continue
+		}
+		switch item := item.(type) {
+		case *CreateTimelineItem:
+			item.redact()
+		case *AddCommentTimelineItem:
+			item.redact()
+		}
+		break
+	}
+
+	// the raw operations are also exposed, replace the ones holding a version
+	// of the message with a redacted copy
+	for i, other := range snapshot.Operations {
+		if redacted, ok := redactOperation(other, op.Target); ok {
+			snapshot.Operations[i] = redacted
+		}
+	}
+}
+
+// redactOperation return a copy of the operation without its message and files
+// if it hold a version of the target comment. The copy keep the id of the
+// original operation.
+func redactOperation(op Operation, target entity.Id) (Operation, bool) {
+	switch op := op.(type) {
+	case *CreateOperation:
+		if op.Id() == target {
+			redacted := *op
+			redacted.Message = ""
+			redacted.Files = nil
+			return &redacted, true
+		}
+	case *AddCommentOperation:
+		if op.Id() == target {
+			redacted := *op
+			redacted.Message = ""
+			redacted.Files = nil
+			return &redacted, true
+		}
+	case *EditCommentOperation:
+		if op.Target == target {
+			// make sure the id is computed on the original content
+			op.Id()
+			redacted := *op
+			redacted.Message = ""
+			redacted.Files = nil
+			return &redacted, true
+		}
+	}
+	return nil, false
+}
+
+func (op *RedactCommentOperation) Validate() error {
+	if err := opBaseValidate(op, RedactCommentOp); err != nil {
+		return err
+	}
+
+	if err := op.Target.Validate(); err != nil {
+		return errors.Wrap(err, "target hash is invalid")
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *RedactCommentOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Target entity.Id `json:"target"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Target = aux.Target
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *RedactCommentOperation) IsAuthored() {}
+
+func NewRedactCommentOp(author identity.Interface, unixTime int64, target entity.Id) *RedactCommentOperation {
+	return &RedactCommentOperation{
+		OpBase: newOpBase(RedactCommentOp, author, unixTime),
+		Target: target,
+	}
+}
+
+// Convenience function to apply the operation
+func RedactComment(b Interface, author identity.Interface, unixTime int64, target entity.Id) (*RedactCommentOperation, error) {
+	redactOp := NewRedactCommentOp(author, unixTime, target)
+	if err := redactOp.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(redactOp)
+	return redactOp, nil
+}
@@ -0,0 +1,106 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestRedactComment(t *testing.T) {
+	snapshot := Snapshot{}
+
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	unix := time.Now().Unix()
+
+	create := NewCreateOp(rene, unix, "title", "create", nil)
+	create.Apply(&snapshot)
+	snapshot.Operations = append(snapshot.Operations, create)
+
+	comment := NewAddCommentOp(rene, unix, "my password is hunter2", []repository.Hash{"file"})
+	comment.Apply(&snapshot)
+	snapshot.Operations = append(snapshot.Operations, comment)
+
+	edit := NewEditCommentOp(rene, unix, comment.Id(), "my password is *******", nil)
+	edit.Apply(&snapshot)
+	snapshot.Operations = append(snapshot.Operations, edit)
+
+	commentId := comment.Id()
+	editId := edit.Id()
+
+	redact := NewRedactCommentOp(rene, unix, comment.Id())
+	redact.Apply(&snapshot)
+	snapshot.Operations = append(snapshot.Operations, redact)
+
+	// the other comments are untouched
+	assert.Equal(t, "create", snapshot.Comments[0].Message)
+	assert.False(t, snapshot.Comments[0].Redacted)
+
+	assert.Equal(t, "", snapshot.Comments[1].Message)
+	assert.Nil(t, snapshot.Comments[1].Files)
+	assert.True(t, snapshot.Comments[1].Redacted)
+
+	item := snapshot.Timeline[1].(*AddCommentTimelineItem)
+	assert.Equal(t, "", item.Message)
+	assert.True(t, item.Redacted)
+	require.Len(t, item.History, 2)
+	for _, step := range item.History {
+		assert.Equal(t, "", step.Message)
+		assert.True(t, step.Redacted)
+	}
+
+	// the raw operations are redacted as well, without changing their id
+	assert.Equal(t, "create", snapshot.Operations[0].(*CreateOperation).Message)
+	assert.Equal(t, "", snapshot.Operations[1].(*AddCommentOperation).Message)
+	assert.Nil(t, snapshot.Operations[1].GetFiles())
+	assert.Equal(t, commentId, snapshot.Operations[1].Id())
+	assert.Equal(t, "", snapshot.Operations[2].(*EditCommentOperation).Message)
+	assert.Equal(t, editId, snapshot.Operations[2].Id())
+
+	// the original operations are not modified
+	assert.Equal(t, "my password is hunter2", comment.Message)
+	assert.Equal(t, "my password is *******", edit.Message)
+
+	// a new edit is visible again
+	NewEditCommentOp(rene, unix, comment.Id(), "nothing to see", nil).Apply(&snapshot)
+	assert.Equal(t, "nothing to see", snapshot.Comments[1].Message)
+	assert.False(t, snapshot.Comments[1].Redacted)
+	assert.False(t, item.Redacted)
+	require.Len(t, item.History, 3)
+	assert.True(t, item.History[1].Redacted)
+	assert.False(t, item.History[2].Redacted)
+}
+
+func TestRedactCommentSerialize(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repo)
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+	before := NewRedactCommentOp(rene, unix, "target")
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after RedactCommentOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the ID
+	before.Id()
+
+	// Replace the identity stub with the real thing
+	assert.Equal(t, rene.Id(), after.base().Author.Id())
+	after.Author = rene
+
+	assert.Equal(t, before, &after)
+}
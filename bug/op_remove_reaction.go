@@ -0,0 +1,104 @@
+package bug
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+var _ Operation = &RemoveReactionOperation{}
+
+// RemoveReactionOperation will remove an emoji reaction from a comment in the bug
+type RemoveReactionOperation struct {
+	OpBase
+	Target   entity.Id `json:"target"`
+	Reaction Reaction  `json:"reaction"`
+}
+
+// Sign-post method for gqlgen
+func (op *RemoveReactionOperation) IsOperation() {}
+
+func (op *RemoveReactionOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *RemoveReactionOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *RemoveReactionOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.Author)
+
+	snapshot.updateReactions(op.Target, func(groups []ReactionGroup) []ReactionGroup {
+		return removeReaction(groups, op.Reaction, op.Author)
+	})
+}
+
+func (op *RemoveReactionOperation) Validate() error {
+	if err := opBaseValidate(op, RemoveReactionOp); err != nil {
+		return err
+	}
+
+	if err := op.Target.Validate(); err != nil {
+		return errors.Wrap(err, "target hash is invalid")
+	}
+
+	if err := op.Reaction.Validate(); err != nil {
+		return errors.Wrap(err, "reaction")
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *RemoveReactionOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Target   entity.Id `json:"target"`
+		Reaction Reaction  `json:"reaction"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Target = aux.Target
+	op.Reaction = aux.Reaction
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *RemoveReactionOperation) IsAuthored() {}
+
+func NewRemoveReactionOp(author identity.Interface, unixTime int64, target entity.Id, reaction Reaction) *RemoveReactionOperation {
+	return &RemoveReactionOperation{
+		OpBase:   newOpBase(RemoveReactionOp, author, unixTime),
+		Target:   target,
+		Reaction: reaction,
+	}
+}
+
+// Convenience function to apply the operation
+func RemoveReaction(b Interface, author identity.Interface, unixTime int64, target entity.Id, reaction Reaction) (*RemoveReactionOperation, error) {
+	removeReactionOp := NewRemoveReactionOp(author, unixTime, target, reaction)
+	if err := removeReactionOp.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(removeReactionOp)
+	return removeReactionOp, nil
+}
@@ -0,0 +1,227 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+var _ Operation = &SetAssigneesOperation{}
+
+// SetAssigneesOperation define a Bug operation to assign or unassign people
+type SetAssigneesOperation struct {
+	OpBase
+	Added   []identity.Interface `json:"added"`
+	Removed []identity.Interface `json:"removed"`
+}
+
+// Sign-post method for gqlgen
+func (op *SetAssigneesOperation) IsOperation() {}
+
+func (op *SetAssigneesOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *SetAssigneesOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+// Apply apply the operation
+func (op *SetAssigneesOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.Author)
+
+	// Add in the set
+AddLoop:
+	for _, added := range op.Added {
+		for _, assignee := range snapshot.Assignees {
+			if assignee.Id() == added.Id() {
+				// Already exist
+				continue AddLoop
+			}
+		}
+
+		snapshot.Assignees = append(snapshot.Assignees, added)
+	}
+
+	// Remove in the set
+	for _, removed := range op.Removed {
+		for i, assignee := range snapshot.Assignees {
+			if assignee.Id() == removed.Id() {
+				snapshot.Assignees = append(snapshot.Assignees[:i], snapshot.Assignees[i+1:]...)
+				break
+			}
+		}
+	}
+
+	item := &SetAssigneesTimelineItem{
+		id:       op.Id(),
+		Author:   op.Author,
+		UnixTime: timestamp.Timestamp(op.UnixTime),
+		Added:    op.Added,
+		Removed:  op.Removed,
+	}
+
+	snapshot.Timeline = append(snapshot.Timeline, item)
+}
+
+func (op *SetAssigneesOperation) Validate() error {
+	if err := opBaseValidate(op, SetAssigneesOp); err != nil {
+		return err
+	}
+
+	for _, i := range op.Added {
+		if i == nil {
+			return fmt.Errorf("nil added assignee")
+		}
+		if err := i.Validate(); err != nil {
+			return errors.Wrap(err, "added assignee")
+		}
+	}
+
+	for _, i := range op.Removed {
+		if i == nil {
+			return fmt.Errorf("nil removed assignee")
+		}
+		if err := i.Validate(); err != nil {
+			return errors.Wrap(err, "removed assignee")
+		}
+	}
+
+	if len(op.Added)+len(op.Removed) <= 0 {
+		return fmt.Errorf("no assignee change")
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *SetAssigneesOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Added   []json.RawMessage `json:"added"`
+		Removed []json.RawMessage `json:"removed"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	// delegate the decoding of the identities
+	added := make([]identity.Interface, len(aux.Added))
+	for i, raw := range aux.Added {
+		added[i], err = identity.UnmarshalJSON(raw)
+		if err != nil {
+			return err
+		}
+	}
+
+	removed := make([]identity.Interface, len(aux.Removed))
+	for i, raw := range aux.Removed {
+		removed[i], err = identity.UnmarshalJSON(raw)
+		if err != nil {
+			return err
+		}
+	}
+
+	op.OpBase = base
+	op.Added = added
+	op.Removed = removed
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *SetAssigneesOperation) IsAuthored() {}
+
+func NewSetAssigneesOperation(author identity.Interface, unixTime int64, added, removed []identity.Interface) *SetAssigneesOperation {
+	return &SetAssigneesOperation{
+		OpBase:  newOpBase(SetAssigneesOp, author, unixTime),
+		Added:   added,
+		Removed: removed,
+	}
+}
+
+type SetAssigneesTimelineItem struct {
+	id       entity.Id
+	Author   identity.Interface
+	UnixTime timestamp.Timestamp
+	Added    []identity.Interface
+	Removed  []identity.Interface
+}
+
+func (s SetAssigneesTimelineItem) Id() entity.Id {
+	return s.id
+}
+
+// Sign post method for gqlgen
+func (s *SetAssigneesTimelineItem) IsAuthored() {}
+
+// ChangeAssignees is a convenience function to apply the operation. People
+// already assigned, or not assigned when removing, are ignored.
+func ChangeAssignees(b Interface, author identity.Interface, unixTime int64, add, remove []identity.Interface) (*SetAssigneesOperation, error) {
+	var added, removed []identity.Interface
+
+	snap := b.Compile()
+
+	for _, i := range add {
+		if identityExist(added, i) || snap.IsAssigned(i.Id()) {
+			continue
+		}
+		added = append(added, i)
+	}
+
+	for _, i := range remove {
+		if identityExist(removed, i) || !snap.IsAssigned(i.Id()) {
+			continue
+		}
+		removed = append(removed, i)
+	}
+
+	if len(added) == 0 && len(removed) == 0 {
+		return nil, fmt.Errorf("no assignee added or removed")
+	}
+
+	return ForceChangeAssignees(b, author, unixTime, added, removed)
+}
+
+// ForceChangeAssignees is a convenience function to apply the operation
+// The difference with ChangeAssignees is that no checks of deduplications are done. You are entirely
+// responsible of what you are doing. In the general case, you want to use ChangeAssignees instead.
+// The intended use of this function is to allow importers to create legal but unexpected assignee changes,
+// like unassigning someone with no information of when it was assigned before.
+func ForceChangeAssignees(b Interface, author identity.Interface, unixTime int64, add, remove []identity.Interface) (*SetAssigneesOperation, error) {
+	op := NewSetAssigneesOperation(author, unixTime, add, remove)
+
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+
+	b.Append(op)
+
+	return op, nil
+}
+
+func identityExist(identities []identity.Interface, i identity.Interface) bool {
+	for _, other := range identities {
+		if other.Id() == i.Id() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSetAssigneesSerialize(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repo)
+	require.NoError(t, err)
+	isaac := identity.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	err = isaac.Commit(repo)
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+	before := NewSetAssigneesOperation(rene, unix, []identity.Interface{isaac}, []identity.Interface{rene})
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after SetAssigneesOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the ID
+	before.Id()
+
+	// Replace the identity stubs with the real thing
+	assert.Equal(t, rene.Id(), after.base().Author.Id())
+	after.Author = rene
+	require.Len(t, after.Added, 1)
+	assert.Equal(t, isaac.Id(), after.Added[0].Id())
+	after.Added[0] = isaac
+	require.Len(t, after.Removed, 1)
+	assert.Equal(t, rene.Id(), after.Removed[0].Id())
+	after.Removed[0] = rene
+
+	assert.Equal(t, before, &after)
+}
+
+func TestChangeAssignees(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+	isaac := identity.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, isaac.Commit(repo))
+
+	unix := time.Now().Unix()
+
+	b := NewBug()
+	create := NewCreateOp(rene, unix, "title", "message", nil)
+	b.Append(create)
+
+	op, err := ChangeAssignees(b, rene, unix, []identity.Interface{rene, isaac, rene}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []identity.Interface{rene, isaac}, op.Added)
+
+	// already assigned or not assigned are ignored
+	op, err = ChangeAssignees(b, rene, unix, []identity.Interface{isaac}, []identity.Interface{rene})
+	require.NoError(t, err)
+	require.Empty(t, op.Added)
+	require.Equal(t, []identity.Interface{rene}, op.Removed)
+
+	_, err = ChangeAssignees(b, rene, unix, []identity.Interface{isaac}, []identity.Interface{rene})
+	require.Error(t, err)
+
+	snap := b.Compile()
+	require.Equal(t, []identity.Interface{isaac}, snap.Assignees)
+	require.True(t, snap.IsAssigned(isaac.Id()))
+	require.False(t, snap.IsAssigned(rene.Id()))
+	require.IsType(t, &SetAssigneesTimelineItem{}, snap.Timeline[len(snap.Timeline)-1])
+}
@@ -0,0 +1,198 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+var _ Operation = &SetLinksOperation{}
+
+// SetLinksOperation define a Bug operation to add or remove typed links to other bugs
+type SetLinksOperation struct {
+	OpBase
+	Added   []Link `json:"added"`
+	Removed []Link `json:"removed"`
+}
+
+// Sign-post method for gqlgen
+func (op *SetLinksOperation) IsOperation() {}
+
+func (op *SetLinksOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *SetLinksOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+// Apply apply the operation
+func (op *SetLinksOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.Author)
+
+	// Add in the set
+AddLoop:
+	for _, added := range op.Added {
+		for _, link := range snapshot.Links {
+			if link == added {
+				// Already exist
+				continue AddLoop
+			}
+		}
+
+		snapshot.Links = append(snapshot.Links, added)
+	}
+
+	// Remove in the set
+	for _, removed := range op.Removed {
+		for i, link := range snapshot.Links {
+			if link == removed {
+				snapshot.Links = append(snapshot.Links[:i], snapshot.Links[i+1:]...)
+				break
+			}
+		}
+	}
+
+	item := &SetLinksTimelineItem{
+		id:       op.Id(),
+		Author:   op.Author,
+		UnixTime: timestamp.Timestamp(op.UnixTime),
+		Added:    op.Added,
+		Removed:  op.Removed,
+	}
+
+	snapshot.Timeline = append(snapshot.Timeline, item)
+}
+
+func (op *SetLinksOperation) Validate() error {
+	if err := opBaseValidate(op, SetLinksOp); err != nil {
+		return err
+	}
+
+	for _, link := range op.Added {
+		if err := link.Validate(); err != nil {
+			return errors.Wrap(err, "added link")
+		}
+	}
+
+	for _, link := range op.Removed {
+		if err := link.Validate(); err != nil {
+			return errors.Wrap(err, "removed link")
+		}
+	}
+
+	if len(op.Added)+len(op.Removed) <= 0 {
+		return fmt.Errorf("no link change")
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *SetLinksOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Added   []Link `json:"added"`
+		Removed []Link `json:"removed"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Added = aux.Added
+	op.Removed = aux.Removed
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *SetLinksOperation) IsAuthored() {}
+
+func NewSetLinksOperation(author identity.Interface, unixTime int64, added, removed []Link) *SetLinksOperation {
+	return &SetLinksOperation{
+		OpBase:  newOpBase(SetLinksOp, author, unixTime),
+		Added:   added,
+		Removed: removed,
+	}
+}
+
+type SetLinksTimelineItem struct {
+	id       entity.Id
+	Author   identity.Interface
+	UnixTime timestamp.Timestamp
+	Added    []Link
+	Removed  []Link
+}
+
+func (s SetLinksTimelineItem) Id() entity.Id {
+	return s.id
+}
+
+// Sign post method for gqlgen
+func (s *SetLinksTimelineItem) IsAuthored() {}
+
+// ChangeLinks is a convenience function to apply the operation. Links
+// already present, or not present when removing, are ignored.
+func ChangeLinks(b Interface, author identity.Interface, unixTime int64, add, remove []Link) (*SetLinksOperation, error) {
+	var added, removed []Link
+
+	snap := b.Compile()
+
+	for _, link := range add {
+		if link.Target == snap.Id() {
+			return nil, fmt.Errorf("a bug can't be linked to itself")
+		}
+		if linkExist(added, link) || snap.HasLink(link) {
+			continue
+		}
+		added = append(added, link)
+	}
+
+	for _, link := range remove {
+		if linkExist(removed, link) || !snap.HasLink(link) {
+			continue
+		}
+		removed = append(removed, link)
+	}
+
+	if len(added) == 0 && len(removed) == 0 {
+		return nil, fmt.Errorf("no link added or removed")
+	}
+
+	op := NewSetLinksOperation(author, unixTime, added, removed)
+
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+
+	b.Append(op)
+
+	return op, nil
+}
+
+func linkExist(links []Link, link Link) bool {
+	for _, other := range links {
+		if other == link {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSetLinksSerialize(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repo)
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+	before := NewSetLinksOperation(rene, unix,
+		[]Link{{Type: LinkBlocks, Target: entity.Id("1234567890123456789012345678901234567890123456789012345678901234")}},
+		[]Link{{Type: LinkRelatesTo, Target: entity.Id("abcdef7890123456789012345678901234567890123456789012345678901234")}},
+	)
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after SetLinksOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the ID
+	before.Id()
+
+	// Replace the identity stub with the real thing
+	assert.Equal(t, rene.Id(), after.base().Author.Id())
+	after.Author = rene
+
+	assert.Equal(t, before, &after)
+}
+
+func TestChangeLinks(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	unix := time.Now().Unix()
+
+	b := NewBug()
+	create := NewCreateOp(rene, unix, "title", "message", nil)
+	b.Append(create)
+
+	other := entity.Id("1234567890123456789012345678901234567890123456789012345678901234")
+	blocks := Link{Type: LinkBlocks, Target: other}
+	relates := Link{Type: LinkRelatesTo, Target: other}
+
+	op, err := ChangeLinks(b, rene, unix, []Link{blocks, relates, blocks}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []Link{blocks, relates}, op.Added)
+
+	// already present or not present are ignored
+	op, err = ChangeLinks(b, rene, unix, []Link{blocks}, []Link{relates})
+	require.NoError(t, err)
+	require.Empty(t, op.Added)
+	require.Equal(t, []Link{relates}, op.Removed)
+
+	_, err = ChangeLinks(b, rene, unix, []Link{blocks}, []Link{relates})
+	require.Error(t, err)
+
+	_, err = ChangeLinks(b, rene, unix, []Link{{Type: "foo", Target: other}}, nil)
+	require.Error(t, err)
+
+	snap := b.Compile()
+	require.Equal(t, []Link{blocks}, snap.Links)
+	require.True(t, snap.HasLink(blocks))
+	require.False(t, snap.HasLink(relates))
+	require.IsType(t, &SetLinksTimelineItem{}, snap.Timeline[len(snap.Timeline)-1])
+}
+
+func TestLinkTypeInverse(t *testing.T) {
+	for _, linkType := range LinkTypes {
+		require.NoError(t, linkType.Inverse().Validate())
+		require.Equal(t, linkType, linkType.Inverse().Inverse())
+	}
+
+	require.Equal(t, LinkBlockedBy, LinkBlocks.Inverse())
+	require.Equal(t, LinkRelatesTo, LinkRelatesTo.Inverse())
+
+	linkType, err := LinkTypeFromString(" Duplicate-Of ")
+	require.NoError(t, err)
+	require.Equal(t, LinkDuplicateOf, linkType)
+
+	_, err = LinkTypeFromString("foo")
+	require.Error(t, err)
+}
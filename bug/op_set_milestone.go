@@ -0,0 +1,149 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+var _ Operation = &SetMilestoneOperation{}
+
+// SetMilestoneOperation will attach a bug to a milestone, or detach it if the
+// milestone id is empty
+type SetMilestoneOperation struct {
+	OpBase
+	Milestone entity.Id `json:"milestone"`
+	Was       entity.Id `json:"was"`
+}
+
+// Sign-post method for gqlgen
+func (op *SetMilestoneOperation) IsOperation() {}
+
+func (op *SetMilestoneOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *SetMilestoneOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *SetMilestoneOperation) Apply(snapshot *Snapshot) {
+	snapshot.Milestone = op.Milestone
+	snapshot.addActor(op.Author)
+
+	item := &SetMilestoneTimelineItem{
+		id:        op.Id(),
+		Author:    op.Author,
+		UnixTime:  timestamp.Timestamp(op.UnixTime),
+		Milestone: op.Milestone,
+		Was:       op.Was,
+	}
+
+	snapshot.Timeline = append(snapshot.Timeline, item)
+}
+
+func (op *SetMilestoneOperation) Validate() error {
+	if err := opBaseValidate(op, SetMilestoneOp); err != nil {
+		return err
+	}
+
+	if op.Milestone != "" {
+		if err := op.Milestone.Validate(); err != nil {
+			return errors.Wrap(err, "milestone")
+		}
+	}
+
+	if op.Was != "" {
+		if err := op.Was.Validate(); err != nil {
+			return errors.Wrap(err, "was")
+		}
+	}
+
+	if op.Milestone == op.Was {
+		return fmt.Errorf("milestone is unchanged")
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *SetMilestoneOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Milestone entity.Id `json:"milestone"`
+		Was       entity.Id `json:"was"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Milestone = aux.Milestone
+	op.Was = aux.Was
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *SetMilestoneOperation) IsAuthored() {}
+
+func NewSetMilestoneOp(author identity.Interface, unixTime int64, milestone entity.Id, was entity.Id) *SetMilestoneOperation {
+	return &SetMilestoneOperation{
+		OpBase:    newOpBase(SetMilestoneOp, author, unixTime),
+		Milestone: milestone,
+		Was:       was,
+	}
+}
+
+type SetMilestoneTimelineItem struct {
+	id        entity.Id
+	Author    identity.Interface
+	UnixTime  timestamp.Timestamp
+	Milestone entity.Id
+	Was       entity.Id
+}
+
+func (s SetMilestoneTimelineItem) Id() entity.Id {
+	return s.id
+}
+
+// Sign post method for gqlgen
+func (s *SetMilestoneTimelineItem) IsAuthored() {}
+
+// Convenience function to apply the operation. An empty milestone id detach
+// the bug from its current milestone.
+func SetMilestone(b Interface, author identity.Interface, unixTime int64, milestone entity.Id) (*SetMilestoneOperation, error) {
+	it := NewOperationIterator(b)
+
+	var was entity.Id
+	for it.Next() {
+		if op, ok := it.Value().(*SetMilestoneOperation); ok {
+			was = op.Milestone
+		}
+	}
+
+	setMilestoneOp := NewSetMilestoneOp(author, unixTime, milestone, was)
+
+	if err := setMilestoneOp.Validate(); err != nil {
+		return nil, err
+	}
+
+	b.Append(setMilestoneOp)
+	return setMilestoneOp, nil
+}
@@ -0,0 +1,74 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSetMilestoneSerialize(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repo)
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+	before := NewSetMilestoneOp(rene, unix, entity.Id("1234567890123456789012345678901234567890123456789012345678901234"), "")
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after SetMilestoneOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the ID
+	before.Id()
+
+	// Replace the identity stub with the real thing
+	assert.Equal(t, rene.Id(), after.base().Author.Id())
+	after.Author = rene
+
+	assert.Equal(t, before, &after)
+}
+
+func TestSetMilestone(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	unix := time.Now().Unix()
+	m1 := entity.Id("1111111111111111111111111111111111111111111111111111111111111111")
+	m2 := entity.Id("2222222222222222222222222222222222222222222222222222222222222222")
+
+	b := NewBug()
+	b.Append(NewCreateOp(rene, unix, "title", "message", nil))
+
+	op, err := SetMilestone(b, rene, unix, m1)
+	require.NoError(t, err)
+	require.Empty(t, op.Was)
+
+	_, err = SetMilestone(b, rene, unix, m1)
+	require.Error(t, err)
+
+	op, err = SetMilestone(b, rene, unix, m2)
+	require.NoError(t, err)
+	require.Equal(t, m1, op.Was)
+
+	snap := b.Compile()
+	require.Equal(t, m2, snap.Milestone)
+	require.IsType(t, &SetMilestoneTimelineItem{}, snap.Timeline[len(snap.Timeline)-1])
+
+	_, err = SetMilestone(b, rene, unix, "")
+	require.NoError(t, err)
+
+	snap = b.Compile()
+	require.Empty(t, snap.Milestone)
+}
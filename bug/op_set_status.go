@@ -2,6 +2,7 @@ package bug
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/pkg/errors"
 
@@ -16,6 +17,10 @@ var _ Operation = &SetStatusOperation{}
 type SetStatusOperation struct {
 	OpBase
 	Status Status `json:"status"`
+	// name of the workflow status, empty for the built-in open and closed
+	WorkflowStatus string `json:"workflow_status,omitempty"`
+	// reason the bug was closed for, only for a closed status
+	Resolution Resolution `json:"resolution,omitempty"`
 }
 
 // Sign-post method for gqlgen
@@ -31,13 +36,17 @@ func (op *SetStatusOperation) Id() entity.Id {
 
 func (op *SetStatusOperation) Apply(snapshot *Snapshot) {
 	snapshot.Status = op.Status
+	snapshot.WorkflowStatus = op.WorkflowStatus
+	snapshot.Resolution = op.Resolution
 	snapshot.addActor(op.Author)
 
 	item := &SetStatusTimelineItem{
-		id:       op.Id(),
-		Author:   op.Author,
-		UnixTime: timestamp.Timestamp(op.UnixTime),
-		Status:   op.Status,
+		id:             op.Id(),
+		Author:         op.Author,
+		UnixTime:       timestamp.Timestamp(op.UnixTime),
+		Status:         op.Status,
+		WorkflowStatus: op.WorkflowStatus,
+		Resolution:     op.Resolution,
 	}
 
 	snapshot.Timeline = append(snapshot.Timeline, item)
@@ -52,6 +61,24 @@ func (op *SetStatusOperation) Validate() error {
 		return errors.Wrap(err, "status")
 	}
 
+	if op.WorkflowStatus != "" {
+		if err := ValidateWorkflowStatusName(op.WorkflowStatus); err != nil {
+			return errors.Wrap(err, "workflow status")
+		}
+		if op.WorkflowStatus == OpenStatus.String() || op.WorkflowStatus == ClosedStatus.String() {
+			return fmt.Errorf("workflow status can't be a built-in status")
+		}
+	}
+
+	if op.Resolution != "" {
+		if op.Status != ClosedStatus {
+			return fmt.Errorf("only a closed bug can have a resolution")
+		}
+		if err := op.Resolution.Validate(); err != nil {
+			return errors.Wrap(err, "resolution")
+		}
+	}
+
 	return nil
 }
 
@@ -68,7 +95,9 @@ func (op *SetStatusOperation) UnmarshalJSON(data []byte) error {
 	}
 
 	aux := struct {
-		Status Status `json:"status"`
+		Status         Status     `json:"status"`
+		WorkflowStatus string     `json:"workflow_status"`
+		Resolution     Resolution `json:"resolution"`
 	}{}
 
 	err = json.Unmarshal(data, &aux)
@@ -78,10 +107,20 @@ func (op *SetStatusOperation) UnmarshalJSON(data []byte) error {
 
 	op.OpBase = base
 	op.Status = aux.Status
+	op.WorkflowStatus = aux.WorkflowStatus
+	op.Resolution = aux.Resolution
 
 	return nil
 }
 
+// StatusName return the name of the status the bug moved to
+func (op *SetStatusOperation) StatusName() string {
+	if op.WorkflowStatus != "" {
+		return op.WorkflowStatus
+	}
+	return op.Status.String()
+}
+
 // Sign post method for gqlgen
 func (op *SetStatusOperation) IsAuthored() {}
 
@@ -93,10 +132,20 @@ func NewSetStatusOp(author identity.Interface, unixTime int64, status Status) *S
 }
 
 type SetStatusTimelineItem struct {
-	id       entity.Id
-	Author   identity.Interface
-	UnixTime timestamp.Timestamp
-	Status   Status
+	id             entity.Id
+	Author         identity.Interface
+	UnixTime       timestamp.Timestamp
+	Status         Status
+	WorkflowStatus string
+	Resolution     Resolution
+}
+
+// StatusName return the name of the status the bug moved to
+func (s SetStatusTimelineItem) StatusName() string {
+	if s.WorkflowStatus != "" {
+		return s.WorkflowStatus
+	}
+	return s.Status.String()
 }
 
 func (s SetStatusTimelineItem) Id() entity.Id {
@@ -125,3 +174,31 @@ func Close(b Interface, author identity.Interface, unixTime int64) (*SetStatusOp
 	b.Append(op)
 	return op, nil
 }
+
+// Convenience function to apply the operation, closing the bug with a
+// resolution
+func CloseWithResolution(b Interface, author identity.Interface, unixTime int64, resolution Resolution) (*SetStatusOperation, error) {
+	op := NewSetStatusOp(author, unixTime, ClosedStatus)
+	op.Resolution = resolution
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
+
+// Convenience function to apply the operation, moving the bug to the given
+// workflow status. The resolution is optional, and only valid for a status in
+// the closed category.
+func SetWorkflowStatus(b Interface, author identity.Interface, unixTime int64, status WorkflowStatus, resolution Resolution) (*SetStatusOperation, error) {
+	op := NewSetStatusOp(author, unixTime, status.Category)
+	if status.Name != status.Category.String() {
+		op.WorkflowStatus = status.Name
+	}
+	op.Resolution = resolution
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(op)
+	return op, nil
+}
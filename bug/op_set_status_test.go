@@ -37,4 +37,64 @@ func TestSetStatusSerialize(t *testing.T) {
 	after.Author = rene
 
 	assert.Equal(t, before, &after)
+
+	before = NewSetStatusOp(rene, unix, OpenStatus)
+	before.WorkflowStatus = "in-progress"
+
+	data, err = json.Marshal(before)
+	assert.NoError(t, err)
+
+	after = SetStatusOperation{}
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	before.Id()
+	after.Author = rene
+
+	assert.Equal(t, before, &after)
+	assert.Equal(t, "in-progress", after.StatusName())
+
+	before = NewSetStatusOp(rene, unix, ClosedStatus)
+	before.Resolution = ResolutionWontFix
+
+	data, err = json.Marshal(before)
+	assert.NoError(t, err)
+
+	after = SetStatusOperation{}
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	before.Id()
+	after.Author = rene
+
+	assert.Equal(t, before, &after)
+}
+
+func TestSetStatusResolution(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repo)
+	require.NoError(t, err)
+
+	unix := time.Now().Unix()
+
+	op := NewSetStatusOp(rene, unix, ClosedStatus)
+	op.Resolution = ResolutionDuplicate
+	require.NoError(t, op.Validate())
+
+	op.Resolution = "nope"
+	require.Error(t, op.Validate())
+
+	op = NewSetStatusOp(rene, unix, OpenStatus)
+	op.Resolution = ResolutionFixed
+	require.Error(t, op.Validate())
+
+	snap := &Snapshot{}
+	op = NewSetStatusOp(rene, unix, ClosedStatus)
+	op.Resolution = ResolutionInvalid
+	op.Apply(snap)
+	require.Equal(t, ResolutionInvalid, snap.Resolution)
+
+	NewSetStatusOp(rene, unix, OpenStatus).Apply(snap)
+	require.Equal(t, Resolution(""), snap.Resolution)
 }
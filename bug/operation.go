@@ -26,6 +26,12 @@ const (
 	EditCommentOp
 	NoOpOp
 	SetMetadataOp
+	SetAssigneesOp
+	SetMilestoneOp
+	SetLinksOp
+	AddReactionOp
+	RemoveReactionOp
+	RedactCommentOp
 )
 
 // Operation define the interface to fulfill for an edit operation of a Bug
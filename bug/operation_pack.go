@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/lamport"
 )
 
 // 1: original format
@@ -24,6 +25,7 @@ type OperationPack struct {
 
 	// Private field so not serialized
 	commitHash repository.Hash
+	editTime   lamport.Time
 }
 
 func (opp *OperationPack) MarshalJSON() ([]byte, error) {
@@ -96,10 +98,34 @@ func (opp *OperationPack) unmarshalOp(raw []byte, _type OperationType) (Operatio
 		op := &NoOpOperation{}
 		err := json.Unmarshal(raw, &op)
 		return op, err
+	case AddReactionOp:
+		op := &AddReactionOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
+	case RemoveReactionOp:
+		op := &RemoveReactionOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
+	case RedactCommentOp:
+		op := &RedactCommentOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
+	case SetAssigneesOp:
+		op := &SetAssigneesOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
+	case SetLinksOp:
+		op := &SetLinksOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
 	case SetMetadataOp:
 		op := &SetMetadataOperation{}
 		err := json.Unmarshal(raw, &op)
 		return op, err
+	case SetMilestoneOp:
+		op := &SetMilestoneOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
 	case SetStatusOp:
 		op := &SetStatusOperation{}
 		err := json.Unmarshal(raw, &op)
@@ -177,6 +203,7 @@ func (opp *OperationPack) Clone() OperationPack {
 	clone := OperationPack{
 		Operations: make([]Operation, len(opp.Operations)),
 		commitHash: opp.commitHash,
+		editTime:   opp.editTime,
 	}
 
 	for i, op := range opp.Operations {
@@ -0,0 +1,346 @@
+package bug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// the remotes a purged bug still has to be force pushed to
+const purgeConfigKeyPattern = "git-bug.purge.%s.remotes"
+
+// PurgeRedactedComments rewrite the git history of a local bug to physically
+// remove the messages and files of its redacted comments. The bug keep its id,
+// but as the commits are different, the bug has to be force pushed to the
+// remotes and the old git objects pruned (git gc) for the data to be actually
+// gone. The remote-tracking refs of the bug are removed so they don't keep
+// the old history alive, and the bug is recorded to be force pushed by the
+// next Push to each remote. Until then, the remote history is not merged back.
+//
+// As the ids of the operations are derived from their content, the purged
+// operations, and the operations targeting them, get a new id.
+//
+// As the id of the bug is the hash of its first commit, the operations of that
+// commit, including the create operation, are never rewritten. The content of
+// the redacted comments it holds is kept.
+//
+// PurgeRedactedComments return the number of comments purged, and the number
+// of redacted comments whose content was kept in the first commit.
+func PurgeRedactedComments(repo repository.ClockedRepo, id entity.Id) (int, int, error) {
+	ref := bugsRefPattern + id.String()
+
+	b, err := ReadLocal(repo, id)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// for each redacted comment, the position of its last redaction. Edits
+	// made after that are visible and are kept.
+	redacted := make(map[entity.Id]int)
+	position := 0
+	for _, pack := range b.packs {
+		for _, op := range pack.Operations {
+			if op, ok := op.(*RedactCommentOperation); ok {
+				redacted[op.Target] = position
+			}
+			position++
+		}
+	}
+
+	if len(redacted) == 0 {
+		return 0, 0, fmt.Errorf("the bug has no redacted comment")
+	}
+
+	// rewrite the operations, remapping the targets to the new ids
+	remap := make(map[entity.Id]entity.Id)
+	purged := make(map[entity.Id]struct{})
+	kept := make(map[entity.Id]struct{})
+	firstChanged := -1
+	position = 0
+
+	for i, pack := range b.packs {
+		for _, op := range pack.Operations {
+			oldId := op.Id()
+
+			// the comment this operation hold a version of, if any
+			comment := oldId
+			if edit, ok := op.(*EditCommentOperation); ok {
+				comment = edit.Target
+			}
+
+			// the first commit can't change without changing the bug id
+			if i == 0 {
+				if redaction, ok := redacted[comment]; ok && position < redaction && hasMessage(op) {
+					kept[comment] = struct{}{}
+				}
+				position++
+				continue
+			}
+
+			changed := remapTarget(op, remap)
+
+			if redaction, ok := redacted[comment]; ok && position < redaction && purgeMessage(op) {
+				purged[comment] = struct{}{}
+				changed = true
+			}
+
+			position++
+
+			if !changed {
+				continue
+			}
+
+			op.base().id = entity.UnsetId
+			if newId := op.Id(); newId != oldId {
+				remap[oldId] = newId
+			}
+			if firstChanged < 0 {
+				firstChanged = i
+			}
+		}
+	}
+
+	if firstChanged < 0 && len(kept) > 0 {
+		return 0, len(kept), fmt.Errorf("the redacted content is in the first commit of the bug, it can't be purged without changing the bug id")
+	}
+	if firstChanged < 0 {
+		return 0, 0, fmt.Errorf("the redacted comments are already purged")
+	}
+
+	hashes, err := repo.ListCommits(ref)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(hashes) != len(b.packs) {
+		return 0, 0, fmt.Errorf("unexpected number of commits")
+	}
+
+	parent := hashes[firstChanged-1]
+	rootPack := b.rootPack
+
+	for i := firstChanged; i < len(b.packs); i++ {
+		pack := b.packs[i]
+
+		opsHash, err := pack.Write(repo)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		entries, err := repo.ReadTree(hashes[i])
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "can't list git tree entries")
+		}
+
+		tree := []repository.TreeEntry{
+			{ObjectType: repository.Blob, Hash: opsHash, Name: opsEntryName},
+			{ObjectType: repository.Blob, Hash: rootPack, Name: rootEntryName},
+		}
+
+		mediaTree := makeMediaTree(pack)
+		if len(mediaTree) > 0 {
+			mediaTreeHash, err := repo.StoreTree(mediaTree)
+			if err != nil {
+				return 0, 0, err
+			}
+			tree = append(tree, repository.TreeEntry{
+				ObjectType: repository.Tree,
+				Hash:       mediaTreeHash,
+				Name:       mediaEntryName,
+			})
+		}
+
+		// keep the clock entries as is
+		for _, entry := range entries {
+			switch entry.Name {
+			case opsEntryName, rootEntryName, mediaEntryName:
+				continue
+			}
+			tree = append(tree, entry)
+		}
+
+		treeHash, err := repo.StoreTree(tree)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		parent, err = repo.StoreCommitWithParent(treeHash, parent)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	err = repo.UpdateRef(ref, parent)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// drop the remote-tracking refs, they still reference the old history
+	remotes, err := repo.GetRemotes()
+	if err != nil {
+		return 0, 0, err
+	}
+	names := make([]string, 0, len(remotes))
+	for remote := range remotes {
+		names = append(names, remote)
+		remoteRefs, err := repo.ListRefs(fmt.Sprintf(bugsRemoteRefPattern, remote) + id.String())
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, remoteRef := range remoteRefs {
+			err = repo.RemoveRef(remoteRef)
+			if err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+
+	if len(names) > 0 {
+		err = repo.LocalConfig().StoreString(fmt.Sprintf(purgeConfigKeyPattern, id), strings.Join(names, ","))
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return len(purged), len(kept), nil
+}
+
+// pendingForcePush return the purged bugs that still have to be force pushed
+// to the given remote
+func pendingForcePush(repo repository.RepoConfig, remote string) (map[entity.Id]struct{}, error) {
+	configs, err := repo.LocalConfig().ReadAll("git-bug.purge.")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[entity.Id]struct{})
+	for key, value := range configs {
+		id := strings.TrimSuffix(strings.TrimPrefix(key, "git-bug.purge."), ".remotes")
+		for _, r := range strings.Split(value, ",") {
+			if r == remote {
+				result[entity.Id(id)] = struct{}{}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// forcePushPurged force push the purged bugs to a remote, replacing their old
+// history, and forget about this remote for them
+func forcePushPurged(repo repository.Repo, remote string) (string, error) {
+	pending, err := pendingForcePush(repo, remote)
+	if err != nil {
+		return "", err
+	}
+
+	var stdout string
+	for id := range pending {
+		ref := bugsRefPattern + id.String()
+
+		exist, err := repo.RefExist(ref)
+		if err != nil {
+			return stdout, err
+		}
+		if exist {
+			out, err := repo.PushRefs(remote, fmt.Sprintf("+%s:%s", ref, ref))
+			stdout += out
+			if err != nil {
+				return stdout, errors.Wrapf(err, "can't force push the purged bug %s", id.Human())
+			}
+		}
+
+		key := fmt.Sprintf(purgeConfigKeyPattern, id)
+		value, err := repo.LocalConfig().ReadString(key)
+		if err != nil {
+			return stdout, err
+		}
+		var remaining []string
+		for _, r := range strings.Split(value, ",") {
+			if r != remote {
+				remaining = append(remaining, r)
+			}
+		}
+		if len(remaining) > 0 {
+			err = repo.LocalConfig().StoreString(key, strings.Join(remaining, ","))
+		} else {
+			err = repo.LocalConfig().RemoveAll(key)
+		}
+		if err != nil {
+			return stdout, err
+		}
+	}
+
+	return stdout, nil
+}
+
+// remapTarget update the target of the operation if it has been given a new id,
+// and return true if it did
+func remapTarget(op Operation, remap map[entity.Id]entity.Id) bool {
+	var target *entity.Id
+
+	switch op := op.(type) {
+	case *EditCommentOperation:
+		target = &op.Target
+	case *SetMetadataOperation:
+		target = &op.Target
+	case *AddReactionOperation:
+		target = &op.Target
+	case *RemoveReactionOperation:
+		target = &op.Target
+	case *RedactCommentOperation:
+		target = &op.Target
+	default:
+		return false
+	}
+
+	newId, ok := remap[*target]
+	if !ok {
+		return false
+	}
+	*target = newId
+	return true
+}
+
+// hasMessage tell if an operation hold a message or files
+func hasMessage(op Operation) bool {
+	switch op := op.(type) {
+	case *CreateOperation:
+		return op.Message != "" || len(op.Files) > 0
+	case *AddCommentOperation:
+		return op.Message != "" || len(op.Files) > 0
+	case *EditCommentOperation:
+		return op.Message != "" || len(op.Files) > 0
+	default:
+		return false
+	}
+}
+
+// purgeMessage remove the message and files of an operation, and return true
+// if there was anything to remove
+func purgeMessage(op Operation) bool {
+	var message *string
+	var files *[]repository.Hash
+
+	switch op := op.(type) {
+	case *CreateOperation:
+		message, files = &op.Message, &op.Files
+	case *AddCommentOperation:
+		message, files = &op.Message, &op.Files
+	case *EditCommentOperation:
+		message, files = &op.Message, &op.Files
+	default:
+		return false
+	}
+
+	if *message == "" && len(*files) == 0 {
+		return false
+	}
+
+	*message = ""
+	*files = nil
+	return true
+}
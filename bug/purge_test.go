@@ -0,0 +1,197 @@
+package bug
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestPurgeRedactedComments(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	unix := time.Now().Unix()
+
+	b, _, err := Create(rene, unix, "title", "create")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	secret, err := AddComment(b, rene, unix, "my password is hunter2")
+	require.NoError(t, err)
+	_, err = AddComment(b, rene, unix, "another comment")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	_, err = EditComment(b, rene, unix, secret.Id(), "my password is still hunter2")
+	require.NoError(t, err)
+	_, err = AddReaction(b, rene, unix, secret.Id(), ReactionEyes)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	// nothing to purge yet
+	_, _, err = PurgeRedactedComments(repo, b.Id())
+	require.Error(t, err)
+
+	_, err = RedactComment(b, rene, unix, secret.Id())
+	require.NoError(t, err)
+	_, err = EditComment(b, rene, unix, secret.Id(), "sorry about that")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	purged, kept, err := PurgeRedactedComments(repo, b.Id())
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	assert.Equal(t, 0, kept)
+
+	after, err := ReadLocal(repo, b.Id())
+	require.NoError(t, err)
+	require.NoError(t, after.Validate())
+
+	for _, pack := range after.packs {
+		for _, op := range pack.Operations {
+			switch op := op.(type) {
+			case *AddCommentOperation:
+				assert.NotContains(t, op.Message, "hunter2")
+			case *EditCommentOperation:
+				assert.NotContains(t, op.Message, "hunter2")
+			}
+		}
+	}
+
+	snap := after.Compile()
+	require.Len(t, snap.Comments, 3)
+	assert.Equal(t, "create", snap.Comments[0].Message)
+	assert.Equal(t, "sorry about that", snap.Comments[1].Message)
+	assert.Equal(t, "another comment", snap.Comments[2].Message)
+
+	// the operations targeting the comment follow its new id
+	assert.NotEqual(t, secret.Id(), snap.Comments[1].Id())
+	require.Len(t, snap.Comments[1].Reactions, 1)
+	assert.Equal(t, ReactionEyes, snap.Comments[1].Reactions[0].Reaction)
+	item := snap.Timeline[1].(*AddCommentTimelineItem)
+	require.Len(t, item.History, 3)
+	assert.True(t, item.History[1].Redacted)
+
+	// already purged
+	_, _, err = PurgeRedactedComments(repo, b.Id())
+	require.Error(t, err)
+}
+
+func TestPurgeRedactedCommentsFirstCommit(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	unix := time.Now().Unix()
+
+	b, create, err := Create(rene, unix, "title", "my password is hunter2")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	_, err = RedactComment(b, rene, unix, create.Id())
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	// the create operation can't be purged without changing the bug id
+	_, kept, err := PurgeRedactedComments(repo, b.Id())
+	require.Error(t, err)
+	assert.Equal(t, 1, kept)
+
+	_, err = EditComment(b, rene, unix, create.Id(), "my password is still hunter2")
+	require.NoError(t, err)
+	_, err = RedactComment(b, rene, unix+1, create.Id())
+	require.NoError(t, err)
+	_, err = AddComment(b, rene, unix, "another comment")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repo))
+
+	// the later edits are purged
+	purged, kept, err := PurgeRedactedComments(repo, b.Id())
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	assert.Equal(t, 1, kept)
+
+	after, err := ReadLocal(repo, b.Id())
+	require.NoError(t, err)
+	require.NoError(t, after.Validate())
+	assert.Equal(t, b.Id(), after.Id())
+	assert.Equal(t, create.Id(), after.FirstOp().Id())
+
+	for _, pack := range after.packs[1:] {
+		for _, op := range pack.Operations {
+			if op, ok := op.(*EditCommentOperation); ok {
+				assert.NotContains(t, op.Message, "hunter2")
+			}
+		}
+	}
+
+	snap := after.Compile()
+	require.Len(t, snap.Comments, 2)
+	assert.True(t, snap.Comments[0].Redacted)
+	assert.Equal(t, "another comment", snap.Comments[1].Message)
+
+	// the bug can still be edited
+	_, err = AddComment(after, rene, unix, "one more")
+	require.NoError(t, err)
+	require.NoError(t, after.Commit(repo))
+}
+
+func TestPurgeRedactedCommentsForcePush(t *testing.T) {
+	repoA, repoB, remote := repository.SetupReposAndRemote()
+	defer repository.CleanupTestRepos(repoA, repoB, remote)
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repoA))
+
+	unix := time.Now().Unix()
+
+	b, _, err := Create(rene, unix, "title", "create")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repoA))
+	secret, err := AddComment(b, rene, unix, "my password is hunter2")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repoA))
+
+	_, err = Push(repoA, "origin")
+	require.NoError(t, err)
+
+	_, err = RedactComment(b, rene, unix, secret.Id())
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(repoA))
+
+	_, _, err = PurgeRedactedComments(repoA, b.Id())
+	require.NoError(t, err)
+
+	// pulling before pushing doesn't bring back the remote history
+	require.NoError(t, Pull(repoA, "origin"))
+	after, err := ReadLocal(repoA, b.Id())
+	require.NoError(t, err)
+	assert.Empty(t, after.Compile().Comments[1].Message)
+
+	// the rewritten history replace the remote one
+	_, err = Push(repoA, "origin")
+	require.NoError(t, err)
+
+	local, err := repoA.ListCommits(bugsRefPattern + b.Id().String())
+	require.NoError(t, err)
+	pushed, err := remote.ListCommits(bugsRefPattern + b.Id().String())
+	require.NoError(t, err)
+	assert.Equal(t, local, pushed)
+
+	_, err = repoA.LocalConfig().ReadString(fmt.Sprintf(purgeConfigKeyPattern, b.Id()))
+	assert.Equal(t, repository.ErrNoConfigEntry, err)
+
+	// the next pull and push are regular ones
+	require.NoError(t, Pull(repoA, "origin"))
+	_, err = Push(repoA, "origin")
+	require.NoError(t, err)
+}
@@ -0,0 +1,116 @@
+package bug
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// Reaction is an emoji reaction to a comment, stored as a short name
+// such as "+1", "heart" or "rocket".
+type Reaction string
+
+// The reactions supported by both GitHub and GitLab
+const (
+	ReactionThumbsUp   Reaction = "+1"
+	ReactionThumbsDown Reaction = "-1"
+	ReactionLaugh      Reaction = "laugh"
+	ReactionHooray     Reaction = "hooray"
+	ReactionConfused   Reaction = "confused"
+	ReactionHeart      Reaction = "heart"
+	ReactionRocket     Reaction = "rocket"
+	ReactionEyes       Reaction = "eyes"
+)
+
+// maximum length of a reaction name
+const maxReactionLength = 64
+
+func (r Reaction) String() string {
+	return string(r)
+}
+
+func (r Reaction) Validate() error {
+	str := string(r)
+
+	if text.Empty(str) {
+		return fmt.Errorf("empty")
+	}
+
+	if len(str) > maxReactionLength {
+		return fmt.Errorf("too long")
+	}
+
+	if strings.IndexFunc(str, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("reaction contains spaces")
+	}
+
+	if !text.Safe(str) {
+		return fmt.Errorf("not fully printable")
+	}
+
+	return nil
+}
+
+// ReactionGroup aggregate the people who reacted to a comment with the same reaction
+type ReactionGroup struct {
+	Reaction Reaction
+	Users    []identity.Interface
+}
+
+// HasReacted return true if the given identity is part of the group
+func (g ReactionGroup) HasReacted(author identity.Interface) bool {
+	for _, user := range g.Users {
+		if user.Id() == author.Id() {
+			return true
+		}
+	}
+	return false
+}
+
+// addReaction return the reaction groups with the author added to the group of
+// the given reaction, creating it if needed
+func addReaction(groups []ReactionGroup, reaction Reaction, author identity.Interface) []ReactionGroup {
+	for i := range groups {
+		if groups[i].Reaction != reaction {
+			continue
+		}
+		if !groups[i].HasReacted(author) {
+			groups[i].Users = append(groups[i].Users, author)
+		}
+		return groups
+	}
+
+	return append(groups, ReactionGroup{
+		Reaction: reaction,
+		Users:    []identity.Interface{author},
+	})
+}
+
+// removeReaction return the reaction groups with the author removed from the
+// group of the given reaction, dropping the group if it became empty
+func removeReaction(groups []ReactionGroup, reaction Reaction, author identity.Interface) []ReactionGroup {
+	for i := range groups {
+		if groups[i].Reaction != reaction {
+			continue
+		}
+
+		users := make([]identity.Interface, 0, len(groups[i].Users))
+		for _, user := range groups[i].Users {
+			if user.Id() != author.Id() {
+				users = append(users, user)
+			}
+		}
+
+		if len(users) == 0 {
+			return append(groups[:i:i], groups[i+1:]...)
+		}
+
+		groups[i].Users = users
+		return groups
+	}
+
+	return groups
+}
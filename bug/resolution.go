@@ -0,0 +1,63 @@
+package bug
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Resolution is the reason a bug was closed for
+type Resolution string
+
+const (
+	ResolutionFixed     Resolution = "fixed"
+	ResolutionWontFix   Resolution = "wontfix"
+	ResolutionDuplicate Resolution = "duplicate"
+	ResolutionInvalid   Resolution = "invalid"
+)
+
+// Resolutions is the list of all the valid resolutions
+var Resolutions = []Resolution{
+	ResolutionFixed,
+	ResolutionWontFix,
+	ResolutionDuplicate,
+	ResolutionInvalid,
+}
+
+func ResolutionFromString(str string) (Resolution, error) {
+	cleaned := strings.ToLower(strings.TrimSpace(str))
+	cleaned = strings.Replace(cleaned, "'", "", -1)
+	cleaned = strings.Replace(cleaned, "-", "", -1)
+	cleaned = strings.Replace(cleaned, " ", "", -1)
+
+	for _, r := range Resolutions {
+		if Resolution(cleaned) == r {
+			return r, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown resolution %s", str)
+}
+
+func (r Resolution) String() string {
+	return string(r)
+}
+
+// Description return a human readable description of the resolution
+func (r Resolution) Description() string {
+	switch r {
+	case ResolutionWontFix:
+		return "won't fix"
+	default:
+		return string(r)
+	}
+}
+
+func (r Resolution) Validate() error {
+	for _, valid := range Resolutions {
+		if r == valid {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid resolution")
+}
@@ -12,10 +12,19 @@ import (
 type Snapshot struct {
 	id entity.Id
 
-	Status       Status
-	Title        string
-	Comments     []Comment
-	Labels       []Label
+	Status Status
+	// name of the workflow status, empty for the built-in open and closed
+	WorkflowStatus string
+	// reason the bug was closed for, if any
+	Resolution Resolution
+	Title      string
+	Comments   []Comment
+	Labels     []Label
+	Assignees  []identity.Interface
+	// id of the milestone the bug is attached to, if any
+	Milestone entity.Id
+	// typed links from this bug to other bugs
+	Links        []Link
 	Author       identity.Interface
 	Actors       []identity.Interface
 	Participants []identity.Interface
@@ -31,6 +40,14 @@ func (snap *Snapshot) Id() entity.Id {
 	return snap.id
 }
 
+// StatusName return the name of the workflow status of the bug
+func (snap *Snapshot) StatusName() string {
+	if snap.WorkflowStatus != "" {
+		return snap.WorkflowStatus
+	}
+	return snap.Status.String()
+}
+
 // Return the last time a bug was modified
 func (snap *Snapshot) EditTime() time.Time {
 	if len(snap.Operations) == 0 {
@@ -129,5 +146,49 @@ func (snap *Snapshot) HasAnyActor(ids ...entity.Id) bool {
 	return false
 }
 
+// IsAssigned return true if the id is an assignee
+func (snap *Snapshot) IsAssigned(id entity.Id) bool {
+	for _, a := range snap.Assignees {
+		if a.Id() == id {
+			return true
+		}
+	}
+	return false
+}
+
+// updateReactions apply a change to the reactions of the targeted comment,
+// both in the comments and in the timeline
+func (snap *Snapshot) updateReactions(target entity.Id, f func([]ReactionGroup) []ReactionGroup) {
+	for i := range snap.Comments {
+		if snap.Comments[i].id == target {
+			snap.Comments[i].Reactions = f(snap.Comments[i].Reactions)
+			break
+		}
+	}
+
+	for _, item := range snap.Timeline {
+		if item.Id() != target {
+			continue
+		}
+		switch item := item.(type) {
+		case *CreateTimelineItem:
+			item.Reactions = f(item.Reactions)
+		case *AddCommentTimelineItem:
+			item.Reactions = f(item.Reactions)
+		}
+		break
+	}
+}
+
+// HasLink return true if the bug has the given link
+func (snap *Snapshot) HasLink(link Link) bool {
+	for _, l := range snap.Links {
+		if l == link {
+			return true
+		}
+	}
+	return false
+}
+
 // Sign post method for gqlgen
 func (snap *Snapshot) IsAuthored() {}
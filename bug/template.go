@@ -0,0 +1,259 @@
+package bug
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const templateConfigKeyPrefix = "git-bug.template"
+
+// the directory, relative to the root of the working tree, holding the
+// template files
+const templateDir = ".git-bug/templates"
+
+const templateFileExt = ".md"
+
+// a template name need to be a valid git config key
+var templateNameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// Template pre-fill the title and message of a new bug, and give it some
+// default labels. Templates are read from the markdown files in the
+// .git-bug/templates directory of the working tree, and from the repository
+// config.
+//
+// A template file can start with a header holding the other fields:
+//
+//	---
+//	description: Report a crash
+//	title: "[crash] "
+//	labels: crash, needs-triage
+//	---
+//	What happened?
+//
+// In the config, the fields are stored as:
+//
+//	git config git-bug.template.crash.title "[crash] "
+//	git config git-bug.template.crash.message "What happened?"
+//	git config git-bug.template.crash.labels "crash, needs-triage"
+//	git config git-bug.template.crash.description "Report a crash"
+type Template struct {
+	Name        string
+	Description string
+	Title       string
+	Message     string
+	Labels      []Label
+}
+
+// TemplateRepo is the part of a repository needed to read the templates
+type TemplateRepo interface {
+	repository.RepoCommon
+	repository.RepoConfig
+}
+
+// Templates return the bug templates of the repository, sorted by name. The
+// fields defined in the config override the ones of the template files.
+func Templates(repo TemplateRepo) ([]Template, error) {
+	templates := make(map[string]*Template)
+
+	getOrAdd := func(name string) *Template {
+		if t, ok := templates[name]; ok {
+			return t
+		}
+		t := &Template{Name: name}
+		templates[name] = t
+		return t
+	}
+
+	dir, ok := templatesPath(repo)
+	if ok {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, errors.Wrap(err, "can't read the template directory")
+		}
+
+		for _, file := range files {
+			if file.IsDir() || filepath.Ext(file.Name()) != templateFileExt {
+				continue
+			}
+
+			name := strings.ToLower(strings.TrimSuffix(file.Name(), templateFileExt))
+			if !templateNameRegex.MatchString(name) {
+				continue
+			}
+
+			data, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+			if err != nil {
+				return nil, errors.Wrap(err, "can't read template")
+			}
+
+			err = parseTemplateFile(getOrAdd(name), string(data))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid template file %s", file.Name())
+			}
+		}
+	}
+
+	configs, err := repo.AnyConfig().ReadAll(templateConfigKeyPrefix + ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "can't read templates")
+	}
+
+	for key, value := range configs {
+		trimmed := strings.TrimPrefix(key, templateConfigKeyPrefix+".")
+		i := strings.LastIndex(trimmed, ".")
+		if i < 0 {
+			continue
+		}
+		name, field := strings.ToLower(trimmed[:i]), trimmed[i+1:]
+
+		if !templateNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid template name \"%s\"", name)
+		}
+
+		err = setTemplateField(getOrAdd(name), field, value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid template \"%s\"", name)
+		}
+	}
+
+	result := make([]Template, 0, len(templates))
+	for _, t := range templates {
+		result = append(result, *t)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// LoadTemplate return the template with the given name
+func LoadTemplate(repo TemplateRepo, name string) (Template, error) {
+	templates, err := Templates(repo)
+	if err != nil {
+		return Template{}, err
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	names := make([]string, len(templates))
+	for i, t := range templates {
+		if t.Name == name {
+			return t, nil
+		}
+		names[i] = t.Name
+	}
+
+	if len(names) == 0 {
+		return Template{}, fmt.Errorf("unknown template \"%s\", no template is defined", name)
+	}
+	return Template{}, fmt.Errorf("unknown template \"%s\", valid templates are: %s", name, strings.Join(names, ", "))
+}
+
+// templatesPath return the path of the template directory, if the repository
+// has a working tree
+func templatesPath(repo repository.RepoCommon) (string, bool) {
+	gitPath := repo.GetPath()
+	if filepath.Base(gitPath) != ".git" {
+		// bare repository
+		return "", false
+	}
+	return filepath.Join(filepath.Dir(gitPath), filepath.FromSlash(templateDir)), true
+}
+
+// parseTemplateFile fill the template with the content of a template file
+func parseTemplateFile(t *Template, raw string) error {
+	raw = strings.Replace(raw, "\r\n", "\n", -1)
+
+	lines := strings.Split(raw, "\n")
+
+	if strings.TrimSpace(lines[0]) != "---" {
+		t.Message = strings.TrimSpace(raw)
+		return nil
+	}
+
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "---" {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return fmt.Errorf("unterminated header")
+	}
+
+	for _, line := range lines[1:end] {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		i := strings.Index(line, ":")
+		if i < 0 {
+			return fmt.Errorf("invalid header line \"%s\"", line)
+		}
+		field := strings.ToLower(strings.TrimSpace(line[:i]))
+		value := unquoteTemplateValue(strings.TrimSpace(line[i+1:]))
+		if err := setTemplateField(t, field, value); err != nil {
+			return err
+		}
+	}
+
+	body := strings.Join(lines[end+1:], "\n")
+	t.Message = strings.TrimSpace(body)
+
+	return nil
+}
+
+// unquoteTemplateValue remove the quotes around a header value, if any, to
+// allow leading or trailing spaces
+func unquoteTemplateValue(value string) string {
+	if len(value) >= 2 {
+		first, last := value[0], value[len(value)-1]
+		if (first == '"' || first == '\'') && first == last {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
+
+func setTemplateField(t *Template, field string, value string) error {
+	switch field {
+	case "description":
+		t.Description = value
+	case "title":
+		t.Title = value
+	case "message", "body":
+		t.Message = value
+	case "labels":
+		t.Labels = nil
+		for _, str := range strings.Split(value, ",") {
+			label := Label(strings.TrimSpace(str))
+			if label == "" {
+				continue
+			}
+			if err := label.Validate(); err != nil {
+				return errors.Wrapf(err, "invalid label \"%s\"", label)
+			}
+			t.Labels = append(t.Labels, label)
+		}
+	}
+	return nil
+}
+
+// LabelNames return the default labels of the template as strings
+func (t Template) LabelNames() []string {
+	result := make([]string, len(t.Labels))
+	for i, label := range t.Labels {
+		result[i] = label.String()
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package bug
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestTemplates(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	templates, err := Templates(repo)
+	require.NoError(t, err)
+	require.Empty(t, templates)
+
+	dir := filepath.Join(filepath.Dir(repo.GetPath()), ".git-bug", "templates")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+
+	crash := `---
+description: Report a crash
+title: "[crash] "
+labels: crash, needs-triage
+---
+What happened?
+
+Stack trace:
+`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "crash.md"), []byte(crash), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "feature.md"), []byte("What do you need?\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README.txt"), []byte("not a template"), 0644))
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.template.feature.labels", "feature"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.template.question.title", "Question:"))
+
+	templates, err = Templates(repo)
+	require.NoError(t, err)
+	require.Equal(t, []Template{
+		{
+			Name:        "crash",
+			Description: "Report a crash",
+			Title:       "[crash] ",
+			Message:     "What happened?\n\nStack trace:",
+			Labels:      []Label{"crash", "needs-triage"},
+		},
+		{
+			Name:    "feature",
+			Message: "What do you need?",
+			Labels:  []Label{"feature"},
+		},
+		{
+			Name:  "question",
+			Title: "Question:",
+		},
+	}, templates)
+
+	template, err := LoadTemplate(repo, "Crash")
+	require.NoError(t, err)
+	require.Equal(t, "crash", template.Name)
+	require.Equal(t, []string{"crash", "needs-triage"}, template.LabelNames())
+
+	_, err = LoadTemplate(repo, "unknown")
+	require.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "broken.md"), []byte("---\ntitle: broken\n"), 0644))
+	_, err = Templates(repo)
+	require.Error(t, err)
+}
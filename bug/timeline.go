@@ -22,6 +22,8 @@ type CommentHistoryStep struct {
 	// The new message
 	Message  string
 	UnixTime timestamp.Timestamp
+	// Redacted is true if the message has been hidden by a redaction
+	Redacted bool
 }
 
 // CommentTimelineItem is a TimelineItem that holds a Comment and its edition history
@@ -33,6 +35,9 @@ type CommentTimelineItem struct {
 	CreatedAt timestamp.Timestamp
 	LastEdit  timestamp.Timestamp
 	History   []CommentHistoryStep
+	Reactions []ReactionGroup
+	// Redacted is true if the current message has been hidden by a redaction
+	Redacted bool
 }
 
 func NewCommentTimelineItem(ID entity.Id, comment Comment) CommentTimelineItem {
@@ -61,6 +66,7 @@ func (c *CommentTimelineItem) Append(comment Comment) {
 	c.Message = comment.Message
 	c.Files = comment.Files
 	c.LastEdit = comment.UnixTime
+	c.Redacted = false
 	c.History = append(c.History, CommentHistoryStep{
 		Author:   comment.Author,
 		Message:  comment.Message,
@@ -68,6 +74,17 @@ func (c *CommentTimelineItem) Append(comment Comment) {
 	})
 }
 
+// redact hide the current message and the full history of the comment
+func (c *CommentTimelineItem) redact() {
+	c.Message = ""
+	c.Files = nil
+	c.Redacted = true
+	for i := range c.History {
+		c.History[i].Message = ""
+		c.History[i].Redacted = true
+	}
+}
+
 // Edited say if the comment was edited
 func (c *CommentTimelineItem) Edited() bool {
 	return len(c.History) > 1
@@ -19,6 +19,12 @@ func (b *WithSnapshot) Snapshot() *Snapshot {
 	return b.snap
 }
 
+// ClearSnapshot drop the current snapshot, to be compiled again on the next
+// access. This is needed when an operation already applied is modified.
+func (b *WithSnapshot) ClearSnapshot() {
+	b.snap = nil
+}
+
 // Append intercept Bug.Append() to update the snapshot efficiently
 func (b *WithSnapshot) Append(op Operation) {
 	b.Bug.Append(op)
@@ -0,0 +1,182 @@
+package bug
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const workflowConfigKeyPrefix = "git-bug.status"
+
+// a workflow status name need to be a valid git config key
+var workflowStatusNameRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// WorkflowStatus is a named status of a bug, like "triage" or "in-progress",
+// defined in the repository config. Each status belong to the open or closed
+// category, which is what the bridges and the open/closed filters use.
+//
+// Ex:
+//
+//	git config git-bug.status.in-progress.category open
+//	git config git-bug.status.in-progress.transitions "blocked,closed"
+type WorkflowStatus struct {
+	Name     string
+	Category Status
+	// the statuses a bug can move to from this one, any if empty
+	Transitions []string
+}
+
+// Workflow is the set of statuses a bug can have in a repository. The
+// built-in "open" and "closed" statuses are always part of it.
+type Workflow struct {
+	Statuses []WorkflowStatus
+}
+
+// DefaultWorkflow return the workflow with only the built-in statuses
+func DefaultWorkflow() *Workflow {
+	return &Workflow{
+		Statuses: []WorkflowStatus{
+			{Name: OpenStatus.String(), Category: OpenStatus},
+			{Name: ClosedStatus.String(), Category: ClosedStatus},
+		},
+	}
+}
+
+// LoadWorkflow read the workflow statuses defined in the repository config.
+// The local config override the global one for a given status.
+func LoadWorkflow(repo repository.RepoConfig) (*Workflow, error) {
+	configs, err := repo.AnyConfig().ReadAll(workflowConfigKeyPrefix + ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "can't read workflow statuses")
+	}
+
+	workflow := DefaultWorkflow()
+
+	for key, value := range configs {
+		trimmed := strings.TrimPrefix(key, workflowConfigKeyPrefix+".")
+		i := strings.LastIndex(trimmed, ".")
+		if i < 0 {
+			continue
+		}
+		name, field := strings.ToLower(trimmed[:i]), trimmed[i+1:]
+
+		if !workflowStatusNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid workflow status name \"%s\"", name)
+		}
+
+		status := workflow.getOrAdd(name)
+
+		switch field {
+		case "category":
+			category, err := StatusFromString(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid category \"%s\" for workflow status \"%s\"", value, name)
+			}
+			if (name == OpenStatus.String() || name == ClosedStatus.String()) && category.String() != name {
+				return nil, fmt.Errorf("the category of the \"%s\" status can't be changed", name)
+			}
+			status.Category = category
+		case "transitions":
+			status.Transitions = nil
+			for _, transition := range strings.Split(value, ",") {
+				transition = strings.ToLower(strings.TrimSpace(transition))
+				if transition != "" {
+					status.Transitions = append(status.Transitions, transition)
+				}
+			}
+		}
+	}
+
+	for _, status := range workflow.Statuses {
+		if err := status.Category.Validate(); err != nil {
+			return nil, fmt.Errorf("missing or invalid category for workflow status \"%s\"", status.Name)
+		}
+		for _, transition := range status.Transitions {
+			if _, ok := workflow.Get(transition); !ok {
+				return nil, fmt.Errorf("unknown status \"%s\" in the transitions of \"%s\"", transition, status.Name)
+			}
+		}
+	}
+
+	// built-in first, then by name
+	sort.SliceStable(workflow.Statuses[2:], func(i, j int) bool {
+		return workflow.Statuses[2+i].Name < workflow.Statuses[2+j].Name
+	})
+
+	return workflow, nil
+}
+
+func (w *Workflow) getOrAdd(name string) *WorkflowStatus {
+	for i := range w.Statuses {
+		if w.Statuses[i].Name == name {
+			return &w.Statuses[i]
+		}
+	}
+	w.Statuses = append(w.Statuses, WorkflowStatus{Name: name})
+	return &w.Statuses[len(w.Statuses)-1]
+}
+
+// Get return the status with the given name, if defined
+func (w *Workflow) Get(name string) (WorkflowStatus, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, status := range w.Statuses {
+		if status.Name == name {
+			return status, true
+		}
+	}
+	return WorkflowStatus{}, false
+}
+
+// Names return the names of all the statuses of the workflow
+func (w *Workflow) Names() []string {
+	result := make([]string, len(w.Statuses))
+	for i, status := range w.Statuses {
+		result[i] = status.Name
+	}
+	return result
+}
+
+// CheckTransition return an error if a bug can't move from a status to another
+func (w *Workflow) CheckTransition(from string, to string) error {
+	target, ok := w.Get(to)
+	if !ok {
+		return fmt.Errorf("unknown status \"%s\", valid statuses are: %s", to, strings.Join(w.Names(), ", "))
+	}
+
+	source, ok := w.Get(from)
+	if !ok {
+		// the status has been removed from the config, let the bug out
+		return nil
+	}
+
+	if source.Name == target.Name {
+		return fmt.Errorf("the bug is already %s", target.Name)
+	}
+
+	if len(source.Transitions) == 0 {
+		return nil
+	}
+
+	for _, transition := range source.Transitions {
+		if transition == target.Name {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("a bug can't go from %s to %s, allowed transitions are: %s",
+		source.Name, target.Name, strings.Join(source.Transitions, ", "))
+}
+
+// ValidateWorkflowStatusName return an error if the given name can't be used
+// as a workflow status
+func ValidateWorkflowStatusName(name string) error {
+	if !workflowStatusNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid status name \"%s\": only lowercase letters, digits and dashes are allowed, starting with a letter", name)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLoadWorkflow(t *testing.T) {
+	repo := repository.NewMockRepoConfig()
+
+	workflow, err := LoadWorkflow(repo)
+	require.NoError(t, err)
+	require.Equal(t, []string{"open", "closed"}, workflow.Names())
+
+	require.NoError(t, repo.GlobalConfig().StoreString("git-bug.status.wontfix.category", "closed"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.status.triage.category", "open"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.status.triage.transitions", "open,wontfix"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.status.closed.transitions", "triage"))
+
+	workflow, err = LoadWorkflow(repo)
+	require.NoError(t, err)
+	require.Equal(t, []string{"open", "closed", "triage", "wontfix"}, workflow.Names())
+
+	triage, ok := workflow.Get("Triage")
+	require.True(t, ok)
+	require.Equal(t, WorkflowStatus{
+		Name:        "triage",
+		Category:    OpenStatus,
+		Transitions: []string{"open", "wontfix"},
+	}, triage)
+
+	require.NoError(t, workflow.CheckTransition("open", "triage"))
+	require.NoError(t, workflow.CheckTransition("triage", "wontfix"))
+	require.NoError(t, workflow.CheckTransition("closed", "triage"))
+	require.Error(t, workflow.CheckTransition("triage", "closed"))
+	require.Error(t, workflow.CheckTransition("closed", "open"))
+	require.Error(t, workflow.CheckTransition("open", "open"))
+	require.Error(t, workflow.CheckTransition("open", "unknown"))
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.status.blocked.transitions", "open"))
+	_, err = LoadWorkflow(repo)
+	require.Error(t, err)
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.status.blocked.category", "open"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.status.blocked.transitions", "nope"))
+	_, err = LoadWorkflow(repo)
+	require.Error(t, err)
+	require.NoError(t, repo.LocalConfig().RemoveAll("git-bug.status.blocked"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.status.open.category", "closed"))
+	_, err = LoadWorkflow(repo)
+	require.Error(t, err)
+}
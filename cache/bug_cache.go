@@ -8,6 +8,7 @@ import (
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/lamport"
 )
 
 var ErrNoMatchingOp = fmt.Errorf("no matching operation found")
@@ -36,6 +37,27 @@ func (c *BugCache) Snapshot() *bug.Snapshot {
 	return c.bug.Snapshot()
 }
 
+// OperationsEditTime return the edit Lamport time of the commit holding each
+// operation of the snapshot, 0 for the ones not committed yet
+func (c *BugCache) OperationsEditTime() []lamport.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.bug.OperationsEditTime()
+}
+
+// TimelineIds return the ids of the items currently in the timeline
+func (c *BugCache) TimelineIds() []entity.Id {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	timeline := c.bug.Snapshot().Timeline
+	result := make([]entity.Id, len(timeline))
+	for i, item := range timeline {
+		result[i] = item.Id()
+	}
+	return result
+}
+
 func (c *BugCache) Id() entity.Id {
 	return c.bug.Id()
 }
@@ -44,6 +66,21 @@ func (c *BugCache) notifyUpdated() error {
 	return c.repoCache.bugUpdated(c.bug.Id())
 }
 
+// setOpMetadata set the metadata of an operation that has just been appended.
+// As the id of the operation change with its metadata, the snapshot is
+// recompiled to not refer to the previous one.
+func (c *BugCache) setOpMetadata(op bug.Operation, metadata map[string]string) {
+	if len(metadata) == 0 {
+		return
+	}
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	c.bug.ClearSnapshot()
+}
+
 // ResolveOperationWithMetadata will find an operation that has the matching metadata
 func (c *BugCache) ResolveOperationWithMetadata(key string, value string) (entity.Id, error) {
 	c.mu.RLock()
@@ -71,6 +108,55 @@ func (c *BugCache) ResolveOperationWithMetadata(key string, value string) (entit
 	return matching[0], nil
 }
 
+// ResolveCommentPrefix will find the comment matching the given id prefix
+func (c *BugCache) ResolveCommentPrefix(prefix string) (entity.Id, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var matching []entity.Id
+
+	for _, comment := range c.bug.Compile().Comments {
+		if comment.Id().HasPrefix(prefix) {
+			matching = append(matching, comment.Id())
+		}
+	}
+
+	if len(matching) == 0 {
+		return "", ErrNoMatchingOp
+	}
+
+	if len(matching) > 1 {
+		return "", bug.NewErrMultipleMatchOp(matching)
+	}
+
+	return matching[0], nil
+}
+
+// ResolveOperationPrefix find an operation of the bug matching an id prefix
+func (c *BugCache) ResolveOperationPrefix(prefix string) (entity.Id, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var matching []entity.Id
+
+	it := bug.NewOperationIterator(c.bug)
+	for it.Next() {
+		if id := it.Value().Id(); id.HasPrefix(prefix) {
+			matching = append(matching, id)
+		}
+	}
+
+	if len(matching) == 0 {
+		return "", ErrNoMatchingOp
+	}
+
+	if len(matching) > 1 {
+		return "", bug.NewErrMultipleMatchOp(matching)
+	}
+
+	return matching[0], nil
+}
+
 func (c *BugCache) AddComment(message string) (*bug.AddCommentOperation, error) {
 	return c.AddCommentWithFiles(message, nil)
 }
@@ -92,9 +178,7 @@ func (c *BugCache) AddCommentRaw(author *IdentityCache, unixTime int64, message
 		return nil, err
 	}
 
-	for key, value := range metadata {
-		op.SetMetadata(key, value)
-	}
+	c.setOpMetadata(op, metadata)
 
 	c.mu.Unlock()
 
@@ -118,9 +202,7 @@ func (c *BugCache) ChangeLabelsRaw(author *IdentityCache, unixTime int64, added
 		return changes, nil, err
 	}
 
-	for key, value := range metadata {
-		op.SetMetadata(key, value)
-	}
+	c.setOpMetadata(op, metadata)
 
 	c.mu.Unlock()
 
@@ -132,6 +214,95 @@ func (c *BugCache) ChangeLabelsRaw(author *IdentityCache, unixTime int64, added
 	return changes, op, nil
 }
 
+func (c *BugCache) ChangeAssignees(added []*IdentityCache, removed []*IdentityCache) (*bug.SetAssigneesOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ChangeAssigneesRaw(author, time.Now().Unix(), added, removed, nil)
+}
+
+func (c *BugCache) ChangeAssigneesRaw(author *IdentityCache, unixTime int64, added []*IdentityCache, removed []*IdentityCache, metadata map[string]string) (*bug.SetAssigneesOperation, error) {
+	c.mu.Lock()
+	op, err := bug.ChangeAssignees(c.bug, author.Identity, unixTime, identities(added), identities(removed))
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	c.setOpMetadata(op, metadata)
+
+	c.mu.Unlock()
+
+	return op, c.notifyUpdated()
+}
+
+func (c *BugCache) ForceChangeAssignees(added []*IdentityCache, removed []*IdentityCache) (*bug.SetAssigneesOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ForceChangeAssigneesRaw(author, time.Now().Unix(), added, removed, nil)
+}
+
+func (c *BugCache) ForceChangeAssigneesRaw(author *IdentityCache, unixTime int64, added []*IdentityCache, removed []*IdentityCache, metadata map[string]string) (*bug.SetAssigneesOperation, error) {
+	c.mu.Lock()
+	op, err := bug.ForceChangeAssignees(c.bug, author.Identity, unixTime, identities(added), identities(removed))
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	c.setOpMetadata(op, metadata)
+
+	c.mu.Unlock()
+
+	return op, c.notifyUpdated()
+}
+
+func (c *BugCache) ChangeLinks(added []bug.Link, removed []bug.Link) (*bug.SetLinksOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ChangeLinksRaw(author, time.Now().Unix(), added, removed, nil)
+}
+
+func (c *BugCache) ChangeLinksRaw(author *IdentityCache, unixTime int64, added []bug.Link, removed []bug.Link, metadata map[string]string) (*bug.SetLinksOperation, error) {
+	c.mu.Lock()
+	op, err := bug.ChangeLinks(c.bug, author.Identity, unixTime, added, removed)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	c.setOpMetadata(op, metadata)
+
+	c.mu.Unlock()
+
+	return op, c.notifyUpdated()
+}
+
+// AllLinks return the links of the bug to other bugs, including the ones
+// recorded on the other side of the relationship.
+func (c *BugCache) AllLinks() []bug.Link {
+	snap := c.Snapshot()
+
+	result := make([]bug.Link, len(snap.Links))
+	copy(result, snap.Links)
+
+	for _, link := range c.repoCache.LinksTo(c.Id()) {
+		if !snap.HasLink(link) {
+			result = append(result, link)
+		}
+	}
+
+	return result
+}
+
 func (c *BugCache) ForceChangeLabels(added []string, removed []string) (*bug.LabelChangeOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
@@ -149,9 +320,7 @@ func (c *BugCache) ForceChangeLabelsRaw(author *IdentityCache, unixTime int64, a
 		return nil, err
 	}
 
-	for key, value := range metadata {
-		op.SetMetadata(key, value)
-	}
+	c.setOpMetadata(op, metadata)
 
 	c.mu.Unlock()
 	err = c.notifyUpdated()
@@ -162,31 +331,51 @@ func (c *BugCache) ForceChangeLabelsRaw(author *IdentityCache, unixTime int64, a
 	return op, nil
 }
 
-func (c *BugCache) Open() (*bug.SetStatusOperation, error) {
+func (c *BugCache) SetMilestone(milestone *MilestoneCache) (*bug.SetMilestoneOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
 		return nil, err
 	}
 
-	return c.OpenRaw(author, time.Now().Unix(), nil)
+	return c.SetMilestoneRaw(author, time.Now().Unix(), milestone, nil)
 }
 
-func (c *BugCache) OpenRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.SetStatusOperation, error) {
+// SetMilestoneRaw attach the bug to the given milestone, or detach it if
+// milestone is nil
+func (c *BugCache) SetMilestoneRaw(author *IdentityCache, unixTime int64, milestone *MilestoneCache, metadata map[string]string) (*bug.SetMilestoneOperation, error) {
+	var id entity.Id
+	if milestone != nil {
+		id = milestone.Id()
+	}
+
 	c.mu.Lock()
-	op, err := bug.Open(c.bug, author.Identity, unixTime)
+	op, err := bug.SetMilestone(c.bug, author.Identity, unixTime, id)
 	if err != nil {
 		c.mu.Unlock()
 		return nil, err
 	}
 
-	for key, value := range metadata {
-		op.SetMetadata(key, value)
-	}
+	c.setOpMetadata(op, metadata)
 
 	c.mu.Unlock()
 	return op, c.notifyUpdated()
 }
 
+func (c *BugCache) Open() (*bug.SetStatusOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.OpenRaw(author, time.Now().Unix(), nil)
+}
+
+// OpenRaw open the bug, if allowed by the transitions defined in the
+// repository config
+func (c *BugCache) OpenRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.SetStatusOperation, error) {
+	return c.SetStatusRaw(author, unixTime, bug.OpenStatus.String(), "", metadata)
+}
+
 func (c *BugCache) Close() (*bug.SetStatusOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
@@ -196,18 +385,79 @@ func (c *BugCache) Close() (*bug.SetStatusOperation, error) {
 	return c.CloseRaw(author, time.Now().Unix(), nil)
 }
 
+// CloseRaw close the bug, if allowed by the transitions defined in the
+// repository config
 func (c *BugCache) CloseRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.SetStatusOperation, error) {
+	return c.SetStatusRaw(author, unixTime, bug.ClosedStatus.String(), "", metadata)
+}
+
+// SetStatus move the bug to the given workflow status, if allowed by the
+// transitions defined in the repository config. The resolution is optional.
+func (c *BugCache) SetStatus(name string, resolution bug.Resolution) (*bug.SetStatusOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetStatusRaw(author, time.Now().Unix(), name, resolution, nil)
+}
+
+func (c *BugCache) SetStatusRaw(author *IdentityCache, unixTime int64, name string, resolution bug.Resolution, metadata map[string]string) (*bug.SetStatusOperation, error) {
+	workflow, err := bug.LoadWorkflow(c.repoCache)
+	if err != nil {
+		return nil, err
+	}
+
 	c.mu.Lock()
-	op, err := bug.Close(c.bug, author.Identity, unixTime)
+	err = workflow.CheckTransition(c.bug.Snapshot().StatusName(), name)
 	if err != nil {
 		c.mu.Unlock()
 		return nil, err
 	}
 
-	for key, value := range metadata {
-		op.SetMetadata(key, value)
+	status, _ := workflow.Get(name)
+	op, err := bug.SetWorkflowStatus(c.bug, author.Identity, unixTime, status, resolution)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	c.setOpMetadata(op, metadata)
+
+	c.mu.Unlock()
+	return op, c.notifyUpdated()
+}
+
+// CloseWithResolution close the bug, recording the reason it was closed for
+func (c *BugCache) CloseWithResolution(resolution bug.Resolution) (*bug.SetStatusOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CloseWithResolutionRaw(author, time.Now().Unix(), resolution, nil)
+}
+
+// CloseWithResolutionRaw close the bug with a resolution, if allowed by the
+// transitions defined in the repository config
+func (c *BugCache) CloseWithResolutionRaw(author *IdentityCache, unixTime int64, resolution bug.Resolution, metadata map[string]string) (*bug.SetStatusOperation, error) {
+	return c.SetStatusRaw(author, unixTime, bug.ClosedStatus.String(), resolution, metadata)
+}
+
+// ImportStatusRaw open or close the bug to mirror a remote change. Unlike the
+// other status changes, it bypass the transitions defined in the repository
+// config: the remote has its own rules, and the bridges need to follow it.
+// It is meant for the importers only.
+func (c *BugCache) ImportStatusRaw(author *IdentityCache, unixTime int64, status bug.Status, resolution bug.Resolution, metadata map[string]string) (*bug.SetStatusOperation, error) {
+	c.mu.Lock()
+	op, err := bug.SetWorkflowStatus(c.bug, author.Identity, unixTime, bug.WorkflowStatus{Name: status.String(), Category: status}, resolution)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
 	}
 
+	c.setOpMetadata(op, metadata)
+
 	c.mu.Unlock()
 	return op, c.notifyUpdated()
 }
@@ -229,9 +479,7 @@ func (c *BugCache) SetTitleRaw(author *IdentityCache, unixTime int64, title stri
 		return nil, err
 	}
 
-	for key, value := range metadata {
-		op.SetMetadata(key, value)
-	}
+	c.setOpMetadata(op, metadata)
 
 	c.mu.Unlock()
 	return op, c.notifyUpdated()
@@ -254,9 +502,7 @@ func (c *BugCache) EditCreateCommentRaw(author *IdentityCache, unixTime int64, b
 		return nil, err
 	}
 
-	for key, value := range metadata {
-		op.SetMetadata(key, value)
-	}
+	c.setOpMetadata(op, metadata)
 
 	c.mu.Unlock()
 	return op, c.notifyUpdated()
@@ -279,10 +525,77 @@ func (c *BugCache) EditCommentRaw(author *IdentityCache, unixTime int64, target
 		return nil, err
 	}
 
-	for key, value := range metadata {
-		op.SetMetadata(key, value)
+	c.setOpMetadata(op, metadata)
+
+	c.mu.Unlock()
+	return op, c.notifyUpdated()
+}
+
+func (c *BugCache) AddReaction(target entity.Id, reaction bug.Reaction) (*bug.AddReactionOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.AddReactionRaw(author, time.Now().Unix(), target, reaction, nil)
+}
+
+func (c *BugCache) AddReactionRaw(author *IdentityCache, unixTime int64, target entity.Id, reaction bug.Reaction, metadata map[string]string) (*bug.AddReactionOperation, error) {
+	c.mu.Lock()
+	op, err := bug.AddReaction(c.bug, author.Identity, unixTime, target, reaction)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	c.setOpMetadata(op, metadata)
+
+	c.mu.Unlock()
+	return op, c.notifyUpdated()
+}
+
+func (c *BugCache) RemoveReaction(target entity.Id, reaction bug.Reaction) (*bug.RemoveReactionOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.RemoveReactionRaw(author, time.Now().Unix(), target, reaction, nil)
+}
+
+func (c *BugCache) RemoveReactionRaw(author *IdentityCache, unixTime int64, target entity.Id, reaction bug.Reaction, metadata map[string]string) (*bug.RemoveReactionOperation, error) {
+	c.mu.Lock()
+	op, err := bug.RemoveReaction(c.bug, author.Identity, unixTime, target, reaction)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
 	}
 
+	c.setOpMetadata(op, metadata)
+
+	c.mu.Unlock()
+	return op, c.notifyUpdated()
+}
+
+func (c *BugCache) RedactComment(target entity.Id) (*bug.RedactCommentOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.RedactCommentRaw(author, time.Now().Unix(), target, nil)
+}
+
+func (c *BugCache) RedactCommentRaw(author *IdentityCache, unixTime int64, target entity.Id, metadata map[string]string) (*bug.RedactCommentOperation, error) {
+	c.mu.Lock()
+	op, err := bug.RedactComment(c.bug, author.Identity, unixTime, target)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	c.setOpMetadata(op, metadata)
+
 	c.mu.Unlock()
 	return op, c.notifyUpdated()
 }
@@ -25,14 +25,20 @@ type BugExcerpt struct {
 	EditLamportTime   lamport.Time
 	CreateUnixTime    int64
 	EditUnixTime      int64
-
-	AuthorId     entity.Id
-	Status       bug.Status
-	Labels       []bug.Label
-	Title        string
-	LenComments  int
-	Actors       []entity.Id
-	Participants []entity.Id
+	CloseUnixTime     int64
+
+	AuthorId       entity.Id
+	Status         bug.Status
+	WorkflowStatus string
+	Resolution     bug.Resolution
+	Labels         []bug.Label
+	Title          string
+	LenComments    int
+	Actors         []entity.Id
+	Participants   []entity.Id
+	Assignees      []entity.Id
+	Milestone      entity.Id
+	Links          []bug.Link
 
 	CreateMetadata map[string]string
 }
@@ -71,6 +77,13 @@ func NewBugExcerpt(b bug.Interface, snap *bug.Snapshot) *BugExcerpt {
 		}
 	}
 
+	assigneesIds := make([]entity.Id, 0, len(snap.Assignees))
+	for _, assignee := range snap.Assignees {
+		if _, ok := assignee.(*identity.Identity); ok {
+			assigneesIds = append(assigneesIds, assignee.Id())
+		}
+	}
+
 	e := &BugExcerpt{
 		Id:                b.Id(),
 		CreateLamportTime: b.CreateLamportTime(),
@@ -78,12 +91,28 @@ func NewBugExcerpt(b bug.Interface, snap *bug.Snapshot) *BugExcerpt {
 		CreateUnixTime:    b.FirstOp().Time().Unix(),
 		EditUnixTime:      snap.EditTime().Unix(),
 		Status:            snap.Status,
+		WorkflowStatus:    snap.WorkflowStatus,
+		Resolution:        snap.Resolution,
 		Labels:            snap.Labels,
 		Actors:            actorsIds,
 		Participants:      participantsIds,
+		Assignees:         assigneesIds,
+		Milestone:         snap.Milestone,
+		Links:             snap.Links,
 		Title:             snap.Title,
 		LenComments:       len(snap.Comments),
-		CreateMetadata:    b.FirstOp().AllMetadata(),
+		// from the snapshot, as a redacted creation get its metadata on a copy
+		CreateMetadata: snap.Operations[0].AllMetadata(),
+	}
+
+	// a closed bug was closed by its last status change
+	if snap.Status == bug.ClosedStatus {
+		for i := len(snap.Operations) - 1; i >= 0; i-- {
+			if op, ok := snap.Operations[i].(*bug.SetStatusOperation); ok {
+				e.CloseUnixTime = op.Time().Unix()
+				break
+			}
+		}
 	}
 
 	switch snap.Author.(type) {
@@ -96,6 +125,14 @@ func NewBugExcerpt(b bug.Interface, snap *bug.Snapshot) *BugExcerpt {
 	return e
 }
 
+// StatusName return the name of the workflow status of the bug
+func (b *BugExcerpt) StatusName() string {
+	if b.WorkflowStatus != "" {
+		return b.WorkflowStatus
+	}
+	return b.Status.String()
+}
+
 func (b *BugExcerpt) CreateTime() time.Time {
 	return time.Unix(b.CreateUnixTime, 0)
 }
@@ -104,6 +141,10 @@ func (b *BugExcerpt) EditTime() time.Time {
 	return time.Unix(b.EditUnixTime, 0)
 }
 
+func (b *BugExcerpt) CloseTime() time.Time {
+	return time.Unix(b.CloseUnixTime, 0)
+}
+
 /*
  * Sorting
  */
@@ -177,3 +218,29 @@ func (b BugsByEditTime) Less(i, j int) bool {
 func (b BugsByEditTime) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
+
+// BugsByRelevance sort bugs by their full-text search score. Bugs with the
+// same score are sorted by creation time.
+type BugsByRelevance struct {
+	Excerpts []*BugExcerpt
+	Scores   map[entity.Id]float64
+}
+
+func (b BugsByRelevance) Len() int {
+	return len(b.Excerpts)
+}
+
+func (b BugsByRelevance) Less(i, j int) bool {
+	si := b.Scores[b.Excerpts[i].Id]
+	sj := b.Scores[b.Excerpts[j].Id]
+
+	if si != sj {
+		return si < sj
+	}
+
+	return BugsByCreationTime(b.Excerpts).Less(i, j)
+}
+
+func (b BugsByRelevance) Swap(i, j int) {
+	b.Excerpts[i], b.Excerpts[j] = b.Excerpts[j], b.Excerpts[i]
+}
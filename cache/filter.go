@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/MichaelMure/git-bug/bug"
@@ -12,6 +13,7 @@ import (
 // This exist mainly to go through the functions of the cache with proper locking.
 type resolver interface {
 	ResolveIdentityExcerpt(id entity.Id) (*IdentityExcerpt, error)
+	ResolveMilestoneExcerpt(id entity.Id) (*MilestoneExcerpt, error)
 }
 
 // Filter is a predicate that match a subset of bugs
@@ -24,6 +26,20 @@ func StatusFilter(status bug.Status) Filter {
 	}
 }
 
+// WorkflowStatusFilter return a Filter that match a bug workflow status
+func WorkflowStatusFilter(name string) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return excerpt.StatusName() == name
+	}
+}
+
+// ResolutionFilter return a Filter that match the reason a bug was closed for
+func ResolutionFilter(resolution bug.Resolution) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return excerpt.Status == bug.ClosedStatus && excerpt.Resolution == resolution
+	}
+}
+
 // AuthorFilter return a Filter that match a bug author
 func AuthorFilter(query string) Filter {
 	return func(excerpt *BugExcerpt, resolver resolver) bool {
@@ -88,6 +104,43 @@ func ParticipantFilter(query string) Filter {
 	}
 }
 
+// AssigneeFilter return a Filter that match a bug assignee
+func AssigneeFilter(query string) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		query = strings.ToLower(query)
+
+		for _, id := range excerpt.Assignees {
+			identityExcerpt, err := resolver.ResolveIdentityExcerpt(id)
+			if err != nil {
+				panic(err)
+			}
+
+			if identityExcerpt.Match(query) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// MilestoneFilter return a Filter that match the milestone of a bug, by id
+// prefix or title
+func MilestoneFilter(query string) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		if excerpt.Milestone == "" {
+			return false
+		}
+
+		milestoneExcerpt, err := resolver.ResolveMilestoneExcerpt(excerpt.Milestone)
+		if err != nil {
+			// the milestone might not have been pulled yet
+			return false
+		}
+
+		return milestoneExcerpt.Match(strings.ToLower(query))
+	}
+}
+
 // TitleFilter return a Filter that match if the title contains the given query
 func TitleFilter(query string) Filter {
 	return func(excerpt *BugExcerpt, resolver resolver) bool {
@@ -105,101 +158,152 @@ func NoLabelFilter() Filter {
 	}
 }
 
-// Matcher is a collection of Filter that implement a complex filter
-type Matcher struct {
-	Status      []Filter
-	Author      []Filter
-	Actor       []Filter
-	Participant []Filter
-	Label       []Filter
-	Title       []Filter
-	NoFilters   []Filter
+// CreatedFilter return a Filter that match the bugs created within a time range
+func CreatedFilter(r query.TimeRange) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return r.Contains(excerpt.CreateTime())
+	}
 }
 
-// compileMatcher transform a query.Filters into a specialized matcher
-// for the cache.
-func compileMatcher(filters query.Filters) *Matcher {
-	result := &Matcher{}
-
-	for _, value := range filters.Status {
-		result.Status = append(result.Status, StatusFilter(value))
-	}
-	for _, value := range filters.Author {
-		result.Author = append(result.Author, AuthorFilter(value))
-	}
-	for _, value := range filters.Actor {
-		result.Actor = append(result.Actor, ActorFilter(value))
-	}
-	for _, value := range filters.Participant {
-		result.Participant = append(result.Participant, ParticipantFilter(value))
-	}
-	for _, value := range filters.Label {
-		result.Label = append(result.Label, LabelFilter(value))
-	}
-	for _, value := range filters.Title {
-		result.Title = append(result.Title, TitleFilter(value))
+// EditedFilter return a Filter that match the bugs last edited within a time range
+func EditedFilter(r query.TimeRange) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return r.Contains(excerpt.EditTime())
 	}
-
-	return result
 }
 
-// Match check if a bug match the set of filters
-func (f *Matcher) Match(excerpt *BugExcerpt, resolver resolver) bool {
-	if match := f.orMatch(f.Status, excerpt, resolver); !match {
-		return false
+// ClosedFilter return a Filter that match the closed bugs, closed within a time range
+func ClosedFilter(r query.TimeRange) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return excerpt.Status == bug.ClosedStatus && r.Contains(excerpt.CloseTime())
 	}
+}
 
-	if match := f.orMatch(f.Author, excerpt, resolver); !match {
-		return false
+// CommentsFilter return a Filter that match the bugs with a number of
+// comments within a range. The description doesn't count as a comment.
+func CommentsFilter(r query.IntRange) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return r.Contains(excerpt.LenComments - 1)
 	}
+}
 
-	if match := f.orMatch(f.Participant, excerpt, resolver); !match {
-		return false
+// NoAssigneeFilter return a Filter that match the absence of assignee
+func NoAssigneeFilter() Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return len(excerpt.Assignees) == 0
 	}
+}
 
-	if match := f.orMatch(f.Actor, excerpt, resolver); !match {
-		return false
+// NoMilestoneFilter return a Filter that match the bugs not attached to a milestone
+func NoMilestoneFilter() Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return excerpt.Milestone == ""
 	}
+}
 
-	if match := f.andMatch(f.Label, excerpt, resolver); !match {
-		return false
+// SearchFilter return a Filter that match the bugs found by a full-text
+// search. A nil result means that the search had no usable term and
+// match everything.
+func SearchFilter(matches map[entity.Id]float64) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		if matches == nil {
+			return true
+		}
+		_, ok := matches[excerpt.Id]
+		return ok
 	}
+}
 
-	if match := f.andMatch(f.NoFilters, excerpt, resolver); !match {
-		return false
+// AndFilter return a Filter that match if all of the filters provided match the bug
+func AndFilter(filters ...Filter) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		for _, f := range filters {
+			if !f(excerpt, resolver) {
+				return false
+			}
+		}
+		return true
 	}
+}
 
-	if match := f.andMatch(f.Title, excerpt, resolver); !match {
+// OrFilter return a Filter that match if any of the filters provided match the bug
+func OrFilter(filters ...Filter) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		for _, f := range filters {
+			if f(excerpt, resolver) {
+				return true
+			}
+		}
 		return false
 	}
-
-	return true
 }
 
-// Check if any of the filters provided match the bug
-func (*Matcher) orMatch(filters []Filter, excerpt *BugExcerpt, resolver resolver) bool {
-	if len(filters) == 0 {
-		return true
-	}
-
-	match := false
-	for _, f := range filters {
-		match = match || f(excerpt, resolver)
+// NotFilter return a Filter that match if the filter provided doesn't match the bug
+func NotFilter(filter Filter) Filter {
+	return func(excerpt *BugExcerpt, resolver resolver) bool {
+		return !filter(excerpt, resolver)
 	}
-
-	return match
 }
 
-// Check if all of the filters provided match the bug
-func (*Matcher) andMatch(filters []Filter, excerpt *BugExcerpt, resolver resolver) bool {
-	if len(filters) == 0 {
-		return true
+// compileMatcher transform the expression of a query into a specialized
+// Filter for the cache. The full-text search terms are resolved with the
+// given search function.
+func compileMatcher(node query.Node, search func(term string) map[entity.Id]float64) Filter {
+	compileAll := func(nodes []query.Node) []Filter {
+		result := make([]Filter, len(nodes))
+		for i, n := range nodes {
+			result[i] = compileMatcher(n, search)
+		}
+		return result
 	}
 
-	match := true
-	for _, f := range filters {
-		match = match && f(excerpt, resolver)
+	switch node := node.(type) {
+	case nil:
+		return AndFilter()
+	case *query.And:
+		return AndFilter(compileAll(node.Operands)...)
+	case *query.Or:
+		return OrFilter(compileAll(node.Operands)...)
+	case *query.Not:
+		return NotFilter(compileMatcher(node.Operand, search))
+	case *query.StatusFilter:
+		if node.Name != "" {
+			return WorkflowStatusFilter(node.Name)
+		}
+		return StatusFilter(node.Status)
+	case *query.ResolutionFilter:
+		return ResolutionFilter(node.Resolution)
+	case *query.AuthorFilter:
+		return AuthorFilter(node.Author)
+	case *query.ActorFilter:
+		return ActorFilter(node.Actor)
+	case *query.ParticipantFilter:
+		return ParticipantFilter(node.Participant)
+	case *query.LabelFilter:
+		return LabelFilter(node.Label)
+	case *query.TitleFilter:
+		return TitleFilter(node.Title)
+	case *query.AssigneeFilter:
+		return AssigneeFilter(node.Assignee)
+	case *query.NoLabelFilter:
+		return NoLabelFilter()
+	case *query.NoAssigneeFilter:
+		return NoAssigneeFilter()
+	case *query.MilestoneFilter:
+		return MilestoneFilter(node.Milestone)
+	case *query.NoMilestoneFilter:
+		return NoMilestoneFilter()
+	case *query.CreatedFilter:
+		return CreatedFilter(node.Range)
+	case *query.EditedFilter:
+		return EditedFilter(node.Range)
+	case *query.ClosedFilter:
+		return ClosedFilter(node.Range)
+	case *query.CommentsFilter:
+		return CommentsFilter(node.Range)
+	case *query.SearchFilter:
+		return SearchFilter(search(node.Term))
+	default:
+		panic(fmt.Sprintf("unknown query node %T", node))
 	}
-
-	return match
 }
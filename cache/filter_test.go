@@ -4,6 +4,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/query"
 )
 
 func TestTitleFilter(t *testing.T) {
@@ -32,3 +37,52 @@ func TestTitleFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestCompileMatcher(t *testing.T) {
+	excerpts := map[string]*BugExcerpt{
+		"open-bug":            {Id: "1", Status: bug.OpenStatus, Labels: []bug.Label{"bug"}},
+		"open-crash-needinfo": {Id: "2", Status: bug.OpenStatus, Labels: []bug.Label{"crash", "needinfo"}},
+		"open-nolabel":        {Id: "3", Status: bug.OpenStatus},
+		"closed-bug":          {Id: "4", Status: bug.ClosedStatus, Labels: []bug.Label{"bug"}},
+	}
+
+	search := func(term string) map[entity.Id]float64 {
+		if term == "panic" {
+			return map[entity.Id]float64{"2": 1, "4": 1}
+		}
+		return map[entity.Id]float64{}
+	}
+
+	tests := []struct {
+		query   string
+		matches []string
+	}{
+		{"", []string{"open-bug", "open-crash-needinfo", "open-nolabel", "closed-bug"}},
+		{"status:open", []string{"open-bug", "open-crash-needinfo", "open-nolabel"}},
+		{"status:open status:closed", []string{"open-bug", "open-crash-needinfo", "open-nolabel", "closed-bug"}},
+		{"-label:bug", []string{"open-crash-needinfo", "open-nolabel"}},
+		{"label:bug OR no:label", []string{"open-bug", "open-nolabel", "closed-bug"}},
+		{"status:open AND (label:bug OR label:crash)", []string{"open-bug", "open-crash-needinfo"}},
+		{"status:open AND (label:bug OR label:crash) AND -label:needinfo", []string{"open-bug"}},
+		{"NOT (status:open OR label:bug)", []string{}},
+		{"panic", []string{"open-crash-needinfo", "closed-bug"}},
+		{"-panic status:open", []string{"open-bug", "open-nolabel"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q, err := query.Parse(tt.query)
+			require.NoError(t, err)
+
+			matcher := compileMatcher(q.Filter, search)
+
+			var matches []string
+			for name, excerpt := range excerpts {
+				if matcher(excerpt, nil) {
+					matches = append(matches, name)
+				}
+			}
+			assert.ElementsMatch(t, tt.matches, matches)
+		})
+	}
+}
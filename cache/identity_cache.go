@@ -43,3 +43,12 @@ func (i *IdentityCache) CommitAsNeeded() error {
 	}
 	return i.notifyUpdated()
 }
+
+// identities unwrap a list of IdentityCache into the underlying identities
+func identities(list []*IdentityCache) []identity.Interface {
+	result := make([]identity.Interface, len(list))
+	for i, id := range list {
+		result[i] = id.Identity
+	}
+	return result
+}
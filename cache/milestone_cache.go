@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/milestone"
+)
+
+// MilestoneCache is a wrapper around a Milestone for caching.
+type MilestoneCache struct {
+	*milestone.Milestone
+	repoCache *RepoCache
+}
+
+func NewMilestoneCache(repoCache *RepoCache, m *milestone.Milestone) *MilestoneCache {
+	return &MilestoneCache{
+		Milestone: m,
+		repoCache: repoCache,
+	}
+}
+
+func (m *MilestoneCache) notifyUpdated() error {
+	return m.repoCache.milestoneUpdated(m.Milestone.Id())
+}
+
+func (m *MilestoneCache) Mutate(f func(milestone.Mutator) milestone.Mutator) error {
+	author, err := m.repoCache.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	return m.MutateRaw(author, time.Now().Unix(), f)
+}
+
+func (m *MilestoneCache) MutateRaw(author *IdentityCache, unixTime int64, f func(milestone.Mutator) milestone.Mutator) error {
+	m.Milestone.Mutate(author.Identity, unixTime, f)
+	return m.notifyUpdated()
+}
+
+func (m *MilestoneCache) Commit() error {
+	err := m.Milestone.Commit(m.repoCache.repo)
+	if err != nil {
+		return err
+	}
+	return m.notifyUpdated()
+}
+
+func (m *MilestoneCache) CommitAsNeeded() error {
+	err := m.Milestone.CommitAsNeeded(m.repoCache.repo)
+	if err != nil {
+		return err
+	}
+	return m.notifyUpdated()
+}
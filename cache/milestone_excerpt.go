@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"encoding/gob"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/milestone"
+)
+
+// Package initialisation used to register the type for (de)serialization
+func init() {
+	gob.Register(MilestoneExcerpt{})
+}
+
+// MilestoneExcerpt hold a subset of the milestone values to be able to sort and
+// filter milestones efficiently without having to read and compile each raw
+// milestone.
+type MilestoneExcerpt struct {
+	Id entity.Id
+
+	Title             string
+	Status            bug.Status
+	DueUnixTime       int64
+	CreateUnixTime    int64
+	EditUnixTime      int64
+	ImmutableMetadata map[string]string
+}
+
+func NewMilestoneExcerpt(m *milestone.Milestone) *MilestoneExcerpt {
+	var due int64
+	if !m.DueDate().IsZero() {
+		due = m.DueDate().Unix()
+	}
+
+	return &MilestoneExcerpt{
+		Id:                m.Id(),
+		Title:             m.Title(),
+		Status:            m.Status(),
+		DueUnixTime:       due,
+		CreateUnixTime:    int64(m.CreateTime()),
+		EditUnixTime:      int64(m.LastEdit()),
+		ImmutableMetadata: m.ImmutableMetadata(),
+	}
+}
+
+// DueDate return the due date of the milestone, or the zero time if there is none
+func (m *MilestoneExcerpt) DueDate() time.Time {
+	if m.DueUnixTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(m.DueUnixTime, 0)
+}
+
+// Match matches a query with the milestone title and ID prefix
+func (m *MilestoneExcerpt) Match(query string) bool {
+	return m.Id.HasPrefix(query) ||
+		strings.Contains(strings.ToLower(m.Title), query)
+}
+
+/*
+ * Sorting
+ */
+
+type MilestonesByDueDate []*MilestoneExcerpt
+
+func (m MilestonesByDueDate) Len() int {
+	return len(m)
+}
+
+// Less sort the milestones by due date, the ones without due date last,
+// then by title
+func (m MilestonesByDueDate) Less(i, j int) bool {
+	if m[i].DueUnixTime != m[j].DueUnixTime {
+		if m[i].DueUnixTime == 0 {
+			return false
+		}
+		if m[j].DueUnixTime == 0 {
+			return true
+		}
+		return m[i].DueUnixTime < m[j].DueUnixTime
+	}
+	return m[i].Title < m[j].Title
+}
+
+func (m MilestonesByDueDate) Swap(i, j int) {
+	m[i], m[j] = m[j], m[i]
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/milestone"
 	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/process"
 )
@@ -20,7 +21,13 @@ import (
 // 1: original format
 // 2: added cache for identities with a reference in the bug cache
 // 3: no more legacy identity
-const formatVersion = 3
+// 4: close time in the bug excerpt
+// 5: assignees in the bug excerpt
+// 6: added cache for milestones, milestone in the bug excerpt
+// 7: links in the bug excerpt
+// 8: workflow status in the bug excerpt
+// 9: resolution in the bug excerpt
+const formatVersion = 9
 
 // The maximum number of bugs loaded in memory. After that, eviction will be done.
 const defaultMaxLoadedBugs = 1000
@@ -38,7 +45,8 @@ var _ repository.RepoKeyring = &RepoCache{}
 //		them individually.
 // 3. The cache guarantee that a single instance of a Bug is loaded at once, avoiding
 // 		loss of data that we could have with multiple copies in the same process.
-// 4. The same way, the cache maintain in memory a single copy of the loaded identities.
+// 4. The same way, the cache maintain in memory a single copy of the loaded identities
+// 		and milestones.
 //
 // The cache also protect the on-disk data by locking the git repository for its
 // own usage, by writing a lock file. Of course, normal git operations are not
@@ -60,6 +68,8 @@ type RepoCache struct {
 	bugs map[entity.Id]*BugCache
 	// loadedBugs is an LRU cache that records which bugs the cache has loaded in
 	loadedBugs *LRUIdCache
+	// full-text index of the bugs title and comments
+	searchIndex *searchIndex
 
 	muIdentity sync.RWMutex
 	// excerpt of identities data for all identities
@@ -67,8 +77,18 @@ type RepoCache struct {
 	// identities loaded in memory
 	identities map[entity.Id]*IdentityCache
 
+	muMilestone sync.RWMutex
+	// excerpt of milestones data for all milestones
+	milestonesExcerpts map[entity.Id]*MilestoneExcerpt
+	// milestones loaded in memory
+	milestones map[entity.Id]*MilestoneCache
+
 	// the user identity's id, if known
 	userIdentityId entity.Id
+
+	muSubscribers sync.Mutex
+	// channels of the subscribers to the bug events
+	bugSubscribers map[chan BugEvent]struct{}
 }
 
 func NewRepoCache(r repository.ClockedRepo) (*RepoCache, error) {
@@ -83,6 +103,7 @@ func NewNamedRepoCache(r repository.ClockedRepo, name string) (*RepoCache, error
 		bugs:          make(map[entity.Id]*BugCache),
 		loadedBugs:    NewLRUIdCache(),
 		identities:    make(map[entity.Id]*IdentityCache),
+		milestones:    make(map[entity.Id]*MilestoneCache),
 	}
 
 	err := c.lock()
@@ -116,7 +137,15 @@ func (c *RepoCache) load() error {
 	if err != nil {
 		return err
 	}
-	return c.loadIdentityCache()
+	err = c.loadSearchIndex()
+	if err != nil {
+		return err
+	}
+	err = c.loadIdentityCache()
+	if err != nil {
+		return err
+	}
+	return c.loadMilestoneCache()
 }
 
 // write will serialize on disk all the cache files
@@ -125,7 +154,15 @@ func (c *RepoCache) write() error {
 	if err != nil {
 		return err
 	}
-	return c.writeIdentityCache()
+	err = c.writeSearchIndex()
+	if err != nil {
+		return err
+	}
+	err = c.writeIdentityCache()
+	if err != nil {
+		return err
+	}
+	return c.writeMilestoneCache()
 }
 
 func (c *RepoCache) lock() error {
@@ -156,15 +193,22 @@ func (c *RepoCache) lock() error {
 }
 
 func (c *RepoCache) Close() error {
+	c.closeSubscribers()
+
 	c.muBug.Lock()
 	defer c.muBug.Unlock()
 	c.muIdentity.Lock()
 	defer c.muIdentity.Unlock()
+	c.muMilestone.Lock()
+	defer c.muMilestone.Unlock()
 
+	c.milestones = make(map[entity.Id]*MilestoneCache)
+	c.milestonesExcerpts = nil
 	c.identities = make(map[entity.Id]*IdentityCache)
 	c.identitiesExcerpts = nil
 	c.bugs = make(map[entity.Id]*BugCache)
 	c.bugExcerpts = nil
+	c.searchIndex = nil
 
 	lockPath := repoLockFilePath(c.repo)
 	return os.Remove(lockPath)
@@ -175,6 +219,8 @@ func (c *RepoCache) buildCache() error {
 	defer c.muBug.Unlock()
 	c.muIdentity.Lock()
 	defer c.muIdentity.Unlock()
+	c.muMilestone.Lock()
+	defer c.muMilestone.Unlock()
 
 	_, _ = fmt.Fprintf(os.Stderr, "Building identity cache... ")
 
@@ -192,9 +238,26 @@ func (c *RepoCache) buildCache() error {
 
 	_, _ = fmt.Fprintln(os.Stderr, "Done.")
 
+	_, _ = fmt.Fprintf(os.Stderr, "Building milestone cache... ")
+
+	c.milestonesExcerpts = make(map[entity.Id]*MilestoneExcerpt)
+
+	allMilestones := milestone.ReadAllLocal(c.repo)
+
+	for m := range allMilestones {
+		if m.Err != nil {
+			return m.Err
+		}
+
+		c.milestonesExcerpts[m.Milestone.Id()] = NewMilestoneExcerpt(m.Milestone)
+	}
+
+	_, _ = fmt.Fprintln(os.Stderr, "Done.")
+
 	_, _ = fmt.Fprintf(os.Stderr, "Building bug cache... ")
 
 	c.bugExcerpts = make(map[entity.Id]*BugExcerpt)
+	c.searchIndex = newSearchIndex()
 
 	allBugs := bug.ReadAllLocal(c.repo)
 
@@ -205,6 +268,7 @@ func (c *RepoCache) buildCache() error {
 
 		snap := b.Bug.Compile()
 		c.bugExcerpts[b.Bug.Id()] = NewBugExcerpt(b.Bug, &snap)
+		c.searchIndex.add(b.Bug.Id(), &snap)
 	}
 
 	_, _ = fmt.Fprintln(os.Stderr, "Done.")
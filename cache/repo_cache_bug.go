@@ -40,11 +40,31 @@ func (c *RepoCache) bugUpdated(id entity.Id) error {
 		return errBugNotInCache
 	}
 	c.loadedBugs.Get(id)
-	c.bugExcerpts[id] = NewBugExcerpt(b.bug, b.Snapshot())
+	snap := b.Snapshot()
+	c.bugExcerpts[id] = NewBugExcerpt(b.bug, snap)
+	c.searchIndex.add(id, snap)
+	// the cached snapshot keeps changing, subscribers get a fresh one
+	var eventSnap *bug.Snapshot
+	var eventExcerpt *BugExcerpt
+	if c.hasBugSubscribers() {
+		b.mu.RLock()
+		compiled := b.bug.Compile()
+		eventSnap = &compiled
+		eventExcerpt = NewBugExcerpt(b.bug, eventSnap)
+		b.mu.RUnlock()
+	}
 	c.muBug.Unlock()
 
-	// we only need to write the bug cache
-	return c.writeBugCache()
+	if eventSnap != nil {
+		c.publishBugEvent(id, eventSnap, eventExcerpt)
+	}
+
+	// we only need to write the bug cache and the search index
+	err := c.writeBugCache()
+	if err != nil {
+		return err
+	}
+	return c.writeSearchIndex()
 }
 
 // load will try to read from the disk the bug cache file
@@ -252,16 +272,37 @@ func (c *RepoCache) QueryBugs(q *query.Query) []entity.Id {
 		return c.AllBugsIds()
 	}
 
-	matcher := compileMatcher(q.Filters)
+	// full-text search results, computed once per term
+	searches := make(map[string]map[entity.Id]float64)
+	search := func(term string) map[entity.Id]float64 {
+		if matches, ok := searches[term]; ok {
+			return matches
+		}
+		matches := c.searchIndex.search(term)
+		searches[term] = matches
+		return matches
+	}
+
+	matcher := compileMatcher(q.Filter, search)
 
 	var filtered []*BugExcerpt
 
 	for _, excerpt := range c.bugExcerpts {
-		if matcher.Match(excerpt, c) {
+		if matcher(excerpt, c) {
 			filtered = append(filtered, excerpt)
 		}
 	}
 
+	// the relevance of a bug is the sum of the scores of the terms it needs to mention
+	scores := make(map[entity.Id]float64, len(filtered))
+	if q.OrderBy == query.OrderByRelevance {
+		for _, term := range query.SearchTerms(q.Filter) {
+			for id, score := range search(term) {
+				scores[id] += score
+			}
+		}
+	}
+
 	var sorter sort.Interface
 
 	switch q.OrderBy {
@@ -271,6 +312,8 @@ func (c *RepoCache) QueryBugs(q *query.Query) []entity.Id {
 		sorter = BugsByCreationTime(filtered)
 	case query.OrderByEdit:
 		sorter = BugsByEditTime(filtered)
+	case query.OrderByRelevance:
+		sorter = BugsByRelevance{Excerpts: filtered, Scores: scores}
 	default:
 		panic("missing sort type")
 	}
@@ -295,6 +338,39 @@ func (c *RepoCache) QueryBugs(q *query.Query) []entity.Id {
 	return result
 }
 
+// MatchBug tell if a single bug match the filters of a query. A nil query
+// match every bug.
+func (c *RepoCache) MatchBug(q *query.Query, id entity.Id) bool {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	excerpt, ok := c.bugExcerpts[id]
+	if !ok {
+		return false
+	}
+
+	return c.matchBugExcerpt(q, excerpt)
+}
+
+// MatchBugExcerpt tell if a given version of a bug, as described by its
+// excerpt, match the filters of a query. A nil query match every bug.
+func (c *RepoCache) MatchBugExcerpt(q *query.Query, excerpt *BugExcerpt) bool {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	return c.matchBugExcerpt(q, excerpt)
+}
+
+func (c *RepoCache) matchBugExcerpt(q *query.Query, excerpt *BugExcerpt) bool {
+	if q == nil {
+		return true
+	}
+
+	matcher := compileMatcher(q.Filter, c.searchIndex.search)
+
+	return matcher(excerpt, c)
+}
+
 // AllBugsIds return all known bug ids
 func (c *RepoCache) AllBugsIds() []entity.Id {
 	c.muBug.RLock()
@@ -344,6 +420,33 @@ func (c *RepoCache) ValidLabels() []bug.Label {
 	return result
 }
 
+// LinksTo return the links other bugs have to the given bug, reversed to be
+// seen from that bug. For example, a bug blocking the given one is returned
+// as a "blocked-by" link targeting the blocking bug.
+func (c *RepoCache) LinksTo(id entity.Id) []bug.Link {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	var result []bug.Link
+
+	for _, excerpt := range c.bugExcerpts {
+		for _, link := range excerpt.Links {
+			if link.Target == id {
+				result = append(result, link.Inverse(excerpt.Id))
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Type != result[j].Type {
+			return result[i].Type < result[j].Type
+		}
+		return result[i].Target < result[j].Target
+	})
+
+	return result
+}
+
 // NewBug create a new bug
 // The new bug is written in the repository (commit)
 func (c *RepoCache) NewBug(title string, message string) (*BugCache, *bug.CreateOperation, error) {
@@ -365,6 +468,29 @@ func (c *RepoCache) NewBugWithFiles(title string, message string, files []reposi
 // well as metadata for the Create operation.
 // The new bug is written in the repository (commit)
 func (c *RepoCache) NewBugRaw(author *IdentityCache, unixTime int64, title string, message string, files []repository.Hash, metadata map[string]string) (*BugCache, *bug.CreateOperation, error) {
+	return c.newBug(author, unixTime, title, message, files, nil, metadata)
+}
+
+// NewBugFromTemplate create a new bug with the given title and message, and
+// the default labels of the template.
+// The new bug is written in the repository (commit)
+func (c *RepoCache) NewBugFromTemplate(template bug.Template, title string, message string) (*BugCache, *bug.CreateOperation, error) {
+	author, err := c.GetUserIdentity()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c.NewBugFromTemplateRaw(author, time.Now().Unix(), template, title, message, nil, nil)
+}
+
+// NewBugFromTemplateRaw create a new bug with the given title and message, and
+// the default labels of the template.
+// The new bug is written in the repository (commit)
+func (c *RepoCache) NewBugFromTemplateRaw(author *IdentityCache, unixTime int64, template bug.Template, title string, message string, files []repository.Hash, metadata map[string]string) (*BugCache, *bug.CreateOperation, error) {
+	return c.newBug(author, unixTime, title, message, files, template.LabelNames(), metadata)
+}
+
+func (c *RepoCache) newBug(author *IdentityCache, unixTime int64, title string, message string, files []repository.Hash, labels []string, metadata map[string]string) (*BugCache, *bug.CreateOperation, error) {
 	b, op, err := bug.CreateWithFiles(author.Identity, unixTime, title, message, files)
 	if err != nil {
 		return nil, nil, err
@@ -374,6 +500,13 @@ func (c *RepoCache) NewBugRaw(author *IdentityCache, unixTime int64, title strin
 		op.SetMetadata(key, value)
 	}
 
+	if len(labels) > 0 {
+		_, _, err = bug.ChangeLabels(b, author.Identity, unixTime, labels, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	err = b.Commit(c.repo)
 	if err != nil {
 		return nil, nil, err
@@ -417,9 +550,44 @@ func (c *RepoCache) RemoveBug(prefix string) error {
 
 	delete(c.bugs, b.Id())
 	delete(c.bugExcerpts, b.Id())
+	c.searchIndex.remove(b.Id())
 	c.loadedBugs.Remove(b.Id())
 
 	c.muBug.Unlock()
 
-	return c.writeBugCache()
+	err = c.writeBugCache()
+	if err != nil {
+		return err
+	}
+	return c.writeSearchIndex()
+}
+
+// PurgeRedactedComments rewrite the git history of a bug to physically remove
+// the messages and files of its redacted comments, and return the number of
+// comments purged and the number of comments kept in the first commit. See
+// bug.PurgeRedactedComments.
+func (c *RepoCache) PurgeRedactedComments(id entity.Id) (int, int, error) {
+	c.muBug.Lock()
+	if b, ok := c.bugs[id]; ok && b.NeedCommit() {
+		c.muBug.Unlock()
+		return 0, 0, fmt.Errorf("the bug has uncommitted changes")
+	}
+
+	purged, kept, err := bug.PurgeRedactedComments(c.repo, id)
+	if err != nil {
+		c.muBug.Unlock()
+		return 0, kept, err
+	}
+
+	// the operations ids changed, drop the bug from memory to reload it
+	delete(c.bugs, id)
+	c.loadedBugs.Remove(id)
+	c.muBug.Unlock()
+
+	_, err = c.ResolveBug(id)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return purged, kept, c.bugUpdated(id)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/milestone"
 	"github.com/MichaelMure/git-bug/repository"
 )
 
@@ -70,22 +71,27 @@ func (c *RepoCache) StoreData(data []byte) (repository.Hash, error) {
 }
 
 // Fetch retrieve updates from a remote
-// This does not change the local bugs or identities state
+// This does not change the local bugs, identities or milestones state
 func (c *RepoCache) Fetch(remote string) (string, error) {
 	stdout1, err := identity.Fetch(c.repo, remote)
 	if err != nil {
 		return stdout1, err
 	}
 
-	stdout2, err := bug.Fetch(c.repo, remote)
+	stdout2, err := milestone.Fetch(c.repo, remote)
 	if err != nil {
 		return stdout2, err
 	}
 
-	return stdout1 + stdout2, nil
+	stdout3, err := bug.Fetch(c.repo, remote)
+	if err != nil {
+		return stdout3, err
+	}
+
+	return stdout1 + stdout2 + stdout3, nil
 }
 
-// MergeAll will merge all the available remote bug and identities
+// MergeAll will merge all the available remote bug, identities and milestones
 func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 	out := make(chan entity.MergeResult)
 
@@ -110,6 +116,25 @@ func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 			}
 		}
 
+		results = milestone.MergeAllWithResolver(c.repo, newIdentityCacheResolver(c), remote)
+		for result := range results {
+			out <- result
+
+			if result.Err != nil {
+				continue
+			}
+
+			switch result.Status {
+			case entity.MergeStatusNew, entity.MergeStatusUpdated:
+				m := result.Entity.(*milestone.Milestone)
+				c.muMilestone.Lock()
+				c.milestonesExcerpts[result.Id] = NewMilestoneExcerpt(m)
+				// drop the stale in-memory copy, it will be reloaded when needed
+				delete(c.milestones, result.Id)
+				c.muMilestone.Unlock()
+			}
+		}
+
 		results = bug.MergeAll(c.repo, remote)
 		for result := range results {
 			out <- result
@@ -122,9 +147,13 @@ func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 			case entity.MergeStatusNew, entity.MergeStatusUpdated:
 				b := result.Entity.(*bug.Bug)
 				snap := b.Compile()
+				excerpt := NewBugExcerpt(b, &snap)
 				c.muBug.Lock()
-				c.bugExcerpts[result.Id] = NewBugExcerpt(b, &snap)
+				c.bugExcerpts[result.Id] = excerpt
+				c.searchIndex.add(result.Id, &snap)
 				c.muBug.Unlock()
+
+				c.publishBugEvent(result.Id, &snap, excerpt)
 			}
 		}
 
@@ -146,12 +175,17 @@ func (c *RepoCache) Push(remote string) (string, error) {
 		return stdout1, err
 	}
 
-	stdout2, err := bug.Push(c.repo, remote)
+	stdout2, err := milestone.Push(c.repo, remote)
 	if err != nil {
 		return stdout2, err
 	}
 
-	return stdout1 + stdout2, nil
+	stdout3, err := bug.Push(c.repo, remote)
+	if err != nil {
+		return stdout3, err
+	}
+
+	return stdout1 + stdout2 + stdout3, nil
 }
 
 // Pull will do a Fetch + MergeAll
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// size of the buffer of each subscriber, events are dropped for the
+// subscribers too slow to keep up
+const bugEventsBufferSize = 64
+
+// BugEvent is published by a RepoCache each time a bug is created or
+// changed, either locally or by a merge from a remote
+type BugEvent struct {
+	// the id of the bug
+	Id entity.Id
+	// a snapshot of the bug after the change, shared by all the subscribers
+	// and not to be modified
+	Snapshot *bug.Snapshot
+	// the excerpt of the same version of the bug, to filter the events
+	// without racing with the later changes
+	Excerpt *BugExcerpt
+}
+
+// SubscribeBugEvents register a new subscriber to the bug events of the
+// repository. The returned function unsubscribe and must be called once the
+// events are no longer consumed. The channel is closed when unsubscribing or
+// when the cache is closed.
+func (c *RepoCache) SubscribeBugEvents() (<-chan BugEvent, func()) {
+	c.muSubscribers.Lock()
+	defer c.muSubscribers.Unlock()
+
+	ch := make(chan BugEvent, bugEventsBufferSize)
+
+	if c.bugSubscribers == nil {
+		c.bugSubscribers = make(map[chan BugEvent]struct{})
+	}
+	c.bugSubscribers[ch] = struct{}{}
+
+	unsubscribe := func() {
+		c.muSubscribers.Lock()
+		defer c.muSubscribers.Unlock()
+
+		if _, ok := c.bugSubscribers[ch]; ok {
+			delete(c.bugSubscribers, ch)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// hasBugSubscribers tell if someone listen to the bug events, to avoid
+// compiling a snapshot for nothing
+func (c *RepoCache) hasBugSubscribers() bool {
+	c.muSubscribers.Lock()
+	defer c.muSubscribers.Unlock()
+	return len(c.bugSubscribers) > 0
+}
+
+// publishBugEvent send an event to all the subscribers, without ever
+// blocking the cache
+func (c *RepoCache) publishBugEvent(id entity.Id, snap *bug.Snapshot, excerpt *BugExcerpt) {
+	c.muSubscribers.Lock()
+	defer c.muSubscribers.Unlock()
+
+	for ch := range c.bugSubscribers {
+		select {
+		case ch <- BugEvent{Id: id, Snapshot: snap, Excerpt: excerpt}:
+		default:
+		}
+	}
+}
+
+// closeSubscribers unsubscribe everyone, when the cache is closed
+func (c *RepoCache) closeSubscribers() {
+	c.muSubscribers.Lock()
+	defer c.muSubscribers.Unlock()
+
+	for ch := range c.bugSubscribers {
+		close(ch)
+	}
+	c.bugSubscribers = nil
+}
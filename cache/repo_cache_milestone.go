@@ -0,0 +1,287 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/milestone"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const milestoneCacheFile = "milestone-cache"
+
+func milestoneCacheFilePath(repo repository.Repo) string {
+	return path.Join(repo.GetPath(), "git-bug", milestoneCacheFile)
+}
+
+// milestoneUpdated is a callback to trigger when the excerpt of a milestone
+// changed, that is each time a milestone is updated
+func (c *RepoCache) milestoneUpdated(id entity.Id) error {
+	c.muMilestone.Lock()
+
+	m, ok := c.milestones[id]
+	if !ok {
+		c.muMilestone.Unlock()
+		panic("missing milestone in the cache")
+	}
+
+	c.milestonesExcerpts[id] = NewMilestoneExcerpt(m.Milestone)
+	c.muMilestone.Unlock()
+
+	// we only need to write the milestone cache
+	return c.writeMilestoneCache()
+}
+
+// load will try to read from the disk the milestone cache file
+func (c *RepoCache) loadMilestoneCache() error {
+	c.muMilestone.Lock()
+	defer c.muMilestone.Unlock()
+
+	f, err := os.Open(milestoneCacheFilePath(c.repo))
+	if err != nil {
+		return err
+	}
+
+	decoder := gob.NewDecoder(f)
+
+	aux := struct {
+		Version  uint
+		Excerpts map[entity.Id]*MilestoneExcerpt
+	}{}
+
+	err = decoder.Decode(&aux)
+	if err != nil {
+		return err
+	}
+
+	if aux.Version != formatVersion {
+		return fmt.Errorf("unknown cache format version %v", aux.Version)
+	}
+
+	c.milestonesExcerpts = aux.Excerpts
+	return nil
+}
+
+// write will serialize on disk the milestone cache file
+func (c *RepoCache) writeMilestoneCache() error {
+	c.muMilestone.RLock()
+	defer c.muMilestone.RUnlock()
+
+	var data bytes.Buffer
+
+	aux := struct {
+		Version  uint
+		Excerpts map[entity.Id]*MilestoneExcerpt
+	}{
+		Version:  formatVersion,
+		Excerpts: c.milestonesExcerpts,
+	}
+
+	encoder := gob.NewEncoder(&data)
+
+	err := encoder.Encode(aux)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(milestoneCacheFilePath(c.repo))
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// ResolveMilestoneExcerpt retrieve a MilestoneExcerpt matching the exact given id
+func (c *RepoCache) ResolveMilestoneExcerpt(id entity.Id) (*MilestoneExcerpt, error) {
+	c.muMilestone.RLock()
+	defer c.muMilestone.RUnlock()
+
+	e, ok := c.milestonesExcerpts[id]
+	if !ok {
+		return nil, milestone.ErrMilestoneNotExist
+	}
+
+	return e, nil
+}
+
+// ResolveMilestone retrieve a milestone matching the exact given id
+func (c *RepoCache) ResolveMilestone(id entity.Id) (*MilestoneCache, error) {
+	c.muMilestone.RLock()
+	cached, ok := c.milestones[id]
+	c.muMilestone.RUnlock()
+	if ok {
+		return cached, nil
+	}
+
+	m, err := milestone.ReadLocalWithResolver(c.repo, newIdentityCacheResolver(c), id)
+	if err != nil {
+		return nil, err
+	}
+
+	cached = NewMilestoneCache(c, m)
+
+	c.muMilestone.Lock()
+	c.milestones[id] = cached
+	c.muMilestone.Unlock()
+
+	return cached, nil
+}
+
+// ResolveMilestoneExcerptPrefix retrieve a MilestoneExcerpt matching an id prefix.
+// It fails if multiple milestones match.
+func (c *RepoCache) ResolveMilestoneExcerptPrefix(prefix string) (*MilestoneExcerpt, error) {
+	return c.ResolveMilestoneExcerptMatcher(func(excerpt *MilestoneExcerpt) bool {
+		return excerpt.Id.HasPrefix(prefix)
+	})
+}
+
+// ResolveMilestonePrefix retrieve a Milestone matching an id prefix.
+// It fails if multiple milestones match.
+func (c *RepoCache) ResolveMilestonePrefix(prefix string) (*MilestoneCache, error) {
+	return c.ResolveMilestoneMatcher(func(excerpt *MilestoneExcerpt) bool {
+		return excerpt.Id.HasPrefix(prefix)
+	})
+}
+
+// ResolveMilestoneTitle retrieve a Milestone with the exact given title.
+// It fails if multiple milestones match.
+func (c *RepoCache) ResolveMilestoneTitle(title string) (*MilestoneCache, error) {
+	return c.ResolveMilestoneMatcher(func(excerpt *MilestoneExcerpt) bool {
+		return excerpt.Title == title
+	})
+}
+
+// ResolveMilestoneImmutableMetadata retrieve a Milestone that has the exact given metadata on
+// one of it's version. If multiple version have the same key, the first defined take precedence.
+func (c *RepoCache) ResolveMilestoneImmutableMetadata(key string, value string) (*MilestoneCache, error) {
+	return c.ResolveMilestoneMatcher(func(excerpt *MilestoneExcerpt) bool {
+		return excerpt.ImmutableMetadata[key] == value
+	})
+}
+
+func (c *RepoCache) ResolveMilestoneExcerptMatcher(f func(*MilestoneExcerpt) bool) (*MilestoneExcerpt, error) {
+	id, err := c.resolveMilestoneMatcher(f)
+	if err != nil {
+		return nil, err
+	}
+	return c.ResolveMilestoneExcerpt(id)
+}
+
+func (c *RepoCache) ResolveMilestoneMatcher(f func(*MilestoneExcerpt) bool) (*MilestoneCache, error) {
+	id, err := c.resolveMilestoneMatcher(f)
+	if err != nil {
+		return nil, err
+	}
+	return c.ResolveMilestone(id)
+}
+
+func (c *RepoCache) resolveMilestoneMatcher(f func(*MilestoneExcerpt) bool) (entity.Id, error) {
+	c.muMilestone.RLock()
+	defer c.muMilestone.RUnlock()
+
+	// preallocate but empty
+	matching := make([]entity.Id, 0, 5)
+
+	for _, excerpt := range c.milestonesExcerpts {
+		if f(excerpt) {
+			matching = append(matching, excerpt.Id)
+		}
+	}
+
+	if len(matching) > 1 {
+		return entity.UnsetId, milestone.NewErrMultipleMatch(matching)
+	}
+
+	if len(matching) == 0 {
+		return entity.UnsetId, milestone.ErrMilestoneNotExist
+	}
+
+	return matching[0], nil
+}
+
+// AllMilestoneIds return all known milestone ids
+func (c *RepoCache) AllMilestoneIds() []entity.Id {
+	c.muMilestone.RLock()
+	defer c.muMilestone.RUnlock()
+
+	result := make([]entity.Id, len(c.milestonesExcerpts))
+
+	i := 0
+	for _, excerpt := range c.milestonesExcerpts {
+		result[i] = excerpt.Id
+		i++
+	}
+
+	return result
+}
+
+// AllMilestoneExcerpts return all known milestone excerpts, sorted by due date
+func (c *RepoCache) AllMilestoneExcerpts() []*MilestoneExcerpt {
+	c.muMilestone.RLock()
+	defer c.muMilestone.RUnlock()
+
+	result := make([]*MilestoneExcerpt, 0, len(c.milestonesExcerpts))
+	for _, excerpt := range c.milestonesExcerpts {
+		result = append(result, excerpt)
+	}
+
+	sort.Sort(MilestonesByDueDate(result))
+
+	return result
+}
+
+// NewMilestone create a new milestone
+// The new milestone is written in the repository (commit)
+func (c *RepoCache) NewMilestone(title string, description string, dueDate time.Time) (*MilestoneCache, error) {
+	author, err := c.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewMilestoneRaw(author, time.Now().Unix(), title, description, dueDate, nil)
+}
+
+// NewMilestoneRaw create a new milestone with attributes set by the caller
+// The new milestone is written in the repository (commit)
+func (c *RepoCache) NewMilestoneRaw(author *IdentityCache, unixTime int64, title string, description string, dueDate time.Time, metadata map[string]string) (*MilestoneCache, error) {
+	m := milestone.NewMilestone(author.Identity, unixTime, title, description, dueDate)
+
+	for key, value := range metadata {
+		m.SetMetadata(author.Identity, unixTime, key, value)
+	}
+
+	err := m.Commit(c.repo)
+	if err != nil {
+		return nil, err
+	}
+
+	c.muMilestone.Lock()
+	if _, has := c.milestones[m.Id()]; has {
+		c.muMilestone.Unlock()
+		return nil, fmt.Errorf("milestone %s already exist in the cache", m.Id())
+	}
+
+	cached := NewMilestoneCache(c, m)
+	c.milestones[m.Id()] = cached
+	c.muMilestone.Unlock()
+
+	// force the write of the excerpt
+	err = c.milestoneUpdated(m.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	return cached, nil
+}
@@ -1,12 +1,16 @@
 package cache
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/milestone"
 	"github.com/MichaelMure/git-bug/query"
 	"github.com/MichaelMure/git-bug/repository"
 )
@@ -106,6 +110,67 @@ func TestCache(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestFullTextSearch(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	bug1, _, err := cache.NewBug("panic in the parser", "it fails right after startup")
+	require.NoError(t, err)
+	bug2, _, err := cache.NewBug("crash on startup", "this panic happens in the parser too")
+	require.NoError(t, err)
+	bug3, _, err := cache.NewBug("typo", "nothing to see")
+	require.NoError(t, err)
+
+	_, err = bug3.AddComment("the PARSER is fine, no panic here")
+	require.NoError(t, err)
+	require.NoError(t, bug3.Commit())
+
+	search := func(cache *RepoCache, qStr string) []entity.Id {
+		q, err := query.Parse(qStr)
+		require.NoError(t, err)
+		return cache.QueryBugs(q)
+	}
+
+	// words match anywhere, title matches rank first
+	require.Equal(t, []entity.Id{bug1.Id(), bug2.Id(), bug3.Id()}, search(cache, "panic parser"))
+	require.Equal(t, []entity.Id{bug2.Id(), bug1.Id()}, search(cache, "startup"))
+
+	// phrases need the words in order
+	require.ElementsMatch(t, []entity.Id{bug1.Id(), bug2.Id()}, search(cache, `"in the parser"`))
+	require.Empty(t, search(cache, `"parser panic"`))
+
+	// combined with regular filters
+	_, err = bug2.Close()
+	require.NoError(t, err)
+	require.NoError(t, bug2.Commit())
+	require.ElementsMatch(t, []entity.Id{bug1.Id(), bug3.Id()}, search(cache, "panic status:open"))
+
+	// the index follows the edits
+	_, err = bug1.SetTitle("broken tokenizer")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit())
+	require.Equal(t, []entity.Id{bug1.Id()}, search(cache, "tokenizer"))
+	require.ElementsMatch(t, []entity.Id{bug2.Id(), bug3.Id()}, search(cache, "panic"))
+
+	err = cache.RemoveBug(bug1.Id().String())
+	require.NoError(t, err)
+	require.Empty(t, search(cache, "tokenizer"))
+
+	// the index is persisted
+	require.NoError(t, cache.Close())
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{bug2.Id(), bug3.Id()}, search(cache, "parser"))
+}
+
 func TestPushPull(t *testing.T) {
 	repoA, repoB, remote := repository.SetupReposAndRemote()
 	defer repository.CleanupTestRepos(repoA, repoB, remote)
@@ -272,3 +337,508 @@ func checkBugPresence(t *testing.T, cache *RepoCache, bug *BugCache, presence bo
 		require.Equal(t, bug, b)
 	}
 }
+
+func TestDateAndNumericQualifiers(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	date := func(s string) int64 {
+		d, err := time.ParseInLocation("2006-01-02", s, time.Local)
+		require.NoError(t, err)
+		return d.Unix()
+	}
+
+	bug1, _, err := cache.NewBugRaw(iden, date("2026-01-10"), "old", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = bug1.CloseRaw(iden, date("2026-09-15"), nil)
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit())
+
+	bug2, _, err := cache.NewBugRaw(iden, date("2026-03-01"), "commented", "message", nil, nil)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = bug2.AddCommentRaw(iden, date("2026-03-02"), fmt.Sprintf("comment %d", i), nil, nil)
+		require.NoError(t, err)
+	}
+	require.NoError(t, bug2.Commit())
+
+	bug3, _, err := cache.NewBugRaw(iden, date("2026-10-01"), "reopened", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = bug3.CloseRaw(iden, date("2026-10-02"), nil)
+	require.NoError(t, err)
+	_, err = bug3.OpenRaw(iden, date("2026-10-03"), nil)
+	require.NoError(t, err)
+	require.NoError(t, bug3.Commit())
+
+	search := func(qStr string) []entity.Id {
+		q, err := query.Parse(qStr)
+		require.NoError(t, err)
+		return cache.QueryBugs(q)
+	}
+
+	require.ElementsMatch(t, []entity.Id{bug2.Id(), bug3.Id()}, search("created:>2026-01"))
+	require.ElementsMatch(t, []entity.Id{bug1.Id(), bug2.Id()}, search("created:2026-01..2026-03"))
+	require.ElementsMatch(t, []entity.Id{bug1.Id()}, search("created:2026-01-10"))
+	require.ElementsMatch(t, []entity.Id{bug2.Id()}, search("edited:<2026-09"))
+	require.ElementsMatch(t, []entity.Id{bug2.Id()}, search("comments:>2"))
+	require.ElementsMatch(t, []entity.Id{bug1.Id(), bug3.Id()}, search("comments:0"))
+	require.ElementsMatch(t, []entity.Id{bug1.Id()}, search("closed:2026-09..2026-10"))
+	require.ElementsMatch(t, []entity.Id{bug1.Id()}, search("closed:*..*"))
+}
+
+func TestAssignees(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(rene)
+	require.NoError(t, err)
+	isaac, err := cache.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, err)
+
+	bug1, _, err := cache.NewBug("assigned", "message")
+	require.NoError(t, err)
+	bug2, _, err := cache.NewBug("unassigned", "message")
+	require.NoError(t, err)
+
+	_, err = bug1.ChangeAssignees([]*IdentityCache{rene, isaac}, nil)
+	require.NoError(t, err)
+	_, err = bug1.ChangeAssignees(nil, []*IdentityCache{rene})
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit())
+
+	search := func(qStr string) []entity.Id {
+		q, err := query.Parse(qStr)
+		require.NoError(t, err)
+		return cache.QueryBugs(q)
+	}
+
+	require.Equal(t, []entity.Id{bug1.Id()}, search("assignee:newton"))
+	require.Empty(t, search("assignee:descartes"))
+	require.Equal(t, []entity.Id{bug2.Id()}, search("no:assignee"))
+
+	// the assignees are resolved when reading the bug back
+	require.NoError(t, cache.Close())
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+
+	b, err := cache.ResolveBug(bug1.Id())
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Assignees, 1)
+	require.Equal(t, "Isaac Newton", b.Snapshot().Assignees[0].Name())
+	require.Equal(t, []entity.Id{bug1.Id()}, search("assignee:newton"))
+}
+
+func TestMilestones(t *testing.T) {
+	repoA, repoB, remote := repository.SetupReposAndRemote()
+	defer repository.CleanupTestRepos(repoA, repoB, remote)
+
+	cacheA, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+
+	rene, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cacheA.SetUserIdentity(rene)
+	require.NoError(t, err)
+
+	v1, err := cacheA.NewMilestone("v1.0", "first release", time.Now().Add(24*time.Hour))
+	require.NoError(t, err)
+	_, err = cacheA.NewMilestone("v2.0", "", time.Time{})
+	require.NoError(t, err)
+
+	bug1, _, err := cacheA.NewBug("planned", "message")
+	require.NoError(t, err)
+	bug2, _, err := cacheA.NewBug("unplanned", "message")
+	require.NoError(t, err)
+
+	_, err = bug1.SetMilestone(v1)
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit())
+
+	search := func(c *RepoCache, qStr string) []entity.Id {
+		q, err := query.Parse(qStr)
+		require.NoError(t, err)
+		return c.QueryBugs(q)
+	}
+
+	require.Equal(t, []entity.Id{bug1.Id()}, search(cacheA, "milestone:v1"))
+	require.Empty(t, search(cacheA, "milestone:v2"))
+	require.Equal(t, []entity.Id{bug2.Id()}, search(cacheA, "no:milestone"))
+
+	excerpts := cacheA.AllMilestoneExcerpts()
+	require.Len(t, excerpts, 2)
+	require.Equal(t, "v1.0", excerpts[0].Title)
+
+	// the excerpt follow the changes
+	err = v1.Mutate(func(orig milestone.Mutator) milestone.Mutator {
+		orig.Status = bug.ClosedStatus
+		return orig
+	})
+	require.NoError(t, err)
+	require.NoError(t, v1.Commit())
+
+	excerpt, err := cacheA.ResolveMilestoneExcerpt(v1.Id())
+	require.NoError(t, err)
+	require.Equal(t, bug.ClosedStatus, excerpt.Status)
+
+	// A --> remote --> B
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+
+	cacheB, err := NewRepoCache(repoB)
+	require.NoError(t, err)
+	err = cacheB.Pull("origin")
+	require.NoError(t, err)
+
+	require.Len(t, cacheB.AllMilestoneIds(), 2)
+	require.Equal(t, []entity.Id{bug1.Id()}, search(cacheB, "milestone:v1"))
+
+	m, err := cacheB.ResolveMilestonePrefix(v1.Id().Human())
+	require.NoError(t, err)
+	require.Equal(t, "first release", m.Description())
+	require.Equal(t, bug.ClosedStatus, m.Status())
+}
+
+func TestLinks(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(rene)
+	require.NoError(t, err)
+
+	blocker, _, err := cache.NewBug("blocker", "message")
+	require.NoError(t, err)
+	blocked, _, err := cache.NewBug("blocked", "message")
+	require.NoError(t, err)
+	related, _, err := cache.NewBug("related", "message")
+	require.NoError(t, err)
+
+	_, err = blocker.ChangeLinks([]bug.Link{
+		{Type: bug.LinkBlocks, Target: blocked.Id()},
+		{Type: bug.LinkRelatesTo, Target: related.Id()},
+	}, nil)
+	require.NoError(t, err)
+	require.NoError(t, blocker.Commit())
+
+	_, err = blocker.ChangeLinks([]bug.Link{{Type: bug.LinkRelatesTo, Target: blocker.Id()}}, nil)
+	require.Error(t, err)
+
+	require.Equal(t, []bug.Link{{Type: bug.LinkBlockedBy, Target: blocker.Id()}}, cache.LinksTo(blocked.Id()))
+	require.Equal(t, []bug.Link{{Type: bug.LinkBlockedBy, Target: blocker.Id()}}, blocked.AllLinks())
+	require.Equal(t, []bug.Link{{Type: bug.LinkRelatesTo, Target: blocker.Id()}}, related.AllLinks())
+	require.Len(t, blocker.AllLinks(), 2)
+
+	// reverse links survive reloading the cache
+	require.NoError(t, cache.Close())
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	require.Equal(t, []bug.Link{{Type: bug.LinkBlockedBy, Target: blocker.Id()}}, cache.LinksTo(blocked.Id()))
+}
+
+func TestWorkflowStatuses(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.status.triage.category", "open"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.status.triage.transitions", "in-progress, closed"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.status.in-progress.category", "open"))
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(rene)
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	_, err = b.SetStatus("unknown", "")
+	require.Error(t, err)
+	_, err = b.SetStatus("open", "")
+	require.Error(t, err)
+
+	_, err = b.SetStatus("triage", "")
+	require.NoError(t, err)
+	_, err = b.SetStatus("open", "")
+	require.Error(t, err)
+	_, err = b.SetStatus("in-progress", "")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	require.Equal(t, bug.OpenStatus, b.Snapshot().Status)
+	require.Equal(t, "in-progress", b.Snapshot().StatusName())
+
+	q, err := query.ParseWithSaved(cache, "status:in-progress")
+	require.NoError(t, err)
+	require.Len(t, cache.QueryBugs(q), 1)
+
+	q, err = query.ParseWithSaved(cache, "status:open")
+	require.NoError(t, err)
+	require.Len(t, cache.QueryBugs(q), 1)
+
+	q, err = query.ParseWithSaved(cache, "status:triage")
+	require.NoError(t, err)
+	require.Len(t, cache.QueryBugs(q), 0)
+
+	_, err = query.ParseWithSaved(cache, "status:blocked")
+	require.Error(t, err)
+
+	_, err = b.SetStatus("closed", bug.ResolutionWontFix)
+	require.NoError(t, err)
+	require.Equal(t, "closed", b.Snapshot().StatusName())
+	require.Equal(t, bug.ResolutionWontFix, b.Snapshot().Resolution)
+
+	q, err = query.Parse("resolution:wontfix")
+	require.NoError(t, err)
+	require.Len(t, cache.QueryBugs(q), 1)
+
+	q, err = query.Parse("resolution:fixed")
+	require.NoError(t, err)
+	require.Len(t, cache.QueryBugs(q), 0)
+
+	// reopening clear the resolution
+	_, err = b.Open()
+	require.NoError(t, err)
+	require.Equal(t, bug.Resolution(""), b.Snapshot().Resolution)
+
+	q, err = query.Parse("resolution:wontfix")
+	require.NoError(t, err)
+	require.Len(t, cache.QueryBugs(q), 0)
+
+	// open and close follow the transitions as well
+	_, err = b.Open()
+	require.Error(t, err)
+	_, err = b.SetStatus("triage", "")
+	require.NoError(t, err)
+	_, err = b.Open()
+	require.Error(t, err)
+	_, err = b.CloseWithResolution(bug.ResolutionFixed)
+	require.NoError(t, err)
+	_, err = b.Close()
+	require.Error(t, err)
+
+	// but not the importers, mirroring the remote
+	_, err = b.SetStatus("triage", "")
+	require.NoError(t, err)
+	_, err = b.ImportStatusRaw(rene, time.Now().Unix(), bug.OpenStatus, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, "open", b.Snapshot().StatusName())
+}
+
+func TestRedactAndPurgeComment(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(rene)
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	secret, err := b.AddComment("the token is s3cr3t")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	search := func(qStr string) []entity.Id {
+		q, err := query.Parse(qStr)
+		require.NoError(t, err)
+		return cache.QueryBugs(q)
+	}
+	require.Len(t, search("s3cr3t"), 1)
+
+	commentId, err := b.ResolveCommentPrefix(secret.Id().Human())
+	require.NoError(t, err)
+	require.Equal(t, secret.Id(), commentId)
+
+	_, err = b.RedactComment(commentId)
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	require.Empty(t, search("s3cr3t"))
+	require.True(t, b.Snapshot().Comments[1].Redacted)
+
+	purged, kept, err := cache.PurgeRedactedComments(b.Id())
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+	require.Equal(t, 0, kept)
+
+	b, err = cache.ResolveBug(b.Id())
+	require.NoError(t, err)
+	snap := b.Snapshot()
+	require.Len(t, snap.Comments, 2)
+	require.True(t, snap.Comments[1].Redacted)
+	require.NotEqual(t, secret.Id(), snap.Comments[1].Id())
+	require.Empty(t, search("s3cr3t"))
+}
+
+func TestNewBugFromTemplate(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.template.crash.title", "[crash]"))
+	require.NoError(t, repo.LocalConfig().StoreString("git-bug.template.crash.labels", "crash, needs-triage"))
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(rene)
+	require.NoError(t, err)
+
+	template, err := bug.LoadTemplate(cache, "crash")
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBugFromTemplate(template, "[crash] on startup", "message")
+	require.NoError(t, err)
+
+	snap := b.Snapshot()
+	require.Equal(t, "[crash] on startup", snap.Title)
+	require.Equal(t, []bug.Label{"crash", "needs-triage"}, snap.Labels)
+	// title, message and labels are part of the same commit
+	require.False(t, b.NeedCommit())
+	require.Len(t, snap.Operations, 2)
+	require.ElementsMatch(t, []bug.Label{"crash", "needs-triage"}, cache.ValidLabels())
+}
+
+func TestOperationMetadataKeepCommentId(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(rene)
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	// load the snapshot before adding the comment
+	require.Len(t, b.Snapshot().Comments, 1)
+
+	_, err = b.AddCommentRaw(rene, time.Now().Unix(), "comment", nil, map[string]string{"key": "value"})
+	require.NoError(t, err)
+
+	id, err := b.ResolveOperationWithMetadata("key", "value")
+	require.NoError(t, err)
+
+	// the comment have the id of the operation, including its metadata
+	comment, err := b.Snapshot().SearchComment(id)
+	require.NoError(t, err)
+	require.Equal(t, "comment", comment.Message)
+
+	_, err = b.EditCommentRaw(rene, time.Now().Unix(), id, "edited", nil)
+	require.NoError(t, err)
+	require.Equal(t, "edited", b.Snapshot().Comments[1].Message)
+
+	require.NoError(t, b.Commit())
+
+	comment, err = b.Snapshot().SearchComment(id)
+	require.NoError(t, err)
+	require.Equal(t, "edited", comment.Message)
+}
+
+func TestBugEvents(t *testing.T) {
+	repoA, repoB, remote := repository.SetupReposAndRemote()
+	defer repository.CleanupTestRepos(repoA, repoB, remote)
+
+	cacheA, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+
+	cacheB, err := NewRepoCache(repoB)
+	require.NoError(t, err)
+
+	reneA, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cacheA.SetUserIdentity(reneA)
+	require.NoError(t, err)
+
+	eventsA, unsubscribeA := cacheA.SubscribeBugEvents()
+	eventsB, unsubscribeB := cacheB.SubscribeBugEvents()
+
+	// local changes are published
+	bug1, _, err := cacheA.NewBug("bug1", "message")
+	require.NoError(t, err)
+
+	event := <-eventsA
+	require.Equal(t, bug1.Id(), event.Id)
+	require.Equal(t, "bug1", event.Snapshot.Title)
+	created := event
+
+	_, err = bug1.SetTitle("new title")
+	require.NoError(t, err)
+
+	event = <-eventsA
+	require.Equal(t, bug1.Id(), event.Id)
+	require.Equal(t, "new title", event.Snapshot.Title)
+	require.Len(t, event.Snapshot.Timeline, 2)
+
+	// the excerpt of an event describe the same version as its snapshot
+	q, err := query.Parse("title:bug1")
+	require.NoError(t, err)
+	require.Equal(t, "bug1", created.Excerpt.Title)
+	require.True(t, cacheA.MatchBugExcerpt(q, created.Excerpt))
+	require.False(t, cacheA.MatchBugExcerpt(q, event.Excerpt))
+	require.False(t, cacheA.MatchBug(q, bug1.Id()))
+
+	err = bug1.Commit()
+	require.NoError(t, err)
+	<-eventsA
+
+	// as well as the merges from a pull
+	_, err = cacheA.Push("origin")
+	require.NoError(t, err)
+	err = cacheB.Pull("origin")
+	require.NoError(t, err)
+
+	event = <-eventsB
+	require.Equal(t, bug1.Id(), event.Id)
+	require.Equal(t, "new title", event.Snapshot.Title)
+
+	require.True(t, cacheA.MatchBug(nil, bug1.Id()))
+	require.True(t, cacheA.MatchBug(query.NewQuery(), bug1.Id()))
+	q, err = query.Parse("title:new")
+	require.NoError(t, err)
+	require.True(t, cacheA.MatchBug(q, bug1.Id()))
+	q, err = query.Parse("status:closed")
+	require.NoError(t, err)
+	require.False(t, cacheA.MatchBug(q, bug1.Id()))
+
+	// unsubscribing close the channel and stop the events
+	unsubscribeA()
+	_, ok := <-eventsA
+	require.False(t, ok)
+
+	_, err = bug1.Close()
+	require.NoError(t, err)
+
+	// closing the cache close the remaining channels
+	require.NoError(t, cacheB.Close())
+	_, ok = <-eventsB
+	require.False(t, ok)
+	unsubscribeB()
+}
@@ -0,0 +1,269 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const searchIndexFile = "search-index"
+
+// BM25 tuning parameters
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// A term found in the title weight as much as this many terms in a comment.
+const titleBoost = 2
+
+func searchIndexFilePath(repo repository.Repo) string {
+	return path.Join(repo.GetPath(), "git-bug", searchIndexFile)
+}
+
+// searchIndex is an inverted index of the words found in the title and the
+// comments of the bugs. It allows to answer full-text queries without having
+// to read and compile each raw bugs.
+type searchIndex struct {
+	// for each term, the sorted positions where it appears in each bug
+	Postings map[string]map[entity.Id][]int
+	// the distinct terms of each bug, to be able to remove a bug from the index
+	Terms map[entity.Id][]string
+	// the total number of terms of each bug
+	Lengths map[entity.Id]int
+	// the number of positions taken by the title of each bug, those come first
+	TitleLengths map[entity.Id]int
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		Postings:     make(map[string]map[entity.Id][]int),
+		Terms:        make(map[entity.Id][]string),
+		Lengths:      make(map[entity.Id]int),
+		TitleLengths: make(map[entity.Id]int),
+	}
+}
+
+// splitTerms break a text into lower cased terms suitable for the index
+func splitTerms(text string) []string {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for i, field := range fields {
+		fields[i] = strings.ToLower(field)
+	}
+	return fields
+}
+
+// add (re)index a bug from its snapshot
+func (si *searchIndex) add(id entity.Id, snap *bug.Snapshot) {
+	si.remove(id)
+
+	positions := make(map[string][]int)
+	pos := 0
+	length := 0
+
+	index := func(text string) {
+		for _, term := range splitTerms(text) {
+			positions[term] = append(positions[term], pos)
+			pos++
+			length++
+		}
+		// leave a gap so that a phrase can't match across two texts
+		pos++
+	}
+
+	index(snap.Title)
+	si.TitleLengths[id] = pos
+
+	for _, comment := range snap.Comments {
+		index(comment.Message)
+	}
+
+	terms := make([]string, 0, len(positions))
+	for term, p := range positions {
+		postings, ok := si.Postings[term]
+		if !ok {
+			postings = make(map[entity.Id][]int)
+			si.Postings[term] = postings
+		}
+		postings[id] = p
+		terms = append(terms, term)
+	}
+
+	si.Terms[id] = terms
+	si.Lengths[id] = length
+}
+
+// remove a bug from the index
+func (si *searchIndex) remove(id entity.Id) {
+	for _, term := range si.Terms[id] {
+		delete(si.Postings[term], id)
+		if len(si.Postings[term]) == 0 {
+			delete(si.Postings, term)
+		}
+	}
+	delete(si.Terms, id)
+	delete(si.Lengths, id)
+	delete(si.TitleLengths, id)
+}
+
+// matchPhrase return, for each bug, the starting positions where the
+// given terms appear in sequence.
+func (si *searchIndex) matchPhrase(terms []string) map[entity.Id][]int {
+	result := make(map[entity.Id][]int)
+
+	for id, starts := range si.Postings[terms[0]] {
+		var matching []int
+
+	nextStart:
+		for _, start := range starts {
+			for i, term := range terms[1:] {
+				positions := si.Postings[term][id]
+				want := start + i + 1
+				j := sort.SearchInts(positions, want)
+				if j == len(positions) || positions[j] != want {
+					continue nextStart
+				}
+			}
+			matching = append(matching, start)
+		}
+
+		if len(matching) > 0 {
+			result[id] = matching
+		}
+	}
+
+	return result
+}
+
+// search return the bugs mentioning the given term or phrase, with their
+// relevance score. It returns nil if the term doesn't hold any usable word.
+func (si *searchIndex) search(term string) map[entity.Id]float64 {
+	terms := splitTerms(term)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	totalDocs := float64(len(si.Lengths))
+	var totalLength float64
+	for _, length := range si.Lengths {
+		totalLength += float64(length)
+	}
+	avgLength := totalLength / math.Max(totalDocs, 1)
+
+	matches := si.matchPhrase(terms)
+
+	df := float64(len(matches))
+	idf := math.Log(1 + (totalDocs-df+0.5)/(df+0.5))
+
+	scores := make(map[entity.Id]float64, len(matches))
+	for id, starts := range matches {
+		var tf float64
+		for _, start := range starts {
+			if start < si.TitleLengths[id] {
+				tf += titleBoost
+			} else {
+				tf++
+			}
+		}
+
+		norm := 1 - bm25B + bm25B*float64(si.Lengths[id])/avgLength
+		scores[id] = idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+	}
+
+	return scores
+}
+
+// loadSearchIndex will try to read from the disk the search index file
+func (c *RepoCache) loadSearchIndex() error {
+	c.muBug.Lock()
+	defer c.muBug.Unlock()
+
+	f, err := os.Open(searchIndexFilePath(c.repo))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	decoder := gob.NewDecoder(f)
+
+	aux := struct {
+		Version uint
+		Index   *searchIndex
+	}{}
+
+	err = decoder.Decode(&aux)
+	if err != nil {
+		return err
+	}
+
+	if aux.Version != formatVersion {
+		return fmt.Errorf("unknown cache format version %v", aux.Version)
+	}
+
+	// gob doesn't transmit empty maps
+	index := newSearchIndex()
+	if aux.Index != nil {
+		if aux.Index.Postings != nil {
+			index.Postings = aux.Index.Postings
+		}
+		if aux.Index.Terms != nil {
+			index.Terms = aux.Index.Terms
+		}
+		if aux.Index.Lengths != nil {
+			index.Lengths = aux.Index.Lengths
+		}
+		if aux.Index.TitleLengths != nil {
+			index.TitleLengths = aux.Index.TitleLengths
+		}
+	}
+
+	c.searchIndex = index
+	return nil
+}
+
+// writeSearchIndex will serialize on disk the search index file
+func (c *RepoCache) writeSearchIndex() error {
+	c.muBug.RLock()
+	defer c.muBug.RUnlock()
+
+	var data bytes.Buffer
+
+	aux := struct {
+		Version uint
+		Index   *searchIndex
+	}{
+		Version: formatVersion,
+		Index:   c.searchIndex,
+	}
+
+	encoder := gob.NewEncoder(&data)
+
+	err := encoder.Encode(aux)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(searchIndexFilePath(c.repo))
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return f.Close()
+}
@@ -3,6 +3,8 @@ package commands
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/input"
 )
 
@@ -10,6 +12,7 @@ type addOptions struct {
 	title       string
 	message     string
 	messageFile string
+	template    string
 }
 
 func newAddCommand() *cobra.Command {
@@ -35,12 +38,23 @@ func newAddCommand() *cobra.Command {
 		"Provide a message to describe the issue")
 	flags.StringVarP(&options.messageFile, "file", "F", "",
 		"Take the message from the given file. Use - to read the message from the standard input")
+	flags.StringVarP(&options.template, "template", "T", "",
+		"Pre-fill the title and message and set the default labels from the given template")
 
 	return cmd
 }
 
 func runAdd(env *Env, opts addOptions) error {
 	var err error
+	var template bug.Template
+
+	if opts.template != "" {
+		template, err = bug.LoadTemplate(env.backend, opts.template)
+		if err != nil {
+			return err
+		}
+	}
+
 	if opts.messageFile != "" && opts.message == "" {
 		opts.title, opts.message, err = input.BugCreateFileInput(opts.messageFile)
 		if err != nil {
@@ -49,7 +63,15 @@ func runAdd(env *Env, opts addOptions) error {
 	}
 
 	if opts.messageFile == "" && (opts.message == "" || opts.title == "") {
-		opts.title, opts.message, err = input.BugCreateEditorInput(env.backend, opts.title, opts.message)
+		preTitle, preMessage := opts.title, opts.message
+		if preTitle == "" {
+			preTitle = template.Title
+		}
+		if preMessage == "" {
+			preMessage = template.Message
+		}
+
+		opts.title, opts.message, err = input.BugCreateEditorInput(env.backend, preTitle, preMessage)
 
 		if err == input.ErrEmptyTitle {
 			env.out.Println("Empty title, aborting.")
@@ -60,7 +82,12 @@ func runAdd(env *Env, opts addOptions) error {
 		}
 	}
 
-	b, _, err := env.backend.NewBug(opts.title, opts.message)
+	var b *cache.BugCache
+	if opts.template != "" {
+		b, _, err = env.backend.NewBugFromTemplate(template, opts.title, opts.message)
+	} else {
+		b, _, err = env.backend.NewBug(opts.title, opts.message)
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	_select "github.com/MichaelMure/git-bug/commands/select"
+)
+
+type assignOptions struct {
+	remove []string
+}
+
+func newAssignCommand() *cobra.Command {
+	env := newEnv()
+	options := assignOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "assign [ID] [USER...]",
+		Short: "Display, assign or unassign people to/from a bug.",
+		Long: `Display, assign or unassign people to/from a bug.
+
+USER can be an identity id prefix, or part of a name or login, as long as it match a single identity. Without any USER to assign or unassign, the current assignees are displayed.`,
+		Example: `Assign yourself and René Descartes to the selected bug:
+git bug assign $(git bug user --field id) "rené descartes"
+
+Hand over a bug from René to Isaac:
+git bug assign 9ed1a isaac --remove rené`,
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAssign(env, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringSliceVarP(&options.remove, "remove", "r", nil,
+		"Unassign the given user")
+
+	return cmd
+}
+
+func runAssign(env *Env, opts assignOptions, args []string) error {
+	b, args, err := _select.ResolveBug(env.backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 && len(opts.remove) == 0 {
+		for _, assignee := range b.Snapshot().Assignees {
+			env.out.Println(assignee.DisplayName())
+		}
+		return nil
+	}
+
+	added, err := resolveUsers(env.backend, args)
+	if err != nil {
+		return err
+	}
+	removed, err := resolveUsers(env.backend, opts.remove)
+	if err != nil {
+		return err
+	}
+
+	op, err := b.ChangeAssignees(added, removed)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range op.Added {
+		env.out.Printf("%s assigned\n", i.DisplayName())
+	}
+	for _, i := range op.Removed {
+		env.out.Printf("%s unassigned\n", i.DisplayName())
+	}
+
+	return b.Commit()
+}
+
+// resolveUsers find the identities matching the given id prefixes, names or logins
+func resolveUsers(backend *cache.RepoCache, queries []string) ([]*cache.IdentityCache, error) {
+	result := make([]*cache.IdentityCache, len(queries))
+
+	for i, query := range queries {
+		query := strings.ToLower(query)
+		id, err := backend.ResolveIdentityMatcher(func(excerpt *cache.IdentityExcerpt) bool {
+			return excerpt.Match(query)
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't find user \"%s\"", query)
+		}
+		result[i] = id
+	}
+
+	return result, nil
+}
@@ -25,6 +25,8 @@ func newBridgeCommand() *cobra.Command {
 	cmd.AddCommand(newBridgePullCommand())
 	cmd.AddCommand(newBridgePushCommand())
 	cmd.AddCommand(newBridgeRm())
+	cmd.AddCommand(newBridgeServeCommand())
+	cmd.AddCommand(newBridgeSyncCommand())
 
 	return cmd
 }
@@ -42,6 +42,9 @@ func runBridgeAuthShow(env *Env, args []string) error {
 	switch cred := cred.(type) {
 	case *auth.Token:
 		env.out.Printf("Value: %s\n", cred.Value)
+	case *auth.OAuth1:
+		env.out.Printf("Consumer key: %s\n", cred.ConsumerKey)
+		env.out.Printf("Token: %s\n", cred.Token)
 	}
 
 	env.out.Println("Metadata:")
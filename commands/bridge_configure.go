@@ -32,12 +32,15 @@ func newBridgeConfigureCommand() *cobra.Command {
 		Short: "Configure a new bridge.",
 		Long: `	Configure a new bridge by passing flags or/and using interactive terminal prompts. You can avoid all the terminal prompts by passing all the necessary flags to configure your bridge.`,
 		Example: `# Interactive example
-[1]: github
-[2]: gitlab
-[3]: jira
-[4]: launchpad-preview
-
-target: 1
+[1]: bugzilla
+[2]: email
+[3]: gitea
+[4]: github
+[5]: gitlab
+[6]: jira
+[7]: launchpad-preview
+
+target: 4
 name [default]: default
 
 Detected projects:
@@ -83,7 +86,27 @@ git bug bridge configure \
     --name=default \
     --target=github \
     --url=https://github.com/michaelmure/git-bug \
-    --token=$(TOKEN)`,
+    --token=$(TOKEN)
+
+# For Gitea or Forgejo
+git bug bridge configure \
+    --name=default \
+    --target=gitea \
+    --url=https://gitea.example.com/michaelmure/git-bug \
+    --token=$(TOKEN)
+
+# For Bugzilla
+git bug bridge configure \
+    --name=default \
+    --target=bugzilla \
+    --url=https://bugzilla.example.com/buglist.cgi?product=git-bug \
+    --token=$(API_KEY)
+
+# For a mailing list archive, as a mbox file or a Maildir
+git bug bridge configure \
+    --name=default \
+    --target=email \
+    --url=/var/mail/bugs.mbox`,
 		PreRunE:  loadBackend(env),
 		PostRunE: closeBackend(env),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -105,6 +128,7 @@ git bug bridge configure \
 	flags.BoolVar(&options.tokenStdin, "token-stdin", false, "Will read the token from stdin and ignore --token")
 	flags.StringVarP(&options.params.Owner, "owner", "o", "", "The owner of the remote repository")
 	flags.StringVarP(&options.params.Project, "project", "p", "", "The name of the remote repository")
+	flags.StringVar(&options.params.WebhookSecret, "webhook-secret", "", "The secret authenticating the webhooks of the remote issue tracker (see \"git-bug bridge serve\")")
 
 	return cmd
 }
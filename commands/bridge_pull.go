@@ -19,6 +19,7 @@ import (
 type bridgePullOptions struct {
 	importSince string
 	noResume    bool
+	dryRun      bool
 }
 
 func newBridgePullCommand() *cobra.Command {
@@ -26,8 +27,17 @@ func newBridgePullCommand() *cobra.Command {
 	options := bridgePullOptions{}
 
 	cmd := &cobra.Command{
-		Use:      "pull [NAME]",
-		Short:    "Pull updates.",
+		Use:   "pull [NAME]",
+		Short: "Pull updates.",
+		Long: `Pull updates.
+
+Available bridge config:
+  git-bug.bridge.<name>.import-labels [labels]: only import the remote issues with one of these comma separated labels
+  git-bug.bridge.<name>.import-state [open|closed]: only import the remote issues in this state
+  git-bug.bridge.<name>.import-authors [logins]: only import the remote issues opened by one of these comma separated logins
+
+The import filters only select the new issues, the ones already imported are always kept up to date.
+`,
 		PreRunE:  loadBackend(env),
 		PostRunE: closeBackend(env),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -41,6 +51,7 @@ func newBridgePullCommand() *cobra.Command {
 
 	flags.BoolVarP(&options.noResume, "no-resume", "n", false, "force importing all bugs")
 	flags.StringVarP(&options.importSince, "since", "s", "", "import only bugs updated after the given date (ex: \"200h\" or \"june 2 2019\")")
+	flags.BoolVar(&options.dryRun, "dry-run", false, "only show the changes that would be imported, without writing anything")
 
 	return cmd
 }
@@ -63,6 +74,8 @@ func runBridgePull(env *Env, opts bridgePullOptions, args []string) error {
 		return err
 	}
 
+	b.SetDryRun(opts.dryRun)
+
 	parentCtx := context.Background()
 	ctx, cancel := context.WithCancel(parentCtx)
 	defer cancel()
@@ -135,7 +148,11 @@ func runBridgePull(env *Env, opts bridgePullOptions, args []string) error {
 		}
 	}
 
-	env.out.Printf("imported %d issues and %d identities with %s bridge\n", importedIssues, importedIdentities, b.Name)
+	if opts.dryRun {
+		env.out.Printf("dry-run: would import %d issues and %d identities with %s bridge\n", importedIssues, importedIdentities, b.Name)
+	} else {
+		env.out.Printf("imported %d issues and %d identities with %s bridge\n", importedIssues, importedIdentities, b.Name)
+	}
 
 	// send done signal
 	close(done)
@@ -13,24 +13,39 @@ import (
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
+type bridgePushOptions struct {
+	dryRun bool
+}
+
 func newBridgePushCommand() *cobra.Command {
 	env := newEnv()
+	options := bridgePushOptions{}
 
 	cmd := &cobra.Command{
-		Use:      "push [NAME]",
-		Short:    "Push updates.",
+		Use:   "push [NAME]",
+		Short: "Push updates.",
+		Long: `Push updates.
+
+Available bridge config:
+  git-bug.bridge.<name>.export-query [query]: only export the bugs matching this query (ex: "label:public")
+`,
 		PreRunE:  loadBackendEnsureUser(env),
 		PostRunE: closeBackend(env),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runBridgePush(env, args)
+			return runBridgePush(env, options, args)
 		},
 		Args: cobra.MaximumNArgs(1),
 	}
 
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVar(&options.dryRun, "dry-run", false, "only show the changes that would be exported, without writing anything")
+
 	return cmd
 }
 
-func runBridgePush(env *Env, args []string) error {
+func runBridgePush(env *Env, opts bridgePushOptions, args []string) error {
 	var b *core.Bridge
 	var err error
 
@@ -44,6 +59,8 @@ func runBridgePush(env *Env, args []string) error {
 		return err
 	}
 
+	b.SetDryRun(opts.dryRun)
+
 	parentCtx := context.Background()
 	ctx, cancel := context.WithCancel(parentCtx)
 	defer cancel()
@@ -79,7 +96,7 @@ func runBridgePush(env *Env, args []string) error {
 
 	exportedIssues := 0
 	for result := range events {
-		if result.Event != core.ExportEventNothing {
+		if result.Event != core.ExportEventNothing || result.DryRun {
 			env.out.Println(result.String())
 		}
 
@@ -89,7 +106,11 @@ func runBridgePush(env *Env, args []string) error {
 		}
 	}
 
-	env.out.Printf("exported %d issues with %s bridge\n", exportedIssues, b.Name)
+	if opts.dryRun {
+		env.out.Printf("dry-run: would export %d issues with %s bridge\n", exportedIssues, b.Name)
+	} else {
+		env.out.Printf("exported %d issues with %s bridge\n", exportedIssues, b.Name)
+	}
 
 	// send done signal
 	close(done)
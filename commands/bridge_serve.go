@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	httpapi "github.com/MichaelMure/git-bug/api/http"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+type bridgeServeOptions struct {
+	host string
+	port int
+}
+
+func newBridgeServeCommand() *cobra.Command {
+	env := newEnv()
+	options := bridgeServeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Receive the webhooks of the remote bug trackers.",
+		Long: `Receive the webhooks of the remote bug trackers, and import the issues they notify a change of.
+
+The webhooks of a bridge are expected on /webhook/<bridge name>, with the secret
+given with "git bug bridge configure --webhook-secret". The webhooks are also
+received by the web UI, on the same path.
+
+Supported bridges: github, gitlab, gitea
+`,
+		PreRunE: loadRepo(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBridgeServe(env, options)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVar(&options.host, "host", "127.0.0.1", "Network address to listen to")
+	flags.IntVarP(&options.port, "port", "p", 8080, "Port to listen to")
+
+	return cmd
+}
+
+func runBridgeServe(env *Env, opts bridgeServeOptions) error {
+	addr := fmt.Sprintf("%s:%d", opts.host, opts.port)
+
+	mrc := cache.NewMultiRepoCache()
+	_, err := mrc.RegisterDefaultRepository(env.repo)
+	if err != nil {
+		return err
+	}
+
+	router := mux.NewRouter()
+	router.Path("/webhook/{bridge}").Methods("POST").Handler(httpapi.NewWebhookHandler(mrc))
+
+	srv := &http.Server{
+		Addr:    addr,
+		Handler: router,
+	}
+
+	done := make(chan bool)
+	quit := make(chan os.Signal, 1)
+
+	// register as handler of the interrupt signal to trigger the teardown
+	signal.Notify(quit, os.Interrupt)
+
+	go func() {
+		<-quit
+		env.out.Println("Shutting down...")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		srv.SetKeepAlivesEnabled(false)
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Fatalf("Could not gracefully shutdown the server: %v\n", err)
+		}
+
+		err := mrc.Close()
+		if err != nil {
+			env.out.Println(err)
+		}
+
+		close(done)
+	}()
+
+	env.out.Printf("Webhooks: http://%s/webhook/<bridge name>\n", addr)
+	env.out.Println("Press Ctrl+c to quit")
+
+	err = srv.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
+		return err
+	}
+
+	<-done
+
+	env.out.Println("Server stopped")
+	return nil
+}
@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bridge"
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+type bridgeSyncOptions struct {
+	watch    bool
+	interval time.Duration
+	status   bool
+}
+
+func newBridgeSyncCommand() *cobra.Command {
+	env := newEnv()
+	options := bridgeSyncOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Pull then push the updates of all the bridges.",
+		Long: `Pull then push the updates of all the bridges.
+
+Without --watch, the command fails if a bridge can't be synchronized. With
+--watch, the bridges are synchronized again after their interval until
+interrupted. A failed synchronization is retried with an exponential backoff.
+
+Available bridge config:
+  git-bug.bridge.<name>.sync-interval [duration]: interval between two synchronizations of the bridge, overriding --interval
+`,
+		PreRunE:  loadBackend(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBridgeSync(env, options)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVarP(&options.watch, "watch", "w", false, "Keep synchronizing the bridges until interrupted")
+	flags.DurationVarP(&options.interval, "interval", "i", 5*time.Minute, "Default interval between two synchronizations of a bridge")
+	flags.BoolVarP(&options.status, "status", "s", false, "Display the status of the last synchronizations, without synchronizing")
+
+	return cmd
+}
+
+func runBridgeSync(env *Env, opts bridgeSyncOptions) error {
+	if opts.status {
+		return runBridgeSyncStatus(env)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// buffered channel to avoid send block at the end
+	done := make(chan struct{}, 1)
+
+	var mu sync.Mutex
+	interruptCount := 0
+	interrupt.RegisterCleaner(func() error {
+		mu.Lock()
+		if interruptCount > 0 {
+			env.err.Println("Received another interrupt before graceful stop, terminating...")
+			os.Exit(0)
+		}
+
+		interruptCount++
+		mu.Unlock()
+
+		env.err.Println("Received interrupt signal, stopping the synchronization...\n(Hit ctrl-c again to kill the process.)")
+
+		// send signal to stop the synchronization
+		cancel()
+
+		// block until the synchronization gracefully shutdown
+		<-done
+		return nil
+	})
+
+	results, err := core.SyncAll(ctx, env.backend, opts.interval, opts.watch)
+	if err != nil {
+		return err
+	}
+
+	var fatal error
+	var failed []string
+
+	for result := range results {
+		if result.Name == "" {
+			fatal = result.Err
+			continue
+		}
+
+		env.out.Println(result)
+		if opts.watch {
+			env.out.Printf("%s: next sync at %s\n", result.Name, result.Next.Format(time.Kitchen))
+		} else if result.Err != nil {
+			failed = append(failed, result.Name)
+		}
+	}
+
+	// send done signal
+	close(done)
+
+	if fatal != nil {
+		return fatal
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("the synchronization failed for %s", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+func runBridgeSyncStatus(env *Env) error {
+	configured, err := bridge.ConfiguredBridges(env.backend)
+	if err != nil {
+		return err
+	}
+
+	status, err := core.LoadSyncStatus(env.backend)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range configured {
+		s, ok := status[name]
+		switch {
+		case !ok:
+			env.out.Printf("%s: never synchronized\n", name)
+		case s.LastSuccess.IsZero():
+			env.out.Printf("%s: never succeeded\n", name)
+		default:
+			env.out.Printf("%s: last success %s\n", name, s.LastSuccess.Format(time.RFC1123))
+		}
+		if ok && s.Failures > 0 {
+			env.out.Printf("  %d failures since, last at %s: %s\n", s.Failures, s.LastSync.Format(time.RFC1123), s.LastError)
+		}
+	}
+
+	return nil
+}
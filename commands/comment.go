@@ -22,6 +22,8 @@ func newCommentCommand() *cobra.Command {
 	}
 
 	cmd.AddCommand(newCommentAddCommand())
+	cmd.AddCommand(newCommentRedactCommand())
+	cmd.AddCommand(newCommentPurgeCommand())
 
 	return cmd
 }
@@ -42,7 +44,11 @@ func runComment(env *Env, args []string) error {
 		env.out.Printf("Author: %s\n", colors.Magenta(comment.Author.DisplayName()))
 		env.out.Printf("Id: %s\n", colors.Cyan(comment.Id().Human()))
 		env.out.Printf("Date: %s\n\n", comment.FormatTime())
-		env.out.Println(text.LeftPadLines(comment.Message, 4))
+		if comment.Redacted {
+			env.out.Println(text.LeftPadLines("This comment has been redacted.", 4))
+		} else {
+			env.out.Println(text.LeftPadLines(comment.Message, 4))
+		}
 	}
 
 	return nil
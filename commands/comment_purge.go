@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	_select "github.com/MichaelMure/git-bug/commands/select"
+)
+
+func newCommentPurgeCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:   "purge [ID]",
+		Short: "Rewrite the history of a bug to physically remove its redacted comments.",
+		Long: `Rewrite the git history of a bug to physically remove the content of its redacted comments.
+
+The first commit of a bug, holding its description, can't be rewritten as the bug id is derived from it.
+
+This is a destructive maintenance operation: the bug keeps its id but its history changes. The next "git bug push" to each remote force pushes the bug, replacing the old history, and the remote history of the bug is not merged back by "git bug pull" until then. The old git objects then have to be pruned in every clone for the content to be actually gone.`,
+		PreRunE:  loadBackend(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCommentPurge(env, args)
+		},
+	}
+
+	return cmd
+}
+
+func runCommentPurge(env *Env, args []string) error {
+	b, _, err := _select.ResolveBug(env.backend, args)
+	if err != nil {
+		return err
+	}
+
+	purged, kept, err := env.backend.PurgeRedactedComments(b.Id())
+	if err != nil {
+		return err
+	}
+
+	env.out.Printf("%d comment(s) purged from bug %s\n\n", purged, b.Id().Human())
+	if kept > 0 {
+		env.err.Printf("%d redacted comment(s) still have content in the first commit of the bug, which can't be rewritten without changing the bug id\n\n", kept)
+	}
+	env.out.Println("To complete the removal, push the bug to every remote, which force push it, and prune the old objects:")
+	env.out.Println("  git bug push <remote>")
+	env.out.Println("  git reflog expire --expire=now --all && git gc --prune=now")
+
+	return nil
+}
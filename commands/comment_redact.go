@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	_select "github.com/MichaelMure/git-bug/commands/select"
+)
+
+func newCommentRedactCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:   "redact [ID] COMMENT_ID",
+		Short: "Hide the content of a comment.",
+		Long: `Hide the current and previous versions of a comment, for instance after a secret was pasted by mistake.
+
+The content is still stored in the git history of the bug. Use "git bug comment purge" to physically remove it.`,
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCommentRedact(env, args)
+		},
+	}
+
+	return cmd
+}
+
+func runCommentRedact(env *Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		return errors.New("you must provide the id of the comment to redact")
+	}
+
+	commentId, err := b.ResolveCommentPrefix(args[0])
+	if err != nil {
+		return err
+	}
+
+	_, err = b.RedactComment(commentId)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
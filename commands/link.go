@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	_select "github.com/MichaelMure/git-bug/commands/select"
+)
+
+func newLinkCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:      "link [ID]",
+		Short:    "Display, add or remove links between bugs.",
+		PreRunE:  loadBackend(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLink(env, args)
+		},
+	}
+
+	cmd.AddCommand(newLinkAddCommand())
+	cmd.AddCommand(newLinkRmCommand())
+
+	return cmd
+}
+
+func runLink(env *Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.backend, args)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range b.AllLinks() {
+		env.out.Println(linkDescription(env, link))
+	}
+
+	return nil
+}
+
+// linkDescription return a human readable description of a link, including
+// the title of the target bug if known
+func linkDescription(env *Env, link bug.Link) string {
+	excerpt, err := env.backend.ResolveBugExcerpt(link.Target)
+	if err != nil {
+		return link.String()
+	}
+	return fmt.Sprintf("%s %s %s", link.Type.Description(), link.Target.Human(), excerpt.Title)
+}
+
+// parseLink build a link from the given type and target bug prefix
+func parseLink(env *Env, args []string) (bug.Link, error) {
+	if len(args) != 2 {
+		return bug.Link{}, errors.New("a link type and a target bug are required")
+	}
+
+	linkType, err := bug.LinkTypeFromString(args[0])
+	if err != nil {
+		return bug.Link{}, fmt.Errorf("%s, valid types are: %s", err, validLinkTypes())
+	}
+
+	target, err := env.backend.ResolveBugPrefix(args[1])
+	if err != nil {
+		return bug.Link{}, err
+	}
+
+	return bug.Link{Type: linkType, Target: target.Id()}, nil
+}
+
+func validLinkTypes() string {
+	types := make([]string, len(bug.LinkTypes))
+	for i, t := range bug.LinkTypes {
+		types[i] = t.String()
+	}
+	return strings.Join(types, ", ")
+}
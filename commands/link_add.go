@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	_select "github.com/MichaelMure/git-bug/commands/select"
+)
+
+type linkAddOptions struct {
+	close bool
+}
+
+func newLinkAddCommand() *cobra.Command {
+	env := newEnv()
+	options := linkAddOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add [ID] TYPE TARGET",
+		Short: "Add a link from a bug to another one.",
+		Long: `Add a link from a bug to another one.
+
+Valid link types are: ` + validLinkTypes() + `.`,
+		Example: `git bug link add 1234567 blocks 89abcde
+git bug link add 1234567 duplicate-of 89abcde --close`,
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLinkAdd(env, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVarP(&options.close, "close", "c", false,
+		"Close the bug as a duplicate when marking it as such")
+
+	return cmd
+}
+
+func runLinkAdd(env *Env, opts linkAddOptions, args []string) error {
+	b, args, err := _select.ResolveBug(env.backend, args)
+	if err != nil {
+		return err
+	}
+
+	link, err := parseLink(env, args)
+	if err != nil {
+		return err
+	}
+
+	if opts.close && link.Type != bug.LinkDuplicateOf {
+		return errors.New("--close can only be used when marking a duplicate")
+	}
+
+	_, err = b.ChangeLinks([]bug.Link{link}, nil)
+	if err != nil {
+		return err
+	}
+
+	if opts.close && b.Snapshot().Status != bug.ClosedStatus {
+		_, err = b.CloseWithResolution(bug.ResolutionDuplicate)
+		if err != nil {
+			return err
+		}
+	}
+
+	return b.Commit()
+}
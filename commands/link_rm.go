@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	_select "github.com/MichaelMure/git-bug/commands/select"
+)
+
+func newLinkRmCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:      "rm [ID] TYPE TARGET",
+		Short:    "Remove a link from a bug to another one.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLinkRm(env, args)
+		},
+	}
+
+	return cmd
+}
+
+func runLinkRm(env *Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.backend, args)
+	if err != nil {
+		return err
+	}
+
+	link, err := parseLink(env, args)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.ChangeLinks(nil, []bug.Link{link})
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
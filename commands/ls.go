@@ -17,13 +17,18 @@ import (
 )
 
 type lsOptions struct {
-	query query.Query
-
-	statusQuery   []string
-	noQuery       []string
-	sortBy        string
-	sortDirection string
-	outputFormat  string
+	statusQuery      []string
+	authorQuery      []string
+	participantQuery []string
+	actorQuery       []string
+	assigneeQuery    []string
+	milestoneQuery   []string
+	labelQuery       []string
+	titleQuery       []string
+	noQuery          []string
+	sortBy           string
+	sortDirection    string
+	outputFormat     string
 }
 
 func newLsCommand() *cobra.Command {
@@ -35,10 +40,29 @@ func newLsCommand() *cobra.Command {
 		Short: "List bugs.",
 		Long: `Display a summary of each bugs.
 
-You can pass an additional query to filter and order the list. This query can be expressed either with a simple query language or with flags.`,
+You can pass an additional query to filter and order the list. This query can be expressed either with a simple query language or with flags.
+
+Words without a qualifier are searched in the title and comments of the bugs. Queries saved with "git bug query save" can be referenced with "@NAME".
+
+An argument starting with "-" is read as a flag. To negate the first term of a query, use NOT or put the query after "--".`,
 		Example: `List open bugs sorted by last edition with a query:
 git bug ls status:open sort:edit-desc
 
+List open bugs mentioning a phrase, most relevant first:
+git bug ls status:open "panic in parser"
+
+List open bugs labeled as a bug or a crash, but not waiting for more information:
+git bug ls "status:open AND (label:bug OR label:crash) AND -label:needinfo"
+
+List the bugs without the wontfix label:
+git bug ls -- -label:wontfix
+
+List open bugs not edited for a month:
+git bug ls status:open "edited:<1m"
+
+List the bugs matching a saved query, with an additional filter:
+git bug ls @triage label:crash
+
 List closed bugs sorted by creation with flags:
 git bug ls --status closed --by creation
 `,
@@ -53,19 +77,23 @@ git bug ls --status closed --by creation
 	flags.SortFlags = false
 
 	flags.StringSliceVarP(&options.statusQuery, "status", "s", nil,
-		"Filter by status. Valid values are [open,closed]")
-	flags.StringSliceVarP(&options.query.Author, "author", "a", nil,
+		"Filter by status. Valid values are [open,closed] and the workflow statuses of the repository")
+	flags.StringSliceVarP(&options.authorQuery, "author", "a", nil,
 		"Filter by author")
-	flags.StringSliceVarP(&options.query.Participant, "participant", "p", nil,
+	flags.StringSliceVarP(&options.participantQuery, "participant", "p", nil,
 		"Filter by participant")
-	flags.StringSliceVarP(&options.query.Actor, "actor", "A", nil,
+	flags.StringSliceVarP(&options.actorQuery, "actor", "A", nil,
 		"Filter by actor")
-	flags.StringSliceVarP(&options.query.Label, "label", "l", nil,
+	flags.StringSliceVar(&options.assigneeQuery, "assignee", nil,
+		"Filter by assignee")
+	flags.StringSliceVarP(&options.milestoneQuery, "milestone", "m", nil,
+		"Filter by milestone")
+	flags.StringSliceVarP(&options.labelQuery, "label", "l", nil,
 		"Filter by label")
-	flags.StringSliceVarP(&options.query.Title, "title", "t", nil,
+	flags.StringSliceVarP(&options.titleQuery, "title", "t", nil,
 		"Filter by title")
 	flags.StringSliceVarP(&options.noQuery, "no", "n", nil,
-		"Filter by absence of something. Valid values are [label]")
+		"Filter by absence of something. Valid values are [label,assignee,milestone]")
 	flags.StringVarP(&options.sortBy, "by", "b", "creation",
 		"Sort the results by a characteristic. Valid values are [id,creation,edit]")
 	flags.StringVarP(&options.sortDirection, "direction", "d", "asc",
@@ -81,17 +109,24 @@ func runLs(env *Env, opts lsOptions, args []string) error {
 	var err error
 
 	if len(args) >= 1 {
-		q, err = query.Parse(strings.Join(args, " "))
+		if opts.hasFilterFlags() {
+			return fmt.Errorf("a query can't be combined with filter flags, use NOT or put a query starting with \"-\" after \"--\"")
+		}
+
+		q, err = query.ParseWithSaved(env.backend, joinQueryArgs(args))
 
 		if err != nil {
 			return err
 		}
 	} else {
-		err = completeQuery(&opts)
+		q, err = completeQuery(opts)
+		if err != nil {
+			return err
+		}
+		err = query.CheckWorkflowStatuses(env.backend, q)
 		if err != nil {
 			return err
 		}
-		q = &opts.query
 	}
 
 	allIds := env.backend.QueryBugs(q)
@@ -126,10 +161,13 @@ type JSONBugExcerpt struct {
 	EditTime   JSONTime `json:"edit_time"`
 
 	Status       string         `json:"status"`
+	Resolution   string         `json:"resolution,omitempty"`
 	Labels       []bug.Label    `json:"labels"`
 	Title        string         `json:"title"`
 	Actors       []JSONIdentity `json:"actors"`
 	Participants []JSONIdentity `json:"participants"`
+	Assignees    []JSONIdentity `json:"assignees"`
+	Milestone    string         `json:"milestone,omitempty"`
 	Author       JSONIdentity   `json:"author"`
 
 	Comments int               `json:"comments"`
@@ -144,7 +182,8 @@ func lsJsonFormatter(env *Env, bugExcerpts []*cache.BugExcerpt) error {
 			HumanId:    b.Id.Human(),
 			CreateTime: NewJSONTime(b.CreateTime(), b.CreateLamportTime),
 			EditTime:   NewJSONTime(b.EditTime(), b.EditLamportTime),
-			Status:     b.Status.String(),
+			Status:     b.StatusName(),
+			Resolution: b.Resolution.String(),
 			Labels:     b.Labels,
 			Title:      b.Title,
 			Comments:   b.LenComments,
@@ -175,6 +214,19 @@ func lsJsonFormatter(env *Env, bugExcerpts []*cache.BugExcerpt) error {
 			jsonBug.Participants[i] = NewJSONIdentityFromExcerpt(participant)
 		}
 
+		jsonBug.Assignees = make([]JSONIdentity, len(b.Assignees))
+		for i, element := range b.Assignees {
+			assignee, err := env.backend.ResolveIdentityExcerpt(element)
+			if err != nil {
+				return err
+			}
+			jsonBug.Assignees[i] = NewJSONIdentityFromExcerpt(assignee)
+		}
+
+		if b.Milestone != "" {
+			jsonBug.Milestone = b.Milestone.String()
+		}
+
 		jsonBugs[i] = jsonBug
 	}
 	jsonObject, _ := json.MarshalIndent(jsonBugs, "", "    ")
@@ -212,7 +264,7 @@ func lsDefaultFormatter(env *Env, bugExcerpts []*cache.BugExcerpt) error {
 
 		env.out.Printf("%s %s\t%s\t%s\t%s\n",
 			colors.Cyan(b.Id.Human()),
-			colors.Yellow(b.Status),
+			colors.Yellow(b.StatusName()),
 			titleFmt+labelsFmt,
 			colors.Magenta(authorFmt),
 			comments,
@@ -223,7 +275,7 @@ func lsDefaultFormatter(env *Env, bugExcerpts []*cache.BugExcerpt) error {
 
 func lsPlainFormatter(env *Env, bugExcerpts []*cache.BugExcerpt) error {
 	for _, b := range bugExcerpts {
-		env.out.Printf("%s [%s] %s\n", b.Id.Human(), b.Status, strings.TrimSpace(b.Title))
+		env.out.Printf("%s [%s] %s\n", b.Id.Human(), b.StatusName(), strings.TrimSpace(b.Title))
 	}
 	return nil
 }
@@ -239,10 +291,15 @@ func lsOrgmodeFormatter(env *Env, bugExcerpts []*cache.BugExcerpt) error {
 		return time.Format("[2006-01-02 Mon 15:05]")
 	}
 
-	env.out.Println("#+TODO: OPEN | CLOSED")
+	workflow, err := bug.LoadWorkflow(env.backend)
+	if err != nil {
+		return err
+	}
+
+	env.out.Println(orgTodoLine(workflow))
 
 	for _, b := range bugExcerpts {
-		status := strings.ToUpper(b.Status.String())
+		status := strings.ToUpper(b.StatusName())
 
 		var title string
 		if link, ok := b.CreateMetadata["github-url"]; ok {
@@ -302,49 +359,181 @@ func lsOrgmodeFormatter(env *Env, bugExcerpts []*cache.BugExcerpt) error {
 				participant.DisplayName(),
 			)
 		}
+
+		env.out.Printf("** Assignees:\n")
+		for _, element := range b.Assignees {
+			assignee, err := env.backend.ResolveIdentityExcerpt(element)
+			if err != nil {
+				return err
+			}
+
+			env.out.Printf(": %s %s\n",
+				assignee.Id.Human(),
+				assignee.DisplayName(),
+			)
+		}
 	}
 
 	return nil
 }
 
+// orgTodoLine return the org-mode header declaring the workflow statuses as
+// TODO keywords, the closed ones being the done states
+func orgTodoLine(workflow *bug.Workflow) string {
+	var open, closed []string
+	for _, status := range workflow.Statuses {
+		if status.Category == bug.ClosedStatus {
+			closed = append(closed, strings.ToUpper(status.Name))
+		} else {
+			open = append(open, strings.ToUpper(status.Name))
+		}
+	}
+	return fmt.Sprintf("#+TODO: %s | %s", strings.Join(open, " "), strings.Join(closed, " "))
+}
+
+// joinQueryArgs assemble the command arguments into a single query. As the
+// shell already removed the quotes, arguments holding a single value or phrase
+// with spaces are quoted again to keep them together. Arguments holding a
+// complete expression are kept as is.
+func joinQueryArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if !strings.ContainsAny(arg, " \t") || strings.ContainsAny(arg, "\"'") || !isSingleTerm(arg) {
+			quoted[i] = arg
+			continue
+		}
+		split := strings.SplitN(arg, ":", 2)
+		if len(split) == 2 && !strings.ContainsAny(split[0], " \t") {
+			quoted[i] = fmt.Sprintf("%s:\"%s\"", split[0], split[1])
+		} else {
+			quoted[i] = fmt.Sprintf("\"%s\"", arg)
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+// isSingleTerm tell if an argument holds a single qualifier or search term,
+// rather than an expression with operators, parenthesis or several qualifiers
+func isSingleTerm(arg string) bool {
+	for i, field := range strings.Fields(arg) {
+		switch field {
+		case "AND", "OR", "NOT", "-":
+			return false
+		}
+		if strings.ContainsAny(field, "()") {
+			return false
+		}
+		if i > 0 && (strings.HasPrefix(field, "-") || strings.Contains(field, ":")) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasFilterFlags tell if any filter was given with the flags. As cobra read
+// a negated query term like "-label:x" as a flag, a query mixed with filter
+// flags is usually a mistake.
+func (opts lsOptions) hasFilterFlags() bool {
+	return len(opts.statusQuery) > 0 ||
+		len(opts.authorQuery) > 0 ||
+		len(opts.participantQuery) > 0 ||
+		len(opts.actorQuery) > 0 ||
+		len(opts.assigneeQuery) > 0 ||
+		len(opts.milestoneQuery) > 0 ||
+		len(opts.labelQuery) > 0 ||
+		len(opts.titleQuery) > 0 ||
+		len(opts.noQuery) > 0
+}
+
 // Finish the command flags transformation into the query.Query
-func completeQuery(opts *lsOptions) error {
-	for _, str := range opts.statusQuery {
-		status, err := bug.StatusFromString(str)
-		if err != nil {
-			return err
+func completeQuery(opts lsOptions) (*query.Query, error) {
+	q := query.NewQuery()
+
+	var filters []query.Node
+
+	// values of a same flag are alternatives, except for assignees, labels and titles
+	alternatives := func(values []string, leaf func(value string) query.Node) {
+		if len(values) == 0 {
+			return
+		}
+		or := &query.Or{}
+		for _, value := range values {
+			or.Operands = append(or.Operands, leaf(value))
+		}
+		filters = append(filters, or)
+	}
+
+	if len(opts.statusQuery) > 0 {
+		or := &query.Or{}
+		for _, str := range opts.statusQuery {
+			status, err := query.ParseStatus(str)
+			if err != nil {
+				return nil, err
+			}
+			or.Operands = append(or.Operands, status)
 		}
-		opts.query.Status = append(opts.query.Status, status)
+		filters = append(filters, or)
+	}
+
+	alternatives(opts.authorQuery, func(value string) query.Node {
+		return &query.AuthorFilter{Author: value}
+	})
+	alternatives(opts.participantQuery, func(value string) query.Node {
+		return &query.ParticipantFilter{Participant: value}
+	})
+	alternatives(opts.actorQuery, func(value string) query.Node {
+		return &query.ActorFilter{Actor: value}
+	})
+	alternatives(opts.milestoneQuery, func(value string) query.Node {
+		return &query.MilestoneFilter{Milestone: value}
+	})
+
+	for _, assignee := range opts.assigneeQuery {
+		filters = append(filters, &query.AssigneeFilter{Assignee: assignee})
+	}
+	for _, label := range opts.labelQuery {
+		filters = append(filters, &query.LabelFilter{Label: label})
+	}
+	for _, title := range opts.titleQuery {
+		filters = append(filters, &query.TitleFilter{Title: title})
 	}
 
 	for _, no := range opts.noQuery {
 		switch no {
 		case "label":
-			opts.query.NoLabel = true
+			filters = append(filters, &query.NoLabelFilter{})
+		case "assignee":
+			filters = append(filters, &query.NoAssigneeFilter{})
+		case "milestone":
+			filters = append(filters, &query.NoMilestoneFilter{})
 		default:
-			return fmt.Errorf("unknown \"no\" filter %s", no)
+			return nil, fmt.Errorf("unknown \"no\" filter %s", no)
 		}
 	}
 
+	if len(filters) > 0 {
+		q.Filter = &query.And{Operands: filters}
+	}
+
 	switch opts.sortBy {
 	case "id":
-		opts.query.OrderBy = query.OrderById
+		q.OrderBy = query.OrderById
 	case "creation":
-		opts.query.OrderBy = query.OrderByCreation
+		q.OrderBy = query.OrderByCreation
 	case "edit":
-		opts.query.OrderBy = query.OrderByEdit
+		q.OrderBy = query.OrderByEdit
 	default:
-		return fmt.Errorf("unknown sort flag %s", opts.sortBy)
+		return nil, fmt.Errorf("unknown sort flag %s", opts.sortBy)
 	}
 
 	switch opts.sortDirection {
 	case "asc":
-		opts.query.OrderDirection = query.OrderAscending
+		q.OrderDirection = query.OrderAscending
 	case "desc":
-		opts.query.OrderDirection = query.OrderDescending
+		q.OrderDirection = query.OrderDescending
 	default:
-		return fmt.Errorf("unknown sort direction %s", opts.sortDirection)
+		return nil, fmt.Errorf("unknown sort direction %s", opts.sortDirection)
 	}
 
-	return nil
+	return q, nil
 }
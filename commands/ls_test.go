@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestJoinQueryArgs(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"status:open", "label:bug"}, "status:open label:bug"},
+		{[]string{"author:René Descartes"}, `author:"René Descartes"`},
+		{[]string{"status:open", "panic in parser"}, `status:open "panic in parser"`},
+		{[]string{`author:"René Descartes"`}, `author:"René Descartes"`},
+		{[]string{"status:open label:bug"}, "status:open label:bug"},
+		{[]string{"status:open AND (label:bug OR label:crash) AND -label:needinfo"},
+			"status:open AND (label:bug OR label:crash) AND -label:needinfo"},
+		{[]string{"label:bug", "NOT label:needinfo"}, "label:bug NOT label:needinfo"},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, joinQueryArgs(tc.args))
+	}
+}
+
+func TestLsQuery(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+
+	author, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(author))
+
+	newBug := func(title string, labels ...string) *cache.BugCache {
+		b, _, err := backend.NewBug(title, "message")
+		require.NoError(t, err)
+		if len(labels) > 0 {
+			_, _, err = b.ChangeLabels(labels, nil)
+			require.NoError(t, err)
+		}
+		return b
+	}
+
+	openBug := newBug("open bug", "bug")
+	openCrash := newBug("open crash", "crash", "needinfo")
+	newBug("open without label")
+	closedBug := newBug("closed bug", "bug")
+	_, err = closedBug.Close()
+	require.NoError(t, err)
+
+	ls := func(opts lsOptions, args ...string) (string, error) {
+		var buf bytes.Buffer
+		env := &Env{repo: repo, backend: backend, out: out{Writer: &buf}, err: out{Writer: &buf}}
+		opts.outputFormat = "plain"
+		err := runLs(env, opts, args)
+		return buf.String(), err
+	}
+
+	// the documented example
+	result, err := ls(lsOptions{}, "status:open AND (label:bug OR label:crash) AND -label:needinfo")
+	require.NoError(t, err)
+	require.Equal(t, openBug.Id().Human()+" [open] open bug\n", result)
+
+	result, err = ls(lsOptions{}, "status:open", "NOT label:bug", "label:crash")
+	require.NoError(t, err)
+	require.Equal(t, openCrash.Id().Human()+" [open] open crash\n", result)
+
+	// "-label:needinfo" without "--" is read as the -l flag
+	_, err = ls(lsOptions{labelQuery: []string{"abel:needinfo"}}, "status:open")
+	require.Error(t, err)
+
+	result, err = ls(lsOptions{}, "-label:needinfo", "label:crash")
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
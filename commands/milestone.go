@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+// layout of the due dates given on the command line
+const dueDateLayout = "2006-01-02"
+
+type milestoneOptions struct {
+	format string
+}
+
+func newMilestoneCommand() *cobra.Command {
+	env := newEnv()
+	options := milestoneOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "milestone",
+		Short: "List, create or edit milestones, and attach bugs to them.",
+		Long: `List, create or edit milestones, and attach bugs to them.
+
+MILESTONE can be a milestone id prefix or its exact title.`,
+		Example: `Plan a release and attach the selected bug to it:
+git bug milestone new --title v1.0 --due 2021-03-01
+git bug milestone set v1.0`,
+		PreRunE:  loadBackend(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMilestone(env, options)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newMilestoneCloseCommand())
+	cmd.AddCommand(newMilestoneEditCommand())
+	cmd.AddCommand(newMilestoneNewCommand())
+	cmd.AddCommand(newMilestoneOpenCommand())
+	cmd.AddCommand(newMilestoneSetCommand())
+	cmd.AddCommand(newMilestoneShowCommand())
+	cmd.AddCommand(newMilestoneUnsetCommand())
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.format, "format", "f", "default",
+		"Select the output formatting style. Valid values are [default,json]")
+
+	return cmd
+}
+
+func runMilestone(env *Env, opts milestoneOptions) error {
+	milestones := env.backend.AllMilestoneExcerpts()
+
+	switch opts.format {
+	case "json":
+		return milestoneJsonFormatter(env, milestones)
+	case "default":
+		return milestoneDefaultFormatter(env, milestones)
+	default:
+		return fmt.Errorf("unknown format %s", opts.format)
+	}
+}
+
+func milestoneDefaultFormatter(env *Env, milestones []*cache.MilestoneExcerpt) error {
+	for _, m := range milestones {
+		due := ""
+		if m.DueUnixTime != 0 {
+			due = m.DueDate().Format(dueDateLayout)
+		}
+
+		env.out.Printf("%s\t%s\t%-10s\t%s\n",
+			colors.Cyan(m.Id.Human()),
+			colors.Yellow(m.Status),
+			due,
+			m.Title,
+		)
+	}
+
+	return nil
+}
+
+type JSONMilestone struct {
+	Id      string    `json:"id"`
+	HumanId string    `json:"human_id"`
+	Title   string    `json:"title"`
+	Status  string    `json:"status"`
+	DueDate *JSONTime `json:"due_date,omitempty"`
+}
+
+func milestoneJsonFormatter(env *Env, milestones []*cache.MilestoneExcerpt) error {
+	jsonMilestones := make([]JSONMilestone, len(milestones))
+	for i, m := range milestones {
+		jsonMilestones[i] = JSONMilestone{
+			Id:      m.Id.String(),
+			HumanId: m.Id.Human(),
+			Title:   m.Title,
+			Status:  m.Status.String(),
+		}
+		if m.DueUnixTime != 0 {
+			due := NewJSONTime(m.DueDate(), 0)
+			jsonMilestones[i].DueDate = &due
+		}
+	}
+
+	jsonObject, _ := json.MarshalIndent(jsonMilestones, "", "    ")
+	env.out.Printf("%s\n", jsonObject)
+	return nil
+}
+
+// resolveMilestone find the milestone matching an id prefix or an exact title
+func resolveMilestone(backend *cache.RepoCache, query string) (*cache.MilestoneCache, error) {
+	m, err := backend.ResolveMilestoneMatcher(func(excerpt *cache.MilestoneExcerpt) bool {
+		return excerpt.Id.HasPrefix(query) || excerpt.Title == query
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't find milestone \"%s\"", query)
+	}
+	return m, nil
+}
+
+// parseDueDate parse a due date given on the command line. An empty value
+// means no due date.
+func parseDueDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	due, err := time.ParseInLocation(dueDateLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid due date \"%s\", expected YYYY-MM-DD", value)
+	}
+	return due, nil
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/milestone"
+)
+
+func newMilestoneCloseCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:      "close MILESTONE",
+		Short:    "Mark a milestone as closed.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMilestoneClose(env, args)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	return cmd
+}
+
+func runMilestoneClose(env *Env, args []string) error {
+	return setMilestoneStatus(env, args[0], bug.ClosedStatus)
+}
+
+func setMilestoneStatus(env *Env, query string, status bug.Status) error {
+	m, err := resolveMilestone(env.backend, query)
+	if err != nil {
+		return err
+	}
+
+	if m.Status() == status {
+		env.err.Printf("Milestone is already %s\n", status)
+		return nil
+	}
+
+	err = m.Mutate(func(orig milestone.Mutator) milestone.Mutator {
+		orig.Status = status
+		return orig
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.Commit()
+}
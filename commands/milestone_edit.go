@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/milestone"
+)
+
+type milestoneEditOptions struct {
+	title       string
+	description string
+	dueDate     string
+}
+
+func newMilestoneEditCommand() *cobra.Command {
+	env := newEnv()
+	options := milestoneEditOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "edit MILESTONE",
+		Short: "Edit the title, description or due date of a milestone.",
+		Example: `Postpone a milestone:
+git bug milestone edit v1.0 --due 2021-04-01
+
+Remove the due date:
+git bug milestone edit v1.0 --due ""`,
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMilestoneEdit(env, cmd, options, args)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.title, "title", "t", "",
+		"Change the title of the milestone")
+	flags.StringVarP(&options.description, "description", "d", "",
+		"Change the description of the milestone")
+	flags.StringVar(&options.dueDate, "due", "",
+		"Change the due date of the milestone, as YYYY-MM-DD. An empty value remove the due date")
+
+	return cmd
+}
+
+func runMilestoneEdit(env *Env, cmd *cobra.Command, opts milestoneEditOptions, args []string) error {
+	m, err := resolveMilestone(env.backend, args[0])
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+
+	var due time.Time
+	if flags.Changed("due") {
+		due, err = parseDueDate(opts.dueDate)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = m.Mutate(func(orig milestone.Mutator) milestone.Mutator {
+		if flags.Changed("title") {
+			orig.Title = opts.title
+		}
+		if flags.Changed("description") {
+			orig.Description = opts.description
+		}
+		if flags.Changed("due") {
+			orig.DueDate = due
+		}
+		return orig
+	})
+	if err != nil {
+		return err
+	}
+
+	if !m.NeedCommit() {
+		env.err.Println("No change, aborting.")
+		return nil
+	}
+
+	return m.Commit()
+}
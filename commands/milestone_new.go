@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/input"
+)
+
+type milestoneNewOptions struct {
+	title       string
+	description string
+	dueDate     string
+}
+
+func newMilestoneNewCommand() *cobra.Command {
+	env := newEnv()
+	options := milestoneNewOptions{}
+
+	cmd := &cobra.Command{
+		Use:      "new",
+		Short:    "Create a new milestone.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMilestoneNew(env, options)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.title, "title", "t", "",
+		"Provide a title for the milestone")
+	flags.StringVarP(&options.description, "description", "d", "",
+		"Provide a description for the milestone")
+	flags.StringVar(&options.dueDate, "due", "",
+		"Provide a due date for the milestone, as YYYY-MM-DD")
+
+	return cmd
+}
+
+func runMilestoneNew(env *Env, opts milestoneNewOptions) error {
+	var err error
+
+	if opts.title == "" {
+		opts.title, err = input.Prompt("Title", "title", input.Required)
+		if err != nil {
+			return err
+		}
+	}
+
+	due, err := parseDueDate(opts.dueDate)
+	if err != nil {
+		return err
+	}
+
+	m, err := env.backend.NewMilestone(opts.title, opts.description, due)
+	if err != nil {
+		return err
+	}
+
+	env.out.Printf("%s created\n", m.Id().Human())
+
+	return nil
+}
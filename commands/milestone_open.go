@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+)
+
+func newMilestoneOpenCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:      "open MILESTONE",
+		Short:    "Mark a milestone as open.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMilestoneOpen(env, args)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	return cmd
+}
+
+func runMilestoneOpen(env *Env, args []string) error {
+	return setMilestoneStatus(env, args[0], bug.OpenStatus)
+}
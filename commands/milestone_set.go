@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	_select "github.com/MichaelMure/git-bug/commands/select"
+)
+
+func newMilestoneSetCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:      "set [ID] MILESTONE",
+		Short:    "Attach a bug to a milestone.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMilestoneSet(env, args)
+		},
+		Args: cobra.RangeArgs(1, 2),
+	}
+
+	return cmd
+}
+
+func runMilestoneSet(env *Env, args []string) error {
+	b, args, err := _select.ResolveBug(env.backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("a single milestone is required")
+	}
+
+	m, err := resolveMilestone(env.backend, args[0])
+	if err != nil {
+		return err
+	}
+
+	_, err = b.SetMilestone(m)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/query"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func newMilestoneShowCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:      "show MILESTONE",
+		Short:    "Display the details of a milestone and its bugs.",
+		PreRunE:  loadBackend(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMilestoneShow(env, args)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	return cmd
+}
+
+func runMilestoneShow(env *Env, args []string) error {
+	m, err := resolveMilestone(env.backend, args[0])
+	if err != nil {
+		return err
+	}
+
+	env.out.Printf("%s %s\n", colors.Bold(m.Title()), colors.Cyan(m.Id().Human()))
+	env.out.Printf("%s created this milestone %s\n",
+		colors.Magenta(m.Author().DisplayName()),
+		m.CreateTime().Time().Format("Mon Jan 2 15:04:05 2006 +0200"),
+	)
+	env.out.Printf("status: %s\n", colors.Yellow(m.Status()))
+	if !m.DueDate().IsZero() {
+		env.out.Printf("due: %s\n", m.DueDate().Format(dueDateLayout))
+	}
+	if m.Description() != "" {
+		env.out.Printf("\n%s\n", m.Description())
+	}
+
+	q := query.NewQuery()
+	q.Filter = &query.MilestoneFilter{Milestone: m.Id().String()}
+
+	ids := env.backend.QueryBugs(q)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	env.out.Println()
+	for _, id := range ids {
+		b, err := env.backend.ResolveBugExcerpt(id)
+		if err != nil {
+			return err
+		}
+		env.out.Printf("%s\t%s\t%s\n",
+			colors.Cyan(b.Id.Human()),
+			colors.Yellow(b.StatusName()),
+			b.Title,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	_select "github.com/MichaelMure/git-bug/commands/select"
+)
+
+func newMilestoneUnsetCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:      "unset [ID]",
+		Short:    "Detach a bug from its milestone.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMilestoneUnset(env, args)
+		},
+	}
+
+	return cmd
+}
+
+func runMilestoneUnset(env *Env, args []string) error {
+	b, _, err := _select.ResolveBug(env.backend, args)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.SetMilestone(nil)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/notify"
+)
+
+type notifyOptions struct {
+	pull string
+}
+
+func newNotifyCommand() *cobra.Command {
+	env := newEnv()
+	options := notifyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "notify",
+		Short: "Send emails about the new activity on the bugs.",
+		Long: `Send emails about the new activity on the bugs to their participants and watchers.
+
+On the first run, the existing activity is only recorded and no email is sent.
+
+Available git config:
+  git-bug.notify.smtp-address [string]: address of the SMTP server, as host:port (required)
+  git-bug.notify.smtp-username [string]: username to authenticate to the SMTP server
+  git-bug.notify.smtp-password [string]: password to authenticate to the SMTP server
+  git-bug.notify.from [string]: sender of the emails (required)
+  git-bug.notify.template [string]: path of a template redefining the "subject" and "body" of the emails, relative to the root of the repository
+`,
+		PreRunE:  loadBackend(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runNotify(env, options)
+		},
+	}
+
+	cmd.AddCommand(newNotifyWatchCommand())
+	cmd.AddCommand(newNotifyUnwatchCommand())
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVar(&options.pull, "pull", "",
+		"Pull the bugs from this remote before sending the emails")
+
+	return cmd
+}
+
+func runNotify(env *Env, opts notifyOptions) error {
+	notifier, err := notify.NewNotifier(env.backend)
+	if err != nil {
+		return err
+	}
+
+	return pullAndNotify(env, env.backend, notifier, opts.pull)
+}
+
+// pullAndNotify optionally pull the bugs from a remote, then send the
+// emails about the new operations
+func pullAndNotify(env *Env, repo *cache.RepoCache, notifier *notify.Notifier, remote string) error {
+	if remote != "" {
+		if _, err := repo.Fetch(remote); err != nil {
+			return err
+		}
+
+		for result := range repo.MergeAll(remote) {
+			if result.Err != nil {
+				env.err.Println(result.Err)
+			}
+		}
+	}
+
+	result, err := notifier.Notify()
+	if err != nil {
+		return err
+	}
+
+	if result.Initialized {
+		env.out.Println("Notifications initialized, the next activity will be notified")
+	} else if result.Bugs > 0 {
+		env.out.Printf("%d emails sent about %d bugs\n", result.Emails, result.Bugs)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/notify"
+)
+
+func newNotifyUnwatchCommand() *cobra.Command {
+	env := newEnv()
+	options := notifyWatchOptions{}
+
+	cmd := &cobra.Command{
+		Use:      "unwatch [ID]",
+		Short:    "Stop watching a bug.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runNotifyUnwatch(env, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVarP(&options.all, "all", "a", false, "Stop watching all the bugs")
+
+	return cmd
+}
+
+func runNotifyUnwatch(env *Env, opts notifyWatchOptions, args []string) error {
+	prefix, err := watchedPrefix(env, opts.all, args)
+	if err != nil {
+		return err
+	}
+
+	user, err := env.backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	return notify.Unwatch(user, prefix)
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	_select "github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/notify"
+)
+
+type notifyWatchOptions struct {
+	all bool
+}
+
+func newNotifyWatchCommand() *cobra.Command {
+	env := newEnv()
+	options := notifyWatchOptions{}
+
+	cmd := &cobra.Command{
+		Use:      "watch [ID]",
+		Short:    "Receive the emails about a bug without participating in it.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runNotifyWatch(env, options, args)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVarP(&options.all, "all", "a", false, "Watch all the bugs")
+
+	return cmd
+}
+
+func runNotifyWatch(env *Env, opts notifyWatchOptions, args []string) error {
+	prefix, err := watchedPrefix(env, opts.all, args)
+	if err != nil {
+		return err
+	}
+
+	user, err := env.backend.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	return notify.Watch(user, prefix)
+}
+
+// watchedPrefix return the bug id prefix to watch or unwatch
+func watchedPrefix(env *Env, all bool, args []string) (string, error) {
+	if all {
+		return notify.WatchAll, nil
+	}
+
+	b, _, err := _select.ResolveBug(env.backend, args)
+	if err != nil {
+		return "", err
+	}
+
+	return b.Id().String(), nil
+}
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/query"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func newQueryCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "List, save or remove named queries.",
+		Long: `List, save or remove named queries.
+
+A saved query can be used in place of a query, or as part of one, with "@NAME". Queries are saved in the git config of the repository. Queries saved in the global git config with --global are available in every repository.`,
+		Example: `Save and use a query:
+git bug query save triage "status:open no:label sort:edit"
+git bug ls @triage`,
+		PreRunE: loadRepo(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runQuery(env)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newQuerySaveCommand())
+	cmd.AddCommand(newQueryRmCommand())
+
+	return cmd
+}
+
+func runQuery(env *Env) error {
+	queries, err := query.SavedQueries(env.repo)
+	if err != nil {
+		return err
+	}
+
+	for _, saved := range queries {
+		env.out.Printf("%s\t%s\n", colors.Cyan("@"+saved.Name), saved.Query)
+	}
+
+	return nil
+}
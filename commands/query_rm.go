@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/query"
+)
+
+type queryRmOptions struct {
+	global bool
+}
+
+func newQueryRmCommand() *cobra.Command {
+	env := newEnv()
+	options := queryRmOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "rm NAME",
+		Short:   "Remove a saved query.",
+		PreRunE: loadRepo(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runQueryRm(env, options, args)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVarP(&options.global, "global", "g", false,
+		"Remove the query from the global config")
+
+	return cmd
+}
+
+func runQueryRm(env *Env, opts queryRmOptions, args []string) error {
+	remove := query.RemoveSavedQuery
+	if opts.global {
+		remove = query.RemoveGlobalSavedQuery
+	}
+
+	err := remove(env.repo, args[0])
+	if err != nil {
+		return err
+	}
+
+	env.out.Printf("Successfully removed query @%s\n", args[0])
+	return nil
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/query"
+)
+
+type querySaveOptions struct {
+	global bool
+}
+
+func newQuerySaveCommand() *cobra.Command {
+	env := newEnv()
+	options := querySaveOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "save NAME QUERY",
+		Short:   "Save a query under a name.",
+		PreRunE: loadRepo(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runQuerySave(env, options, args)
+		},
+		Args: cobra.MinimumNArgs(2),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVarP(&options.global, "global", "g", false,
+		"Save the query in the global config, to use it in every repository")
+
+	return cmd
+}
+
+func runQuerySave(env *Env, opts querySaveOptions, args []string) error {
+	name := args[0]
+
+	save := query.SaveQuery
+	if opts.global {
+		save = query.SaveGlobalQuery
+	}
+
+	err := save(env.repo, name, joinQueryArgs(args[1:]))
+	if err != nil {
+		return err
+	}
+
+	env.out.Printf("Query saved as @%s\n", strings.ToLower(name))
+	return nil
+}
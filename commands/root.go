@@ -61,16 +61,21 @@ _git_bug() {
 	}
 
 	cmd.AddCommand(newAddCommand())
+	cmd.AddCommand(newAssignCommand())
 	cmd.AddCommand(newBridgeCommand())
 	cmd.AddCommand(newCommandsCommand())
 	cmd.AddCommand(newCommentCommand())
 	cmd.AddCommand(newDeselectCommand())
 	cmd.AddCommand(newLabelCommand())
+	cmd.AddCommand(newLinkCommand())
 	cmd.AddCommand(newLsCommand())
 	cmd.AddCommand(newLsIdCommand())
 	cmd.AddCommand(newLsLabelCommand())
+	cmd.AddCommand(newMilestoneCommand())
+	cmd.AddCommand(newNotifyCommand())
 	cmd.AddCommand(newPullCommand())
 	cmd.AddCommand(newPushCommand())
+	cmd.AddCommand(newQueryCommand())
 	cmd.AddCommand(newRmCommand())
 	cmd.AddCommand(newSelectCommand())
 	cmd.AddCommand(newShowCommand())
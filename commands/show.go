@@ -10,6 +10,7 @@ import (
 
 	"github.com/MichaelMure/git-bug/bug"
 	_select "github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/colors"
 )
 
@@ -36,7 +37,7 @@ func newShowCommand() *cobra.Command {
 	flags.SortFlags = false
 
 	flags.StringVarP(&options.fields, "field", "", "",
-		"Select field to display. Valid values are [author,authorEmail,createTime,lastEdit,humanId,id,labels,shortId,status,title,actors,participants]")
+		"Select field to display. Valid values are [author,authorEmail,createTime,lastEdit,humanId,id,labels,shortId,status,resolution,title,actors,participants,assignees,milestone,links]")
 	flags.StringVarP(&options.format, "format", "f", "default",
 		"Select the output formatting style. Valid values are [default,json,org-mode]")
 
@@ -50,6 +51,7 @@ func runShow(env *Env, opts showOptions, args []string) error {
 	}
 
 	snap := b.Snapshot()
+	links := b.AllLinks()
 
 	if len(snap.Comments) == 0 {
 		return errors.New("invalid bug: no comment")
@@ -81,10 +83,26 @@ func runShow(env *Env, opts showOptions, args []string) error {
 			for _, p := range snap.Participants {
 				env.out.Printf("%s\n", p.DisplayName())
 			}
+		case "assignees":
+			for _, a := range snap.Assignees {
+				env.out.Printf("%s\n", a.DisplayName())
+			}
+		case "milestone":
+			if snap.Milestone != "" {
+				env.out.Printf("%s\n", milestoneTitle(env, snap.Milestone))
+			}
+		case "links":
+			for _, link := range links {
+				env.out.Printf("%s\n", linkDescription(env, link))
+			}
 		case "shortId":
 			env.out.Printf("%s\n", snap.Id().Human())
 		case "status":
-			env.out.Printf("%s\n", snap.Status)
+			env.out.Printf("%s\n", snap.StatusName())
+		case "resolution":
+			if snap.Resolution != "" {
+				env.out.Printf("%s\n", snap.Resolution)
+			}
 		case "title":
 			env.out.Printf("%s\n", snap.Title)
 		default:
@@ -96,21 +114,21 @@ func runShow(env *Env, opts showOptions, args []string) error {
 
 	switch opts.format {
 	case "org-mode":
-		return showOrgModeFormatter(env, snap)
+		return showOrgModeFormatter(env, snap, links)
 	case "json":
-		return showJsonFormatter(env, snap)
+		return showJsonFormatter(env, snap, links)
 	case "default":
-		return showDefaultFormatter(env, snap)
+		return showDefaultFormatter(env, snap, links)
 	default:
 		return fmt.Errorf("unknown format %s", opts.format)
 	}
 }
 
-func showDefaultFormatter(env *Env, snapshot *bug.Snapshot) error {
+func showDefaultFormatter(env *Env, snapshot *bug.Snapshot, links []bug.Link) error {
 	// Header
 	env.out.Printf("%s [%s] %s\n\n",
 		colors.Cyan(snapshot.Id().Human()),
-		colors.Yellow(snapshot.Status),
+		colors.Yellow(snapshot.StatusName()),
 		snapshot.Title,
 	)
 
@@ -149,10 +167,39 @@ func showDefaultFormatter(env *Env, snapshot *bug.Snapshot) error {
 		participants[i] = snapshot.Participants[i].DisplayName()
 	}
 
-	env.out.Printf("participants: %s\n\n",
+	env.out.Printf("participants: %s\n",
 		strings.Join(participants, ", "),
 	)
 
+	// Assignees
+	var assignees = make([]string, len(snapshot.Assignees))
+	for i := range snapshot.Assignees {
+		assignees[i] = snapshot.Assignees[i].DisplayName()
+	}
+
+	env.out.Printf("assignees: %s\n",
+		strings.Join(assignees, ", "),
+	)
+
+	// Milestone
+	if snapshot.Milestone != "" {
+		env.out.Printf("milestone: %s\n", milestoneTitle(env, snapshot.Milestone))
+	}
+
+	// Resolution
+	if snapshot.Resolution != "" {
+		env.out.Printf("resolution: %s\n", snapshot.Resolution.Description())
+	}
+
+	// Links
+	if len(links) > 0 {
+		env.out.Println("links:")
+		for _, link := range links {
+			env.out.Printf("  %s\n", linkDescription(env, link))
+		}
+	}
+	env.out.Println()
+
 	// Comments
 	indent := "  "
 
@@ -165,7 +212,9 @@ func showDefaultFormatter(env *Env, snapshot *bug.Snapshot) error {
 			comment.Author.Email(),
 		)
 
-		if comment.Message == "" {
+		if comment.Redacted {
+			message = colors.BlackBold(colors.WhiteBg("This comment has been redacted."))
+		} else if comment.Message == "" {
 			message = colors.BlackBold(colors.WhiteBg("No description provided."))
 		} else {
 			message = comment.Message
@@ -186,37 +235,50 @@ type JSONBugSnapshot struct {
 	CreateTime   JSONTime       `json:"create_time"`
 	EditTime     JSONTime       `json:"edit_time"`
 	Status       string         `json:"status"`
+	Resolution   string         `json:"resolution,omitempty"`
 	Labels       []bug.Label    `json:"labels"`
 	Title        string         `json:"title"`
 	Author       JSONIdentity   `json:"author"`
 	Actors       []JSONIdentity `json:"actors"`
 	Participants []JSONIdentity `json:"participants"`
+	Assignees    []JSONIdentity `json:"assignees"`
+	Milestone    string         `json:"milestone,omitempty"`
+	Links        []JSONLink     `json:"links"`
 	Comments     []JSONComment  `json:"comments"`
 }
 
+type JSONLink struct {
+	Type        string `json:"type"`
+	Target      string `json:"target"`
+	HumanTarget string `json:"human_target"`
+}
+
 type JSONComment struct {
-	Id      string       `json:"id"`
-	HumanId string       `json:"human_id"`
-	Author  JSONIdentity `json:"author"`
-	Message string       `json:"message"`
+	Id       string       `json:"id"`
+	HumanId  string       `json:"human_id"`
+	Author   JSONIdentity `json:"author"`
+	Message  string       `json:"message"`
+	Redacted bool         `json:"redacted,omitempty"`
 }
 
 func NewJSONComment(comment bug.Comment) JSONComment {
 	return JSONComment{
-		Id:      comment.Id().String(),
-		HumanId: comment.Id().Human(),
-		Author:  NewJSONIdentity(comment.Author),
-		Message: comment.Message,
+		Id:       comment.Id().String(),
+		HumanId:  comment.Id().Human(),
+		Author:   NewJSONIdentity(comment.Author),
+		Message:  comment.Message,
+		Redacted: comment.Redacted,
 	}
 }
 
-func showJsonFormatter(env *Env, snapshot *bug.Snapshot) error {
+func showJsonFormatter(env *Env, snapshot *bug.Snapshot, links []bug.Link) error {
 	jsonBug := JSONBugSnapshot{
 		Id:         snapshot.Id().String(),
 		HumanId:    snapshot.Id().Human(),
 		CreateTime: NewJSONTime(snapshot.CreateTime, 0),
 		EditTime:   NewJSONTime(snapshot.EditTime(), 0),
-		Status:     snapshot.Status.String(),
+		Status:     snapshot.StatusName(),
+		Resolution: snapshot.Resolution.String(),
 		Labels:     snapshot.Labels,
 		Title:      snapshot.Title,
 		Author:     NewJSONIdentity(snapshot.Author),
@@ -232,6 +294,24 @@ func showJsonFormatter(env *Env, snapshot *bug.Snapshot) error {
 		jsonBug.Participants[i] = NewJSONIdentity(element)
 	}
 
+	jsonBug.Assignees = make([]JSONIdentity, len(snapshot.Assignees))
+	for i, element := range snapshot.Assignees {
+		jsonBug.Assignees[i] = NewJSONIdentity(element)
+	}
+
+	if snapshot.Milestone != "" {
+		jsonBug.Milestone = snapshot.Milestone.String()
+	}
+
+	jsonBug.Links = make([]JSONLink, len(links))
+	for i, link := range links {
+		jsonBug.Links[i] = JSONLink{
+			Type:        link.Type.String(),
+			Target:      link.Target.String(),
+			HumanTarget: link.Target.Human(),
+		}
+	}
+
 	jsonBug.Comments = make([]JSONComment, len(snapshot.Comments))
 	for i, comment := range snapshot.Comments {
 		jsonBug.Comments[i] = NewJSONComment(comment)
@@ -243,11 +323,11 @@ func showJsonFormatter(env *Env, snapshot *bug.Snapshot) error {
 	return nil
 }
 
-func showOrgModeFormatter(env *Env, snapshot *bug.Snapshot) error {
+func showOrgModeFormatter(env *Env, snapshot *bug.Snapshot, links []bug.Link) error {
 	// Header
 	env.out.Printf("%s [%s] %s\n",
 		snapshot.Id().Human(),
-		snapshot.Status,
+		snapshot.StatusName(),
 		snapshot.Title,
 	)
 
@@ -302,6 +382,40 @@ func showOrgModeFormatter(env *Env, snapshot *bug.Snapshot) error {
 		strings.Join(participants, "\n** "),
 	)
 
+	// Assignees
+	var assignees = make([]string, len(snapshot.Assignees))
+	for i, assignee := range snapshot.Assignees {
+		assignees[i] = fmt.Sprintf("%s %s",
+			assignee.Id().Human(),
+			assignee.DisplayName(),
+		)
+	}
+
+	env.out.Printf("* Assignees:\n")
+	if len(assignees) > 0 {
+		env.out.Printf("** %s\n",
+			strings.Join(assignees, "\n** "),
+		)
+	}
+
+	if snapshot.Resolution != "" {
+		env.out.Printf("* Resolution: %s\n", snapshot.Resolution.Description())
+	}
+
+	if snapshot.Milestone != "" {
+		env.out.Printf("* Milestone:\n** %s %s\n",
+			snapshot.Milestone.Human(),
+			milestoneTitle(env, snapshot.Milestone),
+		)
+	}
+
+	if len(links) > 0 {
+		env.out.Printf("* Links:\n")
+		for _, link := range links {
+			env.out.Printf("** %s\n", linkDescription(env, link))
+		}
+	}
+
 	env.out.Printf("* Comments:\n")
 
 	for i, comment := range snapshot.Comments {
@@ -309,7 +423,9 @@ func showOrgModeFormatter(env *Env, snapshot *bug.Snapshot) error {
 		env.out.Printf("** #%d %s\n",
 			i, comment.Author.DisplayName())
 
-		if comment.Message == "" {
+		if comment.Redacted {
+			message = "This comment has been redacted."
+		} else if comment.Message == "" {
 			message = "No description provided."
 		} else {
 			message = strings.ReplaceAll(comment.Message, "\n", "\n: ")
@@ -320,3 +436,13 @@ func showOrgModeFormatter(env *Env, snapshot *bug.Snapshot) error {
 
 	return nil
 }
+
+// milestoneTitle return the title of a milestone, or its id if the milestone
+// is not available locally
+func milestoneTitle(env *Env, id entity.Id) string {
+	excerpt, err := env.backend.ResolveMilestoneExcerpt(id)
+	if err != nil {
+		return id.Human()
+	}
+	return excerpt.Title
+}
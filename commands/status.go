@@ -20,6 +20,8 @@ func newStatusCommand() *cobra.Command {
 
 	cmd.AddCommand(newStatusCloseCommand())
 	cmd.AddCommand(newStatusOpenCommand())
+	cmd.AddCommand(newStatusSetCommand())
+	cmd.AddCommand(newStatusLsCommand())
 
 	return cmd
 }
@@ -32,7 +34,7 @@ func runStatus(env *Env, args []string) error {
 
 	snap := b.Snapshot()
 
-	env.out.Println(snap.Status)
+	env.out.Println(snap.StatusName())
 
 	return nil
 }
@@ -1,12 +1,19 @@
 package commands
 
 import (
-	_select "github.com/MichaelMure/git-bug/commands/select"
 	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	_select "github.com/MichaelMure/git-bug/commands/select"
 )
 
+type statusCloseOptions struct {
+	resolution string
+}
+
 func newStatusCloseCommand() *cobra.Command {
 	env := newEnv()
+	options := statusCloseOptions{}
 
 	cmd := &cobra.Command{
 		Use:      "close [ID]",
@@ -14,23 +21,42 @@ func newStatusCloseCommand() *cobra.Command {
 		PreRunE:  loadBackendEnsureUser(env),
 		PostRunE: closeBackend(env),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runStatusClose(env, args)
+			return runStatusClose(env, options, args)
 		},
 	}
 
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.resolution, "resolution", "r", "",
+		"Record the reason the bug is closed for. Valid values are [fixed,wontfix,duplicate,invalid]")
+
 	return cmd
 }
 
-func runStatusClose(env *Env, args []string) error {
+func runStatusClose(env *Env, opts statusCloseOptions, args []string) error {
 	b, args, err := _select.ResolveBug(env.backend, args)
 	if err != nil {
 		return err
 	}
 
-	_, err = b.Close()
+	resolution, err := parseResolution(opts.resolution)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.SetStatus(bug.ClosedStatus.String(), resolution)
 	if err != nil {
 		return err
 	}
 
 	return b.Commit()
 }
+
+// parseResolution parse an optional resolution given as a flag
+func parseResolution(str string) (bug.Resolution, error) {
+	if str == "" {
+		return "", nil
+	}
+	return bug.ResolutionFromString(str)
+}
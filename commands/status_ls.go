@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func newStatusLsCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "List the workflow statuses of the repository.",
+		Long: `List the workflow statuses of the repository, with their category and the statuses a bug can move to from them.
+
+Beside the built-in "open" and "closed", statuses are defined in the git config of the repository:
+
+git config git-bug.status.in-progress.category open
+git config git-bug.status.in-progress.transitions "blocked,closed"`,
+		PreRunE: loadRepo(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatusLs(env)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	return cmd
+}
+
+func runStatusLs(env *Env) error {
+	workflow, err := bug.LoadWorkflow(env.repo)
+	if err != nil {
+		return err
+	}
+
+	for _, status := range workflow.Statuses {
+		transitions := "any"
+		if len(status.Transitions) > 0 {
+			transitions = strings.Join(status.Transitions, ", ")
+		}
+		env.out.Printf("%s\t%s\t-> %s\n",
+			colors.Cyan(status.Name),
+			colors.Yellow(status.Category),
+			transitions,
+		)
+	}
+
+	return nil
+}
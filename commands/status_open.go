@@ -1,8 +1,10 @@
 package commands
 
 import (
-	_select "github.com/MichaelMure/git-bug/commands/select"
 	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	_select "github.com/MichaelMure/git-bug/commands/select"
 )
 
 func newStatusOpenCommand() *cobra.Command {
@@ -27,7 +29,7 @@ func runStatusOpen(env *Env, args []string) error {
 		return err
 	}
 
-	_, err = b.Open()
+	_, err = b.SetStatus(bug.OpenStatus.String(), "")
 	if err != nil {
 		return err
 	}
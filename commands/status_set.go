@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	_select "github.com/MichaelMure/git-bug/commands/select"
+)
+
+type statusSetOptions struct {
+	resolution string
+}
+
+func newStatusSetCommand() *cobra.Command {
+	env := newEnv()
+	options := statusSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:      "set [ID] STATUS",
+		Short:    "Move a bug to a workflow status.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatusSet(env, options, args)
+		},
+		Args: cobra.RangeArgs(1, 2),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.StringVarP(&options.resolution, "resolution", "r", "",
+		"Record the reason the bug is closed for, for a status in the closed category. Valid values are [fixed,wontfix,duplicate,invalid]")
+
+	return cmd
+}
+
+func runStatusSet(env *Env, opts statusSetOptions, args []string) error {
+	b, args, err := _select.ResolveBug(env.backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return errors.New("a single status is required")
+	}
+
+	resolution, err := parseResolution(opts.resolution)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.SetStatus(args[0], resolution)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
@@ -30,6 +30,8 @@ func newUserCommand() *cobra.Command {
 	cmd.AddCommand(newUserAdoptCommand())
 	cmd.AddCommand(newUserCreateCommand())
 	cmd.AddCommand(newUserLsCommand())
+	cmd.AddCommand(newUserPasswordCommand())
+	cmd.AddCommand(newUserTokenCommand())
 
 	flags := cmd.Flags()
 	flags.SortFlags = false
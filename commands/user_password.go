@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/api/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/input"
+)
+
+type userPasswordOptions struct {
+	remove bool
+}
+
+func newUserPasswordCommand() *cobra.Command {
+	env := newEnv()
+	options := userPasswordOptions{}
+
+	cmd := &cobra.Command{
+		Use:      "password [USER-ID]",
+		Short:    "Set the password of an identity for the HTTP basic authentication of the web UI.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUserPassword(env, options, args)
+		},
+		Args: cobra.MaximumNArgs(1),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+
+	flags.BoolVarP(&options.remove, "remove", "r", false,
+		"Remove the password instead of setting it")
+
+	return cmd
+}
+
+func runUserPassword(env *Env, opts userPasswordOptions, args []string) error {
+	var id *cache.IdentityCache
+	var err error
+	if len(args) == 1 {
+		id, err = env.backend.ResolveIdentityPrefix(args[0])
+	} else {
+		id, err = env.backend.GetUserIdentity()
+	}
+	if err != nil {
+		return err
+	}
+
+	keyring := env.backend.Keyring()
+
+	if opts.remove {
+		err = auth.RemovePassword(keyring, id.Id())
+		if err != nil {
+			return err
+		}
+		env.out.Printf("password of %s removed\n", id.DisplayName())
+		return nil
+	}
+
+	password, err := input.PromptPassword("Password", "password", input.Required)
+	if err != nil {
+		return err
+	}
+
+	err = auth.SetPassword(keyring, id.Id(), password)
+	if err != nil {
+		return err
+	}
+
+	env.out.Printf("password of %s set\n", id.DisplayName())
+	return nil
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/api/auth"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+func newUserTokenCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:      "token",
+		Short:    "List the API tokens of the web UI.",
+		PreRunE:  loadBackend(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUserToken(env)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newUserTokenCreateCommand())
+	cmd.AddCommand(newUserTokenRmCommand())
+
+	return cmd
+}
+
+func runUserToken(env *Env) error {
+	tokens, err := auth.ListTokens(env.backend.Keyring())
+	if err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		name := token.IdentityId.Human()
+		if i, err := env.backend.ResolveIdentityExcerpt(token.IdentityId); err == nil {
+			name = i.DisplayName()
+		}
+
+		env.out.Printf("%s %s %s\n",
+			colors.Cyan(token.Id.Human()),
+			colors.Yellow(token.CreateTime.Format("Mon Jan 2 15:04:05 2006 +0200")),
+			name,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/api/auth"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+func newUserTokenCreateCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:      "create [USER-ID]",
+		Short:    "Create an API token to authenticate as an identity on the web UI.",
+		PreRunE:  loadBackendEnsureUser(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUserTokenCreate(env, args)
+		},
+		Args: cobra.MaximumNArgs(1),
+	}
+
+	return cmd
+}
+
+func runUserTokenCreate(env *Env, args []string) error {
+	var id *cache.IdentityCache
+	var err error
+	if len(args) == 1 {
+		id, err = env.backend.ResolveIdentityPrefix(args[0])
+	} else {
+		id, err = env.backend.GetUserIdentity()
+	}
+	if err != nil {
+		return err
+	}
+
+	token, value, err := auth.NewToken(env.backend.Keyring(), id.Id())
+	if err != nil {
+		return err
+	}
+
+	env.err.Printf("API token %s created for %s, it won't be displayed again:\n",
+		token.Id.Human(), id.DisplayName())
+	env.out.Println(value)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/api/auth"
+)
+
+func newUserTokenRmCommand() *cobra.Command {
+	env := newEnv()
+
+	cmd := &cobra.Command{
+		Use:      "rm TOKEN-ID",
+		Short:    "Revoke an API token.",
+		PreRunE:  loadBackend(env),
+		PostRunE: closeBackend(env),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUserTokenRm(env, args)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	return cmd
+}
+
+func runUserTokenRm(env *Env, args []string) error {
+	keyring := env.backend.Keyring()
+
+	token, err := auth.LoadTokenWithPrefix(keyring, args[0])
+	if err != nil {
+		return err
+	}
+
+	err = auth.RemoveToken(keyring, token.Id)
+	if err != nil {
+		return err
+	}
+
+	env.out.Printf("API token %s revoked\n", token.Id.Human())
+	return nil
+}
@@ -2,11 +2,16 @@ package commands
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -14,12 +19,13 @@ import (
 	"github.com/phayes/freeport"
 	"github.com/skratchdot/open-golang/open"
 	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
 
 	"github.com/MichaelMure/git-bug/api/auth"
 	"github.com/MichaelMure/git-bug/api/graphql"
 	httpapi "github.com/MichaelMure/git-bug/api/http"
 	"github.com/MichaelMure/git-bug/cache"
-	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/notify"
 	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/webui"
 )
@@ -27,10 +33,22 @@ import (
 const webUIOpenConfigKey = "git-bug.webui.open"
 
 type webUIOptions struct {
+	host     string
 	port     int
 	open     bool
 	noOpen   bool
 	readOnly bool
+
+	auth              []string
+	oauthIssuer       string
+	oauthClientId     string
+	oauthClientSecret string
+	oauthRedirectURL  string
+	oauthStandIn      bool
+
+	notify         bool
+	notifyInterval time.Duration
+	notifyPull     string
 }
 
 func newWebUICommand() *cobra.Command {
@@ -44,6 +62,27 @@ func newWebUICommand() *cobra.Command {
 
 Available git config:
   git-bug.webui.open [bool]: control the automatic opening of the web UI in the default browser
+
+With --notify, emails about the new activity on the bugs are sent periodically,
+as with "git bug notify".
+
+The users are authenticated with the methods given with --auth:
+  local: every request is made by the user identity of the repository. It
+         can't be combined with the other methods.
+  token: an API token created with "git bug user token create", given in an
+         "Authorization: Bearer" header
+  basic: the HTTP basic auth, with the login or email of an identity and the
+         password set with "git bug user password"
+  oauth: a login on an OAuth identity provider at /auth/login, matched to the
+         identity with the same login or email. Unless served on a loopback
+         --host, the public URL of /auth/callback must be given with
+         --oauth-redirect-url. For testing, --oauth-standin
+         serves instead of --oauth-issuer a stand-in provider at /auth/idp,
+         letting anybody log in as any identity. It is only allowed on a
+         loopback --host.
+With the other methods, the requests without credentials are read-only.
+The user identity of the repository is shared by all the users, so it can only
+be changed from the web UI with the local method.
 `,
 		PreRunE: loadRepo(env),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -56,8 +95,18 @@ Available git config:
 
 	flags.BoolVar(&options.open, "open", false, "Automatically open the web UI in the default browser")
 	flags.BoolVar(&options.noOpen, "no-open", false, "Prevent the automatic opening of the web UI in the default browser")
+	flags.StringVar(&options.host, "host", "127.0.0.1", "Network address to listen to")
 	flags.IntVarP(&options.port, "port", "p", 0, "Port to listen to (default is random)")
 	flags.BoolVar(&options.readOnly, "read-only", false, "Whether to run the web UI in read-only mode")
+	flags.StringSliceVar(&options.auth, "auth", []string{"local"}, "Authentication methods of the users: local, token, basic or oauth")
+	flags.StringVar(&options.oauthIssuer, "oauth-issuer", "", "Base URL of the OAuth identity provider, serving /authorize, /token and /userinfo")
+	flags.StringVar(&options.oauthClientId, "oauth-client-id", "", "OAuth client id of the web UI on the identity provider")
+	flags.StringVar(&options.oauthClientSecret, "oauth-client-secret", "", "OAuth client secret of the web UI on the identity provider")
+	flags.StringVar(&options.oauthRedirectURL, "oauth-redirect-url", "", "Public URL of the /auth/callback of the web UI, registered on the identity provider (default is derived from the requests on a loopback host)")
+	flags.BoolVar(&options.oauthStandIn, "oauth-standin", false, "Serve a stand-in OAuth identity provider letting anybody log in as any identity, for testing on a loopback host")
+	flags.BoolVar(&options.notify, "notify", false, "Periodically send emails about the new activity on the bugs")
+	flags.DurationVar(&options.notifyInterval, "notify-interval", time.Minute, "Interval between the notifications")
+	flags.StringVar(&options.notifyPull, "notify-pull", "", "Pull the bugs from this remote before each notification")
 
 	return cmd
 }
@@ -71,26 +120,33 @@ func runWebUI(env *Env, opts webUIOptions, args []string) error {
 		}
 	}
 
-	addr := fmt.Sprintf("127.0.0.1:%d", opts.port)
+	addr := fmt.Sprintf("%s:%d", opts.host, opts.port)
 	webUiAddr := fmt.Sprintf("http://%s", addr)
 
 	router := mux.NewRouter()
 
-	// If the webUI is not read-only, use an authentication middleware with a
-	// fixed identity: the default user of the repo
-	// TODO: support dynamic authentication with OAuth
+	mrc := cache.NewMultiRepoCache()
+	repoCache, err := mrc.RegisterDefaultRepository(env.repo)
+	if err != nil {
+		return err
+	}
+
+	// If the webUI is not read-only, use an authentication middleware with
+	// the requested methods
 	if !opts.readOnly {
-		author, err := identity.GetUserIdentity(env.repo)
+		authenticators, err := webUIAuthenticators(router, repoCache, opts)
 		if err != nil {
 			return err
 		}
-		router.Use(auth.Middleware(author.Id()))
+		router.Use(auth.Middleware(authenticators...))
 	}
 
-	mrc := cache.NewMultiRepoCache()
-	_, err := mrc.RegisterDefaultRepository(env.repo)
-	if err != nil {
-		return err
+	var notifier *notify.Notifier
+	if opts.notify {
+		notifier, err = notify.NewNotifier(repoCache)
+		if err != nil {
+			return err
+		}
 	}
 
 	graphqlHandler := graphql.NewHandler(mrc)
@@ -100,6 +156,7 @@ func runWebUI(env *Env, opts webUIOptions, args []string) error {
 	router.Path("/graphql").Handler(graphqlHandler)
 	router.Path("/gitfile/{repo}/{hash}").Handler(httpapi.NewGitFileHandler(mrc))
 	router.Path("/upload/{repo}").Methods("POST").Handler(httpapi.NewGitUploadFileHandler(mrc))
+	router.Path("/webhook/{bridge}").Methods("POST").Handler(httpapi.NewWebhookHandler(mrc))
 	router.PathPrefix("/").Handler(webui.NewHandler())
 
 	srv := &http.Server{
@@ -109,6 +166,7 @@ func runWebUI(env *Env, opts webUIOptions, args []string) error {
 
 	done := make(chan bool)
 	quit := make(chan os.Signal, 1)
+	stopNotify := make(chan struct{})
 
 	// register as handler of the interrupt signal to trigger the teardown
 	signal.Notify(quit, os.Interrupt)
@@ -120,6 +178,8 @@ func runWebUI(env *Env, opts webUIOptions, args []string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
+		close(stopNotify)
+
 		srv.SetKeepAlivesEnabled(false)
 		if err := srv.Shutdown(ctx); err != nil {
 			log.Fatalf("Could not gracefully shutdown the WebUI: %v\n", err)
@@ -139,6 +199,10 @@ func runWebUI(env *Env, opts webUIOptions, args []string) error {
 	env.out.Printf("Graphql Playground: http://%s/playground\n", addr)
 	env.out.Println("Press Ctrl+c to quit")
 
+	if notifier != nil {
+		go runWebUINotify(env, opts, repoCache, notifier, stopNotify)
+	}
+
 	configOpen, err := env.repo.AnyConfig().ReadBool(webUIOpenConfigKey)
 	if err == repository.ErrNoConfigEntry {
 		// default to true
@@ -166,3 +230,144 @@ func runWebUI(env *Env, opts webUIOptions, args []string) error {
 	env.out.Println("WebUI stopped")
 	return nil
 }
+
+// webUIAuthenticators return the authenticators of the requested methods,
+// and register the routes of the OAuth login flow if needed
+func webUIAuthenticators(router *mux.Router, repo *cache.RepoCache, opts webUIOptions) ([]auth.Authenticator, error) {
+	var authenticators []auth.Authenticator
+
+	for _, method := range opts.auth {
+		switch method {
+		case "local":
+			// the requests without credentials would otherwise be made by
+			// the user identity of the repository
+			if len(opts.auth) > 1 {
+				return nil, fmt.Errorf("the local authentication method can't be combined with other ones")
+			}
+			_, err := repo.GetUserIdentity()
+			if err != nil {
+				return nil, err
+			}
+			authenticators = append(authenticators, auth.NewRepoUserAuthenticator(repo))
+		case "token":
+			authenticators = append(authenticators, auth.NewTokenAuthenticator(repo))
+		case "basic":
+			authenticators = append(authenticators, auth.NewBasicAuthenticator(repo))
+		case "oauth":
+			oauth, err := webUIOAuth(router, repo, opts)
+			if err != nil {
+				return nil, err
+			}
+			authenticators = append(authenticators, oauth)
+		default:
+			return nil, fmt.Errorf("unknown authentication method %s", method)
+		}
+	}
+
+	return authenticators, nil
+}
+
+func webUIOAuth(router *mux.Router, repo *cache.RepoCache, opts webUIOptions) (*auth.OAuth, error) {
+	var provider auth.OAuthProvider
+
+	switch {
+	case opts.oauthIssuer != "" && opts.oauthStandIn:
+		return nil, fmt.Errorf("--oauth-issuer and --oauth-standin are exclusive")
+
+	case opts.oauthIssuer != "":
+		if opts.oauthClientId == "" {
+			return nil, fmt.Errorf("an OAuth client id is required with --oauth-issuer")
+		}
+		// the callback would otherwise be derived from the Host header of
+		// the requests, which anybody can forge
+		if opts.oauthRedirectURL == "" && !isLoopbackHost(opts.host) {
+			return nil, fmt.Errorf("an OAuth redirect URL is required with --oauth-redirect-url when not serving on a loopback host")
+		}
+		issuer := strings.TrimSuffix(opts.oauthIssuer, "/")
+		provider = auth.OAuthProvider{
+			Config: oauth2.Config{
+				ClientID:     opts.oauthClientId,
+				ClientSecret: opts.oauthClientSecret,
+				Endpoint: oauth2.Endpoint{
+					AuthURL:  issuer + "/authorize",
+					TokenURL: issuer + "/token",
+				},
+				RedirectURL: opts.oauthRedirectURL,
+			},
+			UserInfoURL: issuer + "/userinfo",
+		}
+
+	case opts.oauthStandIn:
+		// anybody reaching the stand-in can log in as any identity
+		if !isLoopbackHost(opts.host) {
+			return nil, fmt.Errorf("the stand-in OAuth provider can only be served on a loopback host, not %s", opts.host)
+		}
+
+		// stand in for an identity provider with the identities of the
+		// repository
+		var users []auth.OAuthUser
+		for _, id := range repo.AllIdentityIds() {
+			i, err := repo.ResolveIdentity(id)
+			if err != nil {
+				return nil, err
+			}
+			login := i.Login()
+			if login == "" {
+				login = i.Id().Human()
+			}
+			users = append(users, auth.OAuthUser{Login: login, Email: i.Email(), Name: i.Name()})
+		}
+
+		secret := make([]byte, 16)
+		_, err := rand.Read(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		// the browser is sent to the stand-in on the same address as the web
+		// UI, while the web UI reach it locally
+		standIn := auth.NewStandInProvider("git-bug", hex.EncodeToString(secret), users)
+		router.PathPrefix("/auth/idp/").Handler(standIn)
+		provider = standIn.Provider(fmt.Sprintf("http://%s/auth/idp", net.JoinHostPort(opts.host, strconv.Itoa(opts.port))), opts.oauthRedirectURL)
+		provider.Endpoint.AuthURL = "/auth/idp/authorize"
+
+	default:
+		return nil, fmt.Errorf("the oauth method requires an identity provider with --oauth-issuer")
+	}
+
+	oauth := auth.NewOAuth(repo, provider)
+	router.Path("/auth/login").Handler(oauth.LoginHandler())
+	router.Path("/auth/callback").Handler(oauth.CallbackHandler())
+	router.Path("/auth/logout").Handler(oauth.LogoutHandler())
+
+	return oauth, nil
+}
+
+// isLoopbackHost tell if a host to listen to is only reachable from the
+// local machine
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// runWebUINotify send the notifications at each interval, until stopped
+func runWebUINotify(env *Env, opts webUIOptions, repo *cache.RepoCache, notifier *notify.Notifier, stop chan struct{}) {
+	ticker := time.NewTicker(opts.notifyInterval)
+	defer ticker.Stop()
+
+	for {
+		err := pullAndNotify(env, repo, notifier, opts.notifyPull)
+		if err != nil {
+			env.err.Printf("Notification failed: %v\n", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
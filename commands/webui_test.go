@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/api/auth"
+	"github.com/MichaelMure/git-bug/api/graphql"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestWebUIAuthenticators(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	mrc := cache.NewMultiRepoCache()
+	backend, err := mrc.RegisterDefaultRepository(repo)
+	require.NoError(t, err)
+
+	alice, err := backend.NewIdentityFull("Alice", "alice@example.com", "alice", "")
+	require.NoError(t, err)
+	require.NoError(t, backend.SetUserIdentity(alice))
+
+	_, err = webUIAuthenticators(mux.NewRouter(), backend, webUIOptions{auth: []string{"local"}})
+	require.NoError(t, err)
+
+	// the requests without credentials would be made by the local user
+	_, err = webUIAuthenticators(mux.NewRouter(), backend, webUIOptions{auth: []string{"local", "token"}})
+	require.Error(t, err)
+
+	_, err = webUIAuthenticators(mux.NewRouter(), backend, webUIOptions{auth: []string{"unknown"}})
+	require.Error(t, err)
+
+	authenticators, err := webUIAuthenticators(mux.NewRouter(), backend, webUIOptions{auth: []string{"token", "basic"}})
+	require.NoError(t, err)
+
+	token, value, err := auth.NewToken(backend.Keyring(), alice.Id())
+	require.NoError(t, err)
+	defer auth.RemoveToken(backend.Keyring(), token.Id)
+
+	handler := auth.Middleware(authenticators...)(graphql.NewHandler(mrc))
+	mutation := `mutation { newBug(input: {title: "title", message: "message"}) { bug { id } } }`
+	var resp interface{}
+
+	// without credentials, the request is not made by the user of the repository
+	err = client.New(handler).Post(mutation, &resp)
+	require.Error(t, err)
+	require.Empty(t, backend.AllBugsIds())
+
+	err = client.New(handler, client.AddHeader("Authorization", "Bearer "+value)).Post(mutation, &resp)
+	require.NoError(t, err)
+	require.Len(t, backend.AllBugsIds(), 1)
+}
+
+func TestWebUIOAuth(t *testing.T) {
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+
+	_, err = backend.NewIdentityFull("Alice", "alice@example.com", "alice", "")
+	require.NoError(t, err)
+
+	cases := []struct {
+		name  string
+		opts  webUIOptions
+		valid bool
+	}{
+		{name: "no provider", opts: webUIOptions{host: "127.0.0.1"}},
+		{name: "issuer", opts: webUIOptions{host: "0.0.0.0", oauthIssuer: "https://idp.example.com", oauthClientId: "git-bug", oauthRedirectURL: "https://bugs.example.com/auth/callback"}, valid: true},
+		{name: "issuer without redirect url", opts: webUIOptions{host: "0.0.0.0", oauthIssuer: "https://idp.example.com", oauthClientId: "git-bug"}},
+		{name: "issuer on a loopback host", opts: webUIOptions{host: "127.0.0.1", oauthIssuer: "https://idp.example.com", oauthClientId: "git-bug"}, valid: true},
+		{name: "issuer without client id", opts: webUIOptions{host: "0.0.0.0", oauthIssuer: "https://idp.example.com"}},
+		{name: "stand-in", opts: webUIOptions{host: "127.0.0.1", oauthStandIn: true}, valid: true},
+		{name: "stand-in on localhost", opts: webUIOptions{host: "localhost", oauthStandIn: true}, valid: true},
+		{name: "stand-in on ipv6 loopback", opts: webUIOptions{host: "::1", oauthStandIn: true}, valid: true},
+		{name: "stand-in on every interface", opts: webUIOptions{host: "0.0.0.0", oauthStandIn: true}},
+		{name: "stand-in on a public address", opts: webUIOptions{host: "192.0.2.1", oauthStandIn: true}},
+		{name: "stand-in and issuer", opts: webUIOptions{host: "127.0.0.1", oauthStandIn: true, oauthIssuer: "https://idp.example.com", oauthClientId: "git-bug"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := webUIOAuth(mux.NewRouter(), backend, tc.opts)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -208,6 +208,7 @@ const queryTemplate = `%s
 # - queries are case insensitive.
 # - you can combine as many qualifiers as you want.
 # - you can use double quotes for multi-word search terms (ex: author:"René Descartes")
+# - you can reference a query saved with "git bug query save" with @name
 `
 
 // QueryEditorInput will open the default editor in the terminal with a
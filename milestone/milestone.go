@@ -0,0 +1,624 @@
+// Package milestone contains the milestone data model and low-level related functions
+package milestone
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/lamport"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+const milestoneRefPattern = "refs/milestones/"
+const milestoneRemoteRefPattern = "refs/remotes/%s/milestones/"
+const versionEntryName = "version"
+
+const editClockName = "milestone-edit"
+
+var ErrMilestoneNotExist = errors.New("milestone doesn't exist")
+
+func NewErrMultipleMatch(matching []entity.Id) *entity.ErrMultipleMatch {
+	return entity.NewErrMultipleMatch("milestone", matching)
+}
+
+var _ entity.Interface = &Milestone{}
+
+// Milestone is a target, usually a release, a set of bugs can be attached to.
+//
+// A Milestone is stored as a chain of git commits, each holding a Version with
+// the fields changed at that point. The current state is computed by applying
+// the versions in their lamport time order, so that concurrent editions of a
+// Milestone from different repositories converge to the same result after a merge.
+type Milestone struct {
+	// Id used as unique identifier
+	id entity.Id
+
+	// all the successive version of the milestone
+	versions []*Version
+
+	// not serialized
+	lastCommit repository.Hash
+}
+
+// Mutator hold the editable fields of a Milestone
+type Mutator struct {
+	Title       string
+	Description string
+	// zero value for no due date
+	DueDate time.Time
+	Status  bug.Status
+}
+
+// NewMilestone create a new open Milestone
+func NewMilestone(author identity.Interface, unixTime int64, title string, description string, dueDate time.Time) *Milestone {
+	status := bug.OpenStatus
+	due := dueDateUnix(dueDate)
+
+	v := &Version{
+		unixTime: unixTime,
+		author:   author,
+		title:    &title,
+		status:   &status,
+		nonce:    makeNonce(20),
+	}
+	if description != "" {
+		v.description = &description
+	}
+	if due != 0 {
+		v.dueDate = &due
+	}
+
+	return &Milestone{
+		id:       entity.UnsetId,
+		versions: []*Version{v},
+	}
+}
+
+// ReadLocal load a local Milestone from the milestones data available in git
+func ReadLocal(repo repository.ClockedRepo, id entity.Id) (*Milestone, error) {
+	ref := milestoneRefPattern + id.String()
+	return read(repo, identity.NewSimpleResolver(repo), ref)
+}
+
+// ReadLocalWithResolver load a local Milestone from the milestones data available in git
+func ReadLocalWithResolver(repo repository.ClockedRepo, identityResolver identity.Resolver, id entity.Id) (*Milestone, error) {
+	ref := milestoneRefPattern + id.String()
+	return read(repo, identityResolver, ref)
+}
+
+// ReadRemote load a remote Milestone from the milestones data available in git
+func ReadRemote(repo repository.ClockedRepo, remote string, id entity.Id) (*Milestone, error) {
+	ref := fmt.Sprintf(milestoneRemoteRefPattern, remote) + id.String()
+	return read(repo, identity.NewSimpleResolver(repo), ref)
+}
+
+// read will load and parse a milestone from git
+func read(repo repository.ClockedRepo, identityResolver identity.Resolver, ref string) (*Milestone, error) {
+	refSplit := strings.Split(ref, "/")
+	id := entity.Id(refSplit[len(refSplit)-1])
+
+	if err := id.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid ref")
+	}
+
+	hashes, err := repo.ListCommits(ref)
+
+	// TODO: this is not perfect, it might be a command invoke error
+	if err != nil {
+		return nil, ErrMilestoneNotExist
+	}
+
+	m := &Milestone{
+		id: id,
+	}
+
+	editClock, err := repo.GetOrCreateClock(editClockName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hash := range hashes {
+		entries, err := repo.ReadTree(hash)
+		if err != nil {
+			return nil, errors.Wrap(err, "can't list git tree entries")
+		}
+
+		if len(entries) != 1 || entries[0].Name != versionEntryName {
+			return nil, fmt.Errorf("invalid milestone data at hash %s", hash)
+		}
+
+		data, err := repo.ReadData(entries[0].Hash)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read git blob data")
+		}
+
+		var version Version
+		err = json.Unmarshal(data, &version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode Milestone version json %s", hash)
+		}
+
+		if err := editClock.Witness(version.time); err != nil {
+			return nil, errors.Wrap(err, "failed to update edit lamport clock")
+		}
+
+		// Make sure that the identities are properly loaded
+		if stub, ok := version.author.(*identity.IdentityStub); ok {
+			version.author, err = identityResolver.ResolveIdentity(stub.Id())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// tag the version with the commit hash
+		version.commitHash = hash
+		m.lastCommit = hash
+
+		m.versions = append(m.versions, &version)
+	}
+
+	return m, nil
+}
+
+type StreamedMilestone struct {
+	Milestone *Milestone
+	Err       error
+}
+
+// ReadAllLocal read and parse all local Milestone
+func ReadAllLocal(repo repository.ClockedRepo) <-chan StreamedMilestone {
+	return readAll(repo, identity.NewSimpleResolver(repo), milestoneRefPattern)
+}
+
+// ReadAllLocalWithResolver read and parse all local Milestone
+func ReadAllLocalWithResolver(repo repository.ClockedRepo, identityResolver identity.Resolver) <-chan StreamedMilestone {
+	return readAll(repo, identityResolver, milestoneRefPattern)
+}
+
+// ReadAllRemote read and parse all remote Milestone for a given remote
+func ReadAllRemote(repo repository.ClockedRepo, remote string) <-chan StreamedMilestone {
+	refPrefix := fmt.Sprintf(milestoneRemoteRefPattern, remote)
+	return readAll(repo, identity.NewSimpleResolver(repo), refPrefix)
+}
+
+// readAll read and parse all available milestone with a given ref prefix
+func readAll(repo repository.ClockedRepo, identityResolver identity.Resolver, refPrefix string) <-chan StreamedMilestone {
+	out := make(chan StreamedMilestone)
+
+	go func() {
+		defer close(out)
+
+		refs, err := repo.ListRefs(refPrefix)
+		if err != nil {
+			out <- StreamedMilestone{Err: err}
+			return
+		}
+
+		for _, ref := range refs {
+			m, err := read(repo, identityResolver, ref)
+
+			if err != nil {
+				out <- StreamedMilestone{Err: err}
+				return
+			}
+
+			out <- StreamedMilestone{Milestone: m}
+		}
+	}()
+
+	return out
+}
+
+// ListLocalIds list all the available local milestone ids
+func ListLocalIds(repo repository.Repo) ([]entity.Id, error) {
+	refs, err := repo.ListRefs(milestoneRefPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]entity.Id, len(refs))
+	for i, ref := range refs {
+		split := strings.Split(ref, "/")
+		ids[i] = entity.Id(split[len(split)-1])
+	}
+
+	return ids, nil
+}
+
+// Mutate allow to create a new version of the Milestone in one go. Only the
+// changed fields are recorded.
+func (m *Milestone) Mutate(author identity.Interface, unixTime int64, f func(orig Mutator) Mutator) {
+	orig := Mutator{
+		Title:       m.Title(),
+		Description: m.Description(),
+		DueDate:     m.DueDate(),
+		Status:      m.Status(),
+	}
+	mutated := f(orig)
+
+	v := &Version{
+		unixTime: unixTime,
+		author:   author,
+	}
+
+	if mutated.Title != orig.Title {
+		v.title = &mutated.Title
+	}
+	if mutated.Description != orig.Description {
+		v.description = &mutated.Description
+	}
+	if !mutated.DueDate.Equal(orig.DueDate) {
+		due := dueDateUnix(mutated.DueDate)
+		v.dueDate = &due
+	}
+	if mutated.Status != orig.Status {
+		v.status = &mutated.Status
+	}
+
+	if v.IsEmpty() {
+		return
+	}
+
+	m.versions = append(m.versions, v)
+}
+
+// SetMetadata store arbitrary metadata along the last not-commit Version.
+// If the Version has been commit to git already, a new version is added and will need to be
+// commit.
+func (m *Milestone) SetMetadata(author identity.Interface, unixTime int64, key string, value string) {
+	last := m.versions[len(m.versions)-1]
+	if last.commitHash != "" {
+		last = &Version{
+			unixTime: unixTime,
+			author:   author,
+		}
+		m.versions = append(m.versions, last)
+	}
+	last.SetMetadata(key, value)
+}
+
+// Commit write the Milestone into the Repository. In particular, this ensure that
+// the Id is properly set.
+func (m *Milestone) Commit(repo repository.ClockedRepo) error {
+	if !m.NeedCommit() {
+		return fmt.Errorf("can't commit a milestone with no pending version")
+	}
+
+	if err := m.Validate(); err != nil {
+		return errors.Wrap(err, "can't commit a milestone with invalid data")
+	}
+
+	editClock, err := repo.GetOrCreateClock(editClockName)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range m.versions {
+		if v.commitHash != "" {
+			m.lastCommit = v.commitHash
+			// ignore already commit versions
+			continue
+		}
+
+		v.time, err = editClock.Increment()
+		if err != nil {
+			return err
+		}
+
+		blobHash, err := v.Write(repo)
+		if err != nil {
+			return err
+		}
+
+		// Make a git tree referencing the blob
+		tree := []repository.TreeEntry{
+			{ObjectType: repository.Blob, Hash: blobHash, Name: versionEntryName},
+		}
+
+		treeHash, err := repo.StoreTree(tree)
+		if err != nil {
+			return err
+		}
+
+		var commitHash repository.Hash
+		if m.lastCommit != "" {
+			commitHash, err = repo.StoreCommitWithParent(treeHash, m.lastCommit)
+		} else {
+			commitHash, err = repo.StoreCommit(treeHash)
+		}
+		if err != nil {
+			return err
+		}
+
+		m.lastCommit = commitHash
+		v.commitHash = commitHash
+
+		// if it was the first commit, use the commit hash as the Milestone id
+		if m.id == "" || m.id == entity.UnsetId {
+			m.id = entity.Id(commitHash)
+		}
+	}
+
+	ref := fmt.Sprintf("%s%s", milestoneRefPattern, m.id)
+	return repo.UpdateRef(ref, m.lastCommit)
+}
+
+func (m *Milestone) CommitAsNeeded(repo repository.ClockedRepo) error {
+	if !m.NeedCommit() {
+		return nil
+	}
+	return m.Commit(repo)
+}
+
+func (m *Milestone) NeedCommit() bool {
+	for _, v := range m.versions {
+		if v.commitHash == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Merge a different version of the same Milestone by rebasing the versions of
+// this Milestone that are not present in the other on top of the chain of
+// versions of the other. As the state is computed from the lamport time of the
+// versions, the result doesn't depend on which side is rebased.
+func (m *Milestone) Merge(repo repository.Repo, other *Milestone) (bool, error) {
+	if m.id != other.id {
+		return false, errors.New("merging unrelated milestones is not supported")
+	}
+
+	if m.lastCommit == "" || other.lastCommit == "" {
+		return false, errors.New("can't merge a milestone that has never been stored")
+	}
+
+	if m.NeedCommit() || other.NeedCommit() {
+		return false, errors.New("merging a milestone with pending versions is not supported")
+	}
+
+	ancestor, err := repo.FindCommonAncestor(m.lastCommit, other.lastCommit)
+	if err != nil {
+		return false, errors.Wrap(err, "can't find common ancestor")
+	}
+
+	ancestorIndex := -1
+	newVersions := make([]*Version, 0, len(m.versions))
+
+	// Find the root of the rebase
+	for i, v := range m.versions {
+		newVersions = append(newVersions, v)
+
+		if v.commitHash == ancestor {
+			ancestorIndex = i
+			break
+		}
+	}
+
+	if ancestorIndex < 0 {
+		return false, errors.New("no common history")
+	}
+
+	if len(other.versions) == ancestorIndex+1 {
+		// Nothing to rebase, return early
+		return false, nil
+	}
+
+	// get other milestone's extra versions
+	for i := ancestorIndex + 1; i < len(other.versions); i++ {
+		newVersions = append(newVersions, other.versions[i])
+		m.lastCommit = other.versions[i].commitHash
+	}
+
+	// rebase our extra versions
+	for i := ancestorIndex + 1; i < len(m.versions); i++ {
+		v := m.versions[i]
+
+		// get the referenced git tree
+		treeHash, err := repo.GetTreeHash(v.commitHash)
+		if err != nil {
+			return false, err
+		}
+
+		// create a new commit with the correct ancestor
+		hash, err := repo.StoreCommitWithParent(treeHash, m.lastCommit)
+		if err != nil {
+			return false, err
+		}
+
+		v.commitHash = hash
+		newVersions = append(newVersions, v)
+		m.lastCommit = hash
+	}
+
+	m.versions = newVersions
+
+	// Update the git ref
+	err = repo.UpdateRef(milestoneRefPattern+m.id.String(), m.lastCommit)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Validate check if the Milestone data is valid
+func (m *Milestone) Validate() error {
+	if len(m.versions) == 0 {
+		return fmt.Errorf("no version")
+	}
+
+	for _, v := range m.versions {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+
+	first := m.versions[0]
+	if first.title == nil || first.status == nil {
+		return fmt.Errorf("the first version should set the title and status")
+	}
+
+	// The milestone Id should be the hash of the first commit
+	if first.commitHash != "" && string(first.commitHash) != m.id.String() {
+		return fmt.Errorf("milestone id should be the first commit hash")
+	}
+
+	return nil
+}
+
+// Id return the Milestone identifier
+func (m *Milestone) Id() entity.Id {
+	if m.id == "" || m.id == entity.UnsetId {
+		// simply panic as it would be a coding error
+		// (using an id of a milestone not stored yet)
+		panic("no id yet")
+	}
+	return m.id
+}
+
+// orderedVersions return the versions in the order they should be applied:
+// the committed ones by lamport time, then the pending ones.
+func (m *Milestone) orderedVersions() []*Version {
+	result := make([]*Version, len(m.versions))
+	copy(result, m.versions)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		vi, vj := result[i], result[j]
+		// pending versions come last, in their creation order
+		if vi.commitHash == "" || vj.commitHash == "" {
+			return vi.commitHash != "" && vj.commitHash == ""
+		}
+		if vi.time != vj.time {
+			return vi.time < vj.time
+		}
+		if vi.unixTime != vj.unixTime {
+			return vi.unixTime < vj.unixTime
+		}
+		// last resort, to not depend on the order of the commits
+		return versionKey(vi) < versionKey(vj)
+	})
+
+	return result
+}
+
+// Title return the current title of the Milestone
+func (m *Milestone) Title() string {
+	var result string
+	for _, v := range m.orderedVersions() {
+		if v.title != nil {
+			result = *v.title
+		}
+	}
+	return result
+}
+
+// Description return the current description of the Milestone
+func (m *Milestone) Description() string {
+	var result string
+	for _, v := range m.orderedVersions() {
+		if v.description != nil {
+			result = *v.description
+		}
+	}
+	return result
+}
+
+// DueDate return the current due date of the Milestone, or the zero time
+// if there is none
+func (m *Milestone) DueDate() time.Time {
+	var result int64
+	for _, v := range m.orderedVersions() {
+		if v.dueDate != nil {
+			result = *v.dueDate
+		}
+	}
+	if result == 0 {
+		return time.Time{}
+	}
+	return time.Unix(result, 0)
+}
+
+// Status return the current status of the Milestone
+func (m *Milestone) Status() bug.Status {
+	var result bug.Status
+	for _, v := range m.orderedVersions() {
+		if v.status != nil {
+			result = *v.status
+		}
+	}
+	return result
+}
+
+// Author return the author of the Milestone
+func (m *Milestone) Author() identity.Interface {
+	return m.versions[0].author
+}
+
+// CreateTime return the time of creation of the Milestone
+func (m *Milestone) CreateTime() timestamp.Timestamp {
+	return timestamp.Timestamp(m.versions[0].unixTime)
+}
+
+// LastEdit return the time of the last edition of the Milestone
+func (m *Milestone) LastEdit() timestamp.Timestamp {
+	var result int64
+	for _, v := range m.versions {
+		if v.unixTime > result {
+			result = v.unixTime
+		}
+	}
+	return timestamp.Timestamp(result)
+}
+
+// EditLamportTime return the Lamport time of the last edit
+func (m *Milestone) EditLamportTime() lamport.Time {
+	var result lamport.Time
+	for _, v := range m.versions {
+		if v.time > result {
+			result = v.time
+		}
+	}
+	return result
+}
+
+// ImmutableMetadata return all metadata for this Milestone, accumulated from each Version.
+// If multiple value are found, the first defined takes precedence.
+func (m *Milestone) ImmutableMetadata() map[string]string {
+	metadata := make(map[string]string)
+
+	for _, version := range m.versions {
+		for key, value := range version.metadata {
+			if _, has := metadata[key]; !has {
+				metadata[key] = value
+			}
+		}
+	}
+
+	return metadata
+}
+
+// versionKey return a string that totally order the versions
+func versionKey(v *Version) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+func dueDateUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,139 @@
+package milestone
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// Fetch retrieve updates from a remote
+// This does not change the local milestones state
+func Fetch(repo repository.Repo, remote string) (string, error) {
+	// "refs/milestones/*:refs/remotes/<remote>/milestones/*"
+	remoteRefSpec := fmt.Sprintf(milestoneRemoteRefPattern, remote)
+	fetchRefSpec := fmt.Sprintf("%s*:%s*", milestoneRefPattern, remoteRefSpec)
+
+	return repo.FetchRefs(remote, fetchRefSpec)
+}
+
+// Push update a remote with the local changes
+func Push(repo repository.Repo, remote string) (string, error) {
+	// "refs/milestones/*:refs/milestones/*"
+	refspec := fmt.Sprintf("%s*:%s*", milestoneRefPattern, milestoneRefPattern)
+
+	return repo.PushRefs(remote, refspec)
+}
+
+// Pull will do a Fetch + MergeAll
+// This function will return an error if a merge fail
+func Pull(repo repository.ClockedRepo, remote string) error {
+	_, err := Fetch(repo, remote)
+	if err != nil {
+		return err
+	}
+
+	for merge := range MergeAll(repo, remote) {
+		if merge.Err != nil {
+			return merge.Err
+		}
+		if merge.Status == entity.MergeStatusInvalid {
+			return errors.Errorf("merge failure: %s", merge.Reason)
+		}
+	}
+
+	return nil
+}
+
+// MergeAll will merge all the available remote milestones
+func MergeAll(repo repository.ClockedRepo, remote string) <-chan entity.MergeResult {
+	return MergeAllWithResolver(repo, identity.NewSimpleResolver(repo), remote)
+}
+
+// MergeAllWithResolver will merge all the available remote milestones, loading
+// the identities through the given resolver
+func MergeAllWithResolver(repo repository.ClockedRepo, identityResolver identity.Resolver, remote string) <-chan entity.MergeResult {
+	out := make(chan entity.MergeResult)
+
+	go func() {
+		defer close(out)
+
+		remoteRefSpec := fmt.Sprintf(milestoneRemoteRefPattern, remote)
+		remoteRefs, err := repo.ListRefs(remoteRefSpec)
+
+		if err != nil {
+			out <- entity.MergeResult{Err: err}
+			return
+		}
+
+		for _, remoteRef := range remoteRefs {
+			refSplit := strings.Split(remoteRef, "/")
+			id := entity.Id(refSplit[len(refSplit)-1])
+
+			if err := id.Validate(); err != nil {
+				out <- entity.NewMergeInvalidStatus(id, errors.Wrap(err, "invalid ref").Error())
+				continue
+			}
+
+			remoteMilestone, err := read(repo, identityResolver, remoteRef)
+
+			if err != nil {
+				out <- entity.NewMergeInvalidStatus(id, errors.Wrap(err, "remote milestone is not readable").Error())
+				continue
+			}
+
+			// Check for error in remote data
+			if err := remoteMilestone.Validate(); err != nil {
+				out <- entity.NewMergeInvalidStatus(id, errors.Wrap(err, "remote milestone is invalid").Error())
+				continue
+			}
+
+			localRef := milestoneRefPattern + remoteMilestone.Id().String()
+			localExist, err := repo.RefExist(localRef)
+
+			if err != nil {
+				out <- entity.NewMergeError(err, id)
+				continue
+			}
+
+			// the milestone is not local yet, simply create the reference
+			if !localExist {
+				err := repo.CopyRef(remoteRef, localRef)
+
+				if err != nil {
+					out <- entity.NewMergeError(err, id)
+					return
+				}
+
+				out <- entity.NewMergeStatus(entity.MergeStatusNew, id, remoteMilestone)
+				continue
+			}
+
+			localMilestone, err := read(repo, identityResolver, localRef)
+
+			if err != nil {
+				out <- entity.NewMergeError(errors.Wrap(err, "local milestone is not readable"), id)
+				return
+			}
+
+			updated, err := localMilestone.Merge(repo, remoteMilestone)
+
+			if err != nil {
+				out <- entity.NewMergeInvalidStatus(id, errors.Wrap(err, "merge failed").Error())
+				continue
+			}
+
+			if updated {
+				out <- entity.NewMergeStatus(entity.MergeStatusUpdated, id, localMilestone)
+			} else {
+				out <- entity.NewMergeStatus(entity.MergeStatusNothing, id, localMilestone)
+			}
+		}
+	}()
+
+	return out
+}
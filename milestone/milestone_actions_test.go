@@ -0,0 +1,77 @@
+package milestone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestPushPull(t *testing.T) {
+	repoA, repoB, remote := repository.SetupReposAndRemote()
+	defer repository.CleanupTestRepos(repoA, repoB, remote)
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repoA)
+	require.NoError(t, err)
+
+	_, err = identity.Push(repoA, "origin")
+	require.NoError(t, err)
+	err = identity.Pull(repoB, "origin")
+	require.NoError(t, err)
+
+	m := NewMilestone(rene, time.Now().Unix(), "v1.0", "", time.Time{})
+	err = m.Commit(repoA)
+	require.NoError(t, err)
+
+	// A --> remote --> B
+	_, err = Push(repoA, "origin")
+	require.NoError(t, err)
+
+	err = Pull(repoB, "origin")
+	require.NoError(t, err)
+
+	mB, err := ReadLocal(repoB, m.Id())
+	require.NoError(t, err)
+	require.Equal(t, "v1.0", mB.Title())
+
+	// concurrent edition of different fields
+	m.Mutate(rene, time.Now().Unix(), func(orig Mutator) Mutator {
+		orig.Title = "v1.0.0"
+		return orig
+	})
+	err = m.Commit(repoA)
+	require.NoError(t, err)
+
+	mB.Mutate(rene, time.Now().Unix(), func(orig Mutator) Mutator {
+		orig.Status = bug.ClosedStatus
+		return orig
+	})
+	err = mB.Commit(repoB)
+	require.NoError(t, err)
+
+	// A --> remote --> B
+	_, err = Push(repoA, "origin")
+	require.NoError(t, err)
+
+	err = Pull(repoB, "origin")
+	require.NoError(t, err)
+
+	// B --> remote --> A
+	_, err = Push(repoB, "origin")
+	require.NoError(t, err)
+
+	err = Pull(repoA, "origin")
+	require.NoError(t, err)
+
+	for _, repo := range []repository.ClockedRepo{repoA, repoB} {
+		loaded, err := ReadLocal(repo, m.Id())
+		require.NoError(t, err)
+		require.Equal(t, "v1.0.0", loaded.Title())
+		require.Equal(t, bug.ClosedStatus, loaded.Status())
+	}
+}
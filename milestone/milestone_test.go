@@ -0,0 +1,103 @@
+package milestone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestMilestoneCommitLoad(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repo)
+	require.NoError(t, err)
+
+	due := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	m := NewMilestone(rene, time.Now().Unix(), "v1.0", "first release", due)
+	require.True(t, m.NeedCommit())
+
+	err = m.Commit(repo)
+	require.NoError(t, err)
+	require.False(t, m.NeedCommit())
+
+	loaded, err := ReadLocal(repo, m.Id())
+	require.NoError(t, err)
+	require.Equal(t, m.Id(), loaded.Id())
+	require.Equal(t, "v1.0", loaded.Title())
+	require.Equal(t, "first release", loaded.Description())
+	require.True(t, due.Equal(loaded.DueDate()))
+	require.Equal(t, bug.OpenStatus, loaded.Status())
+	require.Equal(t, rene.Id(), loaded.Author().Id())
+
+	// edition
+	loaded.Mutate(rene, time.Now().Unix(), func(orig Mutator) Mutator {
+		orig.Status = bug.ClosedStatus
+		orig.DueDate = time.Time{}
+		return orig
+	})
+	require.True(t, loaded.NeedCommit())
+	require.Equal(t, bug.ClosedStatus, loaded.Status())
+
+	err = loaded.Commit(repo)
+	require.NoError(t, err)
+
+	loaded, err = ReadLocal(repo, m.Id())
+	require.NoError(t, err)
+	require.Equal(t, "v1.0", loaded.Title())
+	require.Equal(t, bug.ClosedStatus, loaded.Status())
+	require.True(t, loaded.DueDate().IsZero())
+
+	// no-op edition
+	loaded.Mutate(rene, time.Now().Unix(), func(orig Mutator) Mutator {
+		return orig
+	})
+	require.False(t, loaded.NeedCommit())
+
+	ids, err := ListLocalIds(repo)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ids))
+}
+
+func TestMilestoneValidate(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repo)
+	require.NoError(t, err)
+
+	require.NoError(t, NewMilestone(rene, time.Now().Unix(), "v1.0", "", time.Time{}).Validate())
+	require.Error(t, NewMilestone(rene, time.Now().Unix(), "", "", time.Time{}).Validate())
+	require.Error(t, NewMilestone(rene, time.Now().Unix(), "v1\nv2", "", time.Time{}).Validate())
+	require.Error(t, NewMilestone(nil, time.Now().Unix(), "v1.0", "", time.Time{}).Validate())
+}
+
+func TestMilestoneMetadata(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repo)
+	require.NoError(t, err)
+
+	m := NewMilestone(rene, time.Now().Unix(), "v1.0", "", time.Time{})
+	m.SetMetadata(rene, time.Now().Unix(), "key1", "value1")
+	err = m.Commit(repo)
+	require.NoError(t, err)
+
+	m.SetMetadata(rene, time.Now().Unix(), "key1", "value2")
+	m.SetMetadata(rene, time.Now().Unix(), "key2", "value2")
+	err = m.Commit(repo)
+	require.NoError(t, err)
+
+	loaded, err := ReadLocal(repo, m.Id())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	}, loaded.ImmutableMetadata())
+}
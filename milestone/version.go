@@ -0,0 +1,213 @@
+package milestone
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/lamport"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// 1: original format
+const formatVersion = 1
+
+// Version is a change of a Milestone at a point in time. Only the fields that
+// changed are set, which allow concurrent edition of different fields to merge
+// cleanly.
+type Version struct {
+	// The lamport time at which this version become effective
+	// The reference time is the milestone edition lamport clock
+	// It must be the first field in this struct due to https://github.com/golang/go/issues/599
+	time     lamport.Time
+	unixTime int64
+
+	author identity.Interface
+
+	title       *string
+	description *string
+	// unix time of the due date, 0 to remove it
+	dueDate *int64
+	status  *bug.Status
+
+	// This optional array is here to ensure a better randomness of the milestone id to avoid collisions.
+	// It has no functional purpose and should be ignored.
+	nonce []byte
+
+	// A set of arbitrary key/value to store metadata about a version or about a Milestone in general.
+	metadata map[string]string
+
+	// Not serialized
+	commitHash repository.Hash
+}
+
+type versionJSON struct {
+	// Additional field to version the data
+	FormatVersion uint `json:"version"`
+
+	Time        lamport.Time       `json:"time"`
+	UnixTime    int64              `json:"unix_time"`
+	Author      identity.Interface `json:"author"`
+	Title       *string            `json:"title,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	DueDate     *int64             `json:"due_date,omitempty"`
+	Status      *bug.Status        `json:"status,omitempty"`
+	Nonce       []byte             `json:"nonce,omitempty"`
+	Metadata    map[string]string  `json:"metadata,omitempty"`
+}
+
+func (v *Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(versionJSON{
+		FormatVersion: formatVersion,
+		Time:          v.time,
+		UnixTime:      v.unixTime,
+		Author:        v.author,
+		Title:         v.title,
+		Description:   v.description,
+		DueDate:       v.dueDate,
+		Status:        v.status,
+		Nonce:         v.nonce,
+		Metadata:      v.metadata,
+	})
+}
+
+func (v *Version) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		FormatVersion uint              `json:"version"`
+		Time          lamport.Time      `json:"time"`
+		UnixTime      int64             `json:"unix_time"`
+		Author        json.RawMessage   `json:"author"`
+		Title         *string           `json:"title"`
+		Description   *string           `json:"description"`
+		DueDate       *int64            `json:"due_date"`
+		Status        *bug.Status       `json:"status"`
+		Nonce         []byte            `json:"nonce"`
+		Metadata      map[string]string `json:"metadata"`
+	}{}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if aux.FormatVersion != formatVersion {
+		return fmt.Errorf("unknown format version %v", aux.FormatVersion)
+	}
+
+	// delegate the decoding of the identity
+	author, err := identity.UnmarshalJSON(aux.Author)
+	if err != nil {
+		return err
+	}
+
+	v.time = aux.Time
+	v.unixTime = aux.UnixTime
+	v.author = author
+	v.title = aux.Title
+	v.description = aux.Description
+	v.dueDate = aux.DueDate
+	v.status = aux.Status
+	v.nonce = aux.Nonce
+	v.metadata = aux.Metadata
+
+	return nil
+}
+
+// IsEmpty return true if the version doesn't change anything
+func (v *Version) IsEmpty() bool {
+	return v.title == nil && v.description == nil && v.dueDate == nil &&
+		v.status == nil && len(v.metadata) == 0
+}
+
+// SetMetadata store arbitrary metadata about a version or a Milestone in general
+func (v *Version) SetMetadata(key string, value string) {
+	if v.metadata == nil {
+		v.metadata = make(map[string]string)
+	}
+	v.metadata[key] = value
+}
+
+func (v *Version) Validate() error {
+	// time must be set after a commit
+	if v.commitHash != "" && v.unixTime == 0 {
+		return fmt.Errorf("unix time not set")
+	}
+	if v.commitHash != "" && v.time == 0 {
+		return fmt.Errorf("lamport time not set")
+	}
+
+	if v.author == nil {
+		return fmt.Errorf("author not set")
+	}
+	if err := v.author.Validate(); err != nil {
+		return errors.Wrap(err, "author")
+	}
+
+	if v.IsEmpty() {
+		return fmt.Errorf("empty version")
+	}
+
+	if v.title != nil {
+		if text.Empty(*v.title) {
+			return fmt.Errorf("title is empty")
+		}
+		if strings.Contains(*v.title, "\n") {
+			return fmt.Errorf("title should be a single line")
+		}
+		if !text.Safe(*v.title) {
+			return fmt.Errorf("title is not fully printable")
+		}
+	}
+
+	if v.description != nil && !text.Safe(*v.description) {
+		return fmt.Errorf("description is not fully printable")
+	}
+
+	if v.dueDate != nil && *v.dueDate < 0 {
+		return fmt.Errorf("invalid due date")
+	}
+
+	if v.status != nil {
+		if err := v.status.Validate(); err != nil {
+			return errors.Wrap(err, "status")
+		}
+	}
+
+	return nil
+}
+
+// Write will serialize and store the Version as a git blob and return
+// its hash
+func (v *Version) Write(repo repository.Repo) (repository.Hash, error) {
+	// make sure we don't write invalid data
+	err := v.Validate()
+	if err != nil {
+		return "", errors.Wrap(err, "validation error")
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := repo.StoreData(data)
+	if err != nil {
+		return "", err
+	}
+
+	return hash, nil
+}
+
+func makeNonce(len int) []byte {
+	result := make([]byte, len)
+	_, err := rand.Read(result)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package notify
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	configKeyPrefix       = "git-bug.notify"
+	configKeySmtpAddress  = configKeyPrefix + ".smtp-address"
+	configKeySmtpUsername = configKeyPrefix + ".smtp-username"
+	configKeySmtpPassword = configKeyPrefix + ".smtp-password"
+	configKeyFrom         = configKeyPrefix + ".from"
+	configKeyTemplate     = configKeyPrefix + ".template"
+)
+
+// Config is the configuration of the notifications, read from the git
+// config:
+//
+//	git config git-bug.notify.smtp-address smtp.example.com:587
+//	git config git-bug.notify.smtp-username bot
+//	git config git-bug.notify.smtp-password secret
+//	git config git-bug.notify.from "git-bug <bugs@example.com>"
+//	git config git-bug.notify.template .git-bug/notify.tmpl
+//
+// The credentials are optional, and only sent over an encrypted connection
+// or to a local server. The template is optional as well, see Template.
+type Config struct {
+	SmtpAddress  string
+	SmtpUsername string
+	SmtpPassword string
+	From         string
+	// path of the template file, relative to the root of the working tree
+	Template string
+}
+
+// LoadConfig read the configuration of the notifications
+func LoadConfig(repo repository.RepoConfig) (Config, error) {
+	var conf Config
+
+	fields := []struct {
+		key      string
+		value    *string
+		required bool
+	}{
+		{configKeySmtpAddress, &conf.SmtpAddress, true},
+		{configKeySmtpUsername, &conf.SmtpUsername, false},
+		{configKeySmtpPassword, &conf.SmtpPassword, false},
+		{configKeyFrom, &conf.From, true},
+		{configKeyTemplate, &conf.Template, false},
+	}
+
+	for _, field := range fields {
+		value, err := repo.AnyConfig().ReadString(field.key)
+		switch {
+		case err == repository.ErrNoConfigEntry && field.required:
+			return Config{}, fmt.Errorf("notifications are not configured, %s is missing", field.key)
+		case err == repository.ErrNoConfigEntry:
+		case err != nil:
+			return Config{}, errors.Wrap(err, "can't read the notification config")
+		default:
+			*field.value = value
+		}
+	}
+
+	return conf, nil
+}
@@ -0,0 +1,286 @@
+// Package notify send emails to the participants and watchers of the bugs
+// when new operations are made, or pulled from a remote.
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// Result summarize a notification run
+type Result struct {
+	// the operations were only recorded, as it was the first run
+	Initialized bool
+	Bugs        int
+	Emails      int
+}
+
+// Notifier send an email about the new operations of each bug to its
+// participants and watchers
+type Notifier struct {
+	repo     *cache.RepoCache
+	conf     Config
+	from     *mail.Address
+	auth     smtp.Auth
+	template *template.Template
+}
+
+// NewNotifier return a Notifier configured from the git config of the
+// repository
+func NewNotifier(repo *cache.RepoCache) (*Notifier, error) {
+	conf, err := LoadConfig(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := mail.ParseAddress(conf.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid sender address")
+	}
+
+	t, err := loadTemplate(repo, conf.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &Notifier{
+		repo:     repo,
+		conf:     conf,
+		from:     from,
+		template: t,
+	}
+
+	if conf.SmtpUsername != "" {
+		host := conf.SmtpAddress
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		n.auth = smtp.PlainAuth("", conf.SmtpUsername, conf.SmtpPassword, host)
+	}
+
+	return n, nil
+}
+
+// Notify send the emails about the operations not notified yet. On the first
+// run, the existing operations are only recorded, to not flood the
+// participants with the whole history.
+func (n *Notifier) Notify() (Result, error) {
+	var result Result
+
+	st, exist, err := loadState(n.repo)
+	if err != nil {
+		return result, err
+	}
+
+	if !exist {
+		for _, id := range n.repo.AllBugsIds() {
+			b, err := n.repo.ResolveBug(id)
+			if err != nil {
+				return result, err
+			}
+			st.Notified[id] = committedKeys(opKeys(b.Snapshot(), b.OperationsEditTime()))
+		}
+		result.Initialized = true
+		return result, st.save()
+	}
+
+	watchers, err := n.watchers()
+	if err != nil {
+		return result, err
+	}
+
+	for _, id := range n.repo.AllBugsIds() {
+		b, err := n.repo.ResolveBug(id)
+		if err != nil {
+			return result, err
+		}
+		snap := b.Snapshot()
+		keys := opKeys(snap, b.OperationsEditTime())
+
+		notified := make(map[string]bool, len(st.Notified[id]))
+		for _, key := range st.Notified[id] {
+			notified[key] = true
+		}
+
+		var events []pendingEvent
+		for i, op := range snap.Operations {
+			// not committed yet, notified once it is
+			if keys[i] == "" || notified[keys[i]] {
+				continue
+			}
+			if event, ok := newEvent(op); ok {
+				events = append(events, pendingEvent{key: keys[i], author: op.GetAuthor().Id(), event: event})
+			}
+		}
+
+		if len(events) > 0 {
+			sent, err := n.notifyBug(st, snap, events, watchers)
+			result.Emails += sent
+			if err != nil {
+				return result, errors.Wrapf(err, "bug %s", id.Human())
+			}
+			result.Bugs++
+		}
+
+		// recorded after each bug to not send the emails again if a later
+		// one fail
+		committed := committedKeys(keys)
+		if len(committed) != len(st.Notified[id]) || st.Sent[id] != nil {
+			st.Notified[id] = committed
+			delete(st.Sent, id)
+			if err := st.save(); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// pendingEvent is an event not notified yet, along with the key of its
+// operation
+type pendingEvent struct {
+	key    string
+	author entity.Id
+	event  Event
+}
+
+// notifyBug send an email about the given events to each participant and
+// watcher of a bug, except to the one who did all of them. The events sent
+// to each recipient are recorded as they go, so an interrupted notification
+// doesn't send them again.
+func (n *Notifier) notifyBug(st *state, snap *bug.Snapshot, events []pendingEvent, watchers []watcher) (int, error) {
+	var recipients []identity.Interface
+	recipients = append(recipients, snap.Participants...)
+	for _, w := range watchers {
+		if w.watches(snap.Id()) {
+			recipients = append(recipients, w.identity)
+		}
+	}
+
+	seen := make(map[string]bool)
+	sent := 0
+
+	for _, recipient := range recipients {
+		address := strings.ToLower(recipient.Email())
+		if address == "" || seen[address] {
+			continue
+		}
+		seen[address] = true
+
+		already := make(map[string]bool)
+		for _, key := range st.Sent[snap.Id()][address] {
+			already[key] = true
+		}
+
+		var toSend []Event
+		var keys []string
+		authors := make(map[entity.Id]bool)
+		for _, e := range events {
+			if already[e.key] {
+				continue
+			}
+			toSend = append(toSend, e.event)
+			keys = append(keys, e.key)
+			authors[e.author] = true
+		}
+
+		if len(toSend) == 0 || len(authors) == 1 && authors[recipient.Id()] {
+			continue
+		}
+
+		data := Data{
+			BugId:     snap.Id().Human(),
+			Bug:       snap,
+			Events:    toSend,
+			Recipient: recipient.DisplayName(),
+		}
+
+		subject, body, err := render(n.template, data)
+		if err != nil {
+			return sent, errors.Wrap(err, "rendering the email")
+		}
+
+		to := &mail.Address{Name: recipient.Name(), Address: recipient.Email()}
+		msg := n.message(snap.Id(), to, subject, body)
+
+		err = smtp.SendMail(n.conf.SmtpAddress, n.auth, n.from.Address, []string{to.Address}, msg)
+		if err != nil {
+			return sent, errors.Wrap(err, "sending the email")
+		}
+		sent++
+
+		if err := st.recordSent(snap.Id(), address, keys); err != nil {
+			return sent, err
+		}
+	}
+
+	return sent, nil
+}
+
+// message build the raw email. The emails about a bug reference it, so
+// they are threaded by the email clients.
+func (n *Notifier) message(bugId entity.Id, to *mail.Address, subject, body string) []byte {
+	var buf bytes.Buffer
+
+	headers := []struct{ key, value string }{
+		{"From", n.from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"References", fmt.Sprintf("<%s@git-bug>", bugId)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=utf-8"},
+		{"Content-Transfer-Encoding", "8bit"},
+	}
+	for _, h := range headers {
+		buf.WriteString(fmt.Sprintf("%s: %s\r\n", h.key, h.value))
+	}
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.Replace(body, "\n", "\r\n", -1))
+
+	return buf.Bytes()
+}
+
+// opKeys return a key for each operation, stable when the history of the bug
+// is rewritten: unlike their id, which change when a redacted comment is
+// purged, the edit Lamport time of the commit holding the operations and
+// their author stay the same. The operations not committed yet have an empty
+// key.
+func opKeys(snap *bug.Snapshot, editTimes []lamport.Time) []string {
+	keys := make([]string, len(snap.Operations))
+	count := make(map[string]int)
+	for i, op := range snap.Operations {
+		if i >= len(editTimes) || editTimes[i] == 0 {
+			continue
+		}
+		prefix := fmt.Sprintf("%d-%s", editTimes[i], op.GetAuthor().Id())
+		keys[i] = fmt.Sprintf("%s-%d", prefix, count[prefix])
+		count[prefix]++
+	}
+	return keys
+}
+
+func committedKeys(keys []string) []string {
+	var result []string
+	for _, key := range keys {
+		if key != "" {
+			result = append(result, key)
+		}
+	}
+	return result
+}
@@ -0,0 +1,198 @@
+package notify
+
+import (
+	"io/ioutil"
+	"net/mail"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestNotify(t *testing.T) {
+	server, err := newFakeSMTP()
+	require.NoError(t, err)
+	defer server.Close()
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	// not configured yet
+	_, err = NewNotifier(backend)
+	require.Error(t, err)
+
+	require.NoError(t, repo.LocalConfig().StoreString(configKeySmtpAddress, server.Addr()))
+	require.NoError(t, repo.LocalConfig().StoreString(configKeyFrom, "git-bug <bugs@example.com>"))
+
+	alice, err := backend.NewIdentityRaw("Alice", "alice@example.com", "", "", nil)
+	require.NoError(t, err)
+	bob, err := backend.NewIdentityRaw("Bob", "bob@example.com", "", "", nil)
+	require.NoError(t, err)
+	carol, err := backend.NewIdentityRaw("Carol", "carol@example.com", "", "", nil)
+	require.NoError(t, err)
+
+	// carol watch all the bugs
+	require.NoError(t, Watch(carol, WatchAll))
+
+	unix := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC).Unix()
+
+	b1, _, err := backend.NewBugRaw(alice, unix, "first bug", "first description", nil, nil)
+	require.NoError(t, err)
+
+	notifier, err := NewNotifier(backend)
+	require.NoError(t, err)
+
+	// the first run only record the existing operations
+	result, err := notifier.Notify()
+	require.NoError(t, err)
+	require.True(t, result.Initialized)
+	require.Empty(t, server.received())
+
+	comment, err := b1.AddCommentRaw(bob, unix+60, "first comment", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, b1.CommitAsNeeded())
+
+	result, err = notifier.Notify()
+	require.NoError(t, err)
+	require.Equal(t, Result{Bugs: 1, Emails: 2}, result)
+
+	// the author of the comment is not notified
+	emails := server.received()
+	require.Len(t, emails, 2)
+	recipients := []string{emails[0].to[0], emails[1].to[0]}
+	require.ElementsMatch(t, []string{"alice@example.com", "carol@example.com"}, recipients)
+
+	msg, err := mail.ReadMessage(strings.NewReader(emails[0].data))
+	require.NoError(t, err)
+	require.Equal(t, "bugs@example.com", emails[0].from)
+	require.Equal(t, "["+b1.Id().Human()+"] first bug", msg.Header.Get("Subject"))
+	body, err := ioutil.ReadAll(msg.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "Bob commented on 2020-06-01")
+	require.Contains(t, string(body), "first comment")
+
+	// nothing new
+	result, err = notifier.Notify()
+	require.NoError(t, err)
+	require.Equal(t, Result{}, result)
+	require.Empty(t, server.received())
+
+	// a new bug, only the watcher is notified
+	b2, _, err := backend.NewBugRaw(bob, unix+120, "second bug", "second description", nil, nil)
+	require.NoError(t, err)
+
+	result, err = notifier.Notify()
+	require.NoError(t, err)
+	require.Equal(t, Result{Bugs: 1, Emails: 1}, result)
+
+	emails = server.received()
+	require.Len(t, emails, 1)
+	require.Equal(t, []string{"carol@example.com"}, emails[0].to)
+	require.Contains(t, emails[0].data, "Bob opened the bug")
+	require.Contains(t, emails[0].data, b2.Id().Human())
+
+	// a failure partway only send the remaining emails on the next run
+	server.reject("carol@example.com")
+
+	_, err = b1.AddCommentRaw(bob, unix+180, "second comment", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, b1.CommitAsNeeded())
+
+	_, err = notifier.Notify()
+	require.Error(t, err)
+
+	emails = server.received()
+	require.Len(t, emails, 1)
+	require.Equal(t, []string{"alice@example.com"}, emails[0].to)
+
+	server.reject("")
+
+	result, err = notifier.Notify()
+	require.NoError(t, err)
+	require.Equal(t, Result{Bugs: 1, Emails: 1}, result)
+
+	emails = server.received()
+	require.Len(t, emails, 1)
+	require.Equal(t, []string{"carol@example.com"}, emails[0].to)
+	require.Contains(t, emails[0].data, "second comment")
+	require.NotContains(t, emails[0].data, "first comment")
+
+	// purging a redacted comment change the ids of the operations, but
+	// they are not notified again
+	_, err = b1.RedactCommentRaw(alice, unix+240, comment.Id(), nil)
+	require.NoError(t, err)
+	require.NoError(t, b1.CommitAsNeeded())
+
+	_, err = notifier.Notify()
+	require.NoError(t, err)
+	server.received()
+
+	_, _, err = backend.PurgeRedactedComments(b1.Id())
+	require.NoError(t, err)
+
+	result, err = notifier.Notify()
+	require.NoError(t, err)
+	require.Equal(t, Result{}, result)
+	require.Empty(t, server.received())
+}
+
+func TestNotifyTemplate(t *testing.T) {
+	server, err := newFakeSMTP()
+	require.NoError(t, err)
+	defer server.Close()
+
+	repo := repository.CreateGoGitTestRepo(false)
+	defer repository.CleanupTestRepos(repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	// a template in the working tree, redefining the subject only
+	path := filepath.Join(filepath.Dir(repo.GetPath()), "notify.tmpl")
+	err = ioutil.WriteFile(path, []byte(`{{define "subject"}}Bug "{{.Bug.Title}}" for {{.Recipient}}{{end}}`), 0644)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.LocalConfig().StoreString(configKeySmtpAddress, server.Addr()))
+	require.NoError(t, repo.LocalConfig().StoreString(configKeyFrom, "bugs@example.com"))
+	require.NoError(t, repo.LocalConfig().StoreString(configKeyTemplate, "notify.tmpl"))
+
+	alice, err := backend.NewIdentityRaw("Alice", "alice@example.com", "", "", nil)
+	require.NoError(t, err)
+	bob, err := backend.NewIdentityRaw("Bob", "bob@example.com", "", "", nil)
+	require.NoError(t, err)
+
+	b, _, err := backend.NewBugRaw(alice, time.Now().Unix(), "a bug", "description", nil, nil)
+	require.NoError(t, err)
+
+	notifier, err := NewNotifier(backend)
+	require.NoError(t, err)
+	_, err = notifier.Notify()
+	require.NoError(t, err)
+
+	_, err = b.CloseRaw(bob, time.Now().Unix(), nil)
+	require.NoError(t, err)
+	require.NoError(t, b.CommitAsNeeded())
+
+	_, err = notifier.Notify()
+	require.NoError(t, err)
+
+	emails := server.received()
+	require.Len(t, emails, 1)
+
+	msg, err := mail.ReadMessage(strings.NewReader(emails[0].data))
+	require.NoError(t, err)
+	require.Equal(t, `Bug "a bug" for Alice`, msg.Header.Get("Subject"))
+	body, err := ioutil.ReadAll(msg.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "Bob changed the status to closed")
+}
@@ -0,0 +1,135 @@
+package notify
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"sync"
+)
+
+// fakeSMTP is a local SMTP server recording the emails it receives
+type fakeSMTP struct {
+	listener net.Listener
+
+	mu     sync.Mutex
+	emails []fakeEmail
+	// address refused as a recipient, if any
+	rejected string
+}
+
+type fakeEmail struct {
+	from string
+	to   []string
+	data string
+}
+
+func newFakeSMTP() (*fakeSMTP, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &fakeSMTP{listener: listener}
+	go s.serve()
+	return s, nil
+}
+
+func (s *fakeSMTP) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeSMTP) Close() error {
+	return s.listener.Close()
+}
+
+// reject refuse the given address as a recipient, none if empty
+func (s *fakeSMTP) reject(address string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejected = address
+}
+
+// received return the emails received, and forget them
+func (s *fakeSMTP) received() []fakeEmail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	emails := s.emails
+	s.emails = nil
+	return emails
+}
+
+func (s *fakeSMTP) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTP) handle(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 localhost fake SMTP")
+
+	var email fakeEmail
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250-localhost")
+			reply("250 8BITMIME")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			// drop the parameters, such as BODY=8BITMIME
+			email = fakeEmail{from: strings.Trim(strings.Fields(line[len("MAIL FROM:"):])[0], "<>")}
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			address := strings.Trim(line[len("RCPT TO:"):], "<> ")
+			s.mu.Lock()
+			rejected := address == s.rejected
+			s.mu.Unlock()
+			if rejected {
+				reply("550 mailbox unavailable")
+				continue
+			}
+			email.to = append(email.to, address)
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				// undo the dot-stuffing
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			email.data = data.String()
+			s.mu.Lock()
+			s.emails = append(s.emails, email)
+			s.mu.Unlock()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
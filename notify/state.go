@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const stateFile = "notify-state"
+
+// state record the operations already notified, for each bug. As the
+// operations pulled can be older than the ones created locally, neither
+// their time nor their order can tell which ones are new. The operations are
+// identified by the keys of opKeys.
+type state struct {
+	path string
+
+	Notified map[entity.Id][]string `json:"notified"`
+	// for a bug whose notification was interrupted, the operations already
+	// sent to each address
+	Sent map[entity.Id]map[string][]string `json:"sent,omitempty"`
+}
+
+func statePath(repo repository.RepoCommon) string {
+	return filepath.Join(repo.GetPath(), "git-bug", stateFile)
+}
+
+// loadState read the state of the notifications, or return false if there
+// was never any notification
+func loadState(repo repository.RepoCommon) (*state, bool, error) {
+	s := &state{
+		path:     statePath(repo),
+		Notified: make(map[entity.Id][]string),
+		Sent:     make(map[entity.Id]map[string][]string),
+	}
+
+	raw, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return s, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.Wrap(err, "can't read the notification state")
+	}
+
+	if err := json.Unmarshal(raw, s); err != nil {
+		return nil, false, errors.Wrap(err, "invalid notification state")
+	}
+	if s.Sent == nil {
+		s.Sent = make(map[entity.Id]map[string][]string)
+	}
+
+	return s, true, nil
+}
+
+func (s *state) save() error {
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return err
+	}
+
+	// write then rename to not lose the state if interrupted
+	tmp := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, raw, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// recordSent record the operations sent to an address, before the whole
+// notification of the bug is done
+func (s *state) recordSent(bugId entity.Id, address string, keys []string) error {
+	if s.Sent[bugId] == nil {
+		s.Sent[bugId] = make(map[string][]string)
+	}
+	s.Sent[bugId][address] = append(s.Sent[bugId][address], keys...)
+	return s.save()
+}
@@ -0,0 +1,168 @@
+package notify
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// the default template of the emails. A custom template can redefine the
+// "subject" and "body" templates.
+const defaultTemplate = `{{define "subject"}}[{{.BugId}}] {{.Bug.Title}}{{end}}
+{{- define "body"}}
+{{- range .Events}}
+{{.Author}} {{.Action}} on {{.Time.Format "2006-01-02 15:04"}}
+{{- if .Message}}:
+
+{{.Message}}
+{{- end}}
+
+{{end -}}
+--
+Bug {{.BugId}}, {{.Bug.StatusName}}
+You receive this email because you participate in or watch this bug.
+{{end}}`
+
+// Data is given to the templates to render the email about a bug
+type Data struct {
+	// human readable id of the bug
+	BugId string
+	Bug   *bug.Snapshot
+	// what happened in the bug since the last notification
+	Events []Event
+	// name of the recipient
+	Recipient string
+}
+
+// Event describe an operation made on a bug
+type Event struct {
+	Author string
+	Time   time.Time
+	// what the author did, such as "commented"
+	Action string
+	// text of the comment, if any
+	Message string
+}
+
+// loadTemplate return the default template, overridden by the custom
+// template file if any
+func loadTemplate(repo repository.RepoCommon, path string) (*template.Template, error) {
+	t := template.Must(template.New("notify").Parse(defaultTemplate))
+
+	if path == "" {
+		return t, nil
+	}
+
+	if !filepath.IsAbs(path) {
+		root := repo.GetPath()
+		if filepath.Base(root) == ".git" {
+			root = filepath.Dir(root)
+		}
+		path = filepath.Join(root, path)
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't read the notification template")
+	}
+
+	t, err = t.Parse(string(raw))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid notification template")
+	}
+
+	return t, nil
+}
+
+// render return the subject and the body of the email
+func render(t *template.Template, data Data) (string, string, error) {
+	var subject, body strings.Builder
+
+	if err := t.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", err
+	}
+	if err := t.ExecuteTemplate(&body, "body", data); err != nil {
+		return "", "", err
+	}
+
+	// the subject is a single line header
+	return strings.Join(strings.Fields(subject.String()), " "), body.String(), nil
+}
+
+// newEvent describe an operation, or return false if the operation is not
+// worth a notification
+func newEvent(op bug.Operation) (Event, bool) {
+	event := Event{
+		Author: op.GetAuthor().DisplayName(),
+		Time:   op.Time(),
+	}
+
+	switch op := op.(type) {
+	case *bug.CreateOperation:
+		event.Action = "opened the bug"
+		event.Message = op.Message
+	case *bug.AddCommentOperation:
+		event.Action = "commented"
+		event.Message = op.Message
+	case *bug.EditCommentOperation:
+		event.Action = "edited a comment"
+		event.Message = op.Message
+	case *bug.SetTitleOperation:
+		event.Action = fmt.Sprintf("changed the title to \"%s\"", op.Title)
+	case *bug.SetStatusOperation:
+		event.Action = statusAction(op)
+	case *bug.LabelChangeOperation:
+		event.Action = labelAction(op)
+	case *bug.SetAssigneesOperation:
+		event.Action = "changed the assignees"
+	case *bug.SetMilestoneOperation:
+		event.Action = "changed the milestone"
+	case *bug.SetLinksOperation:
+		event.Action = "changed the links"
+	case *bug.RedactCommentOperation:
+		event.Action = "redacted a comment"
+	default:
+		// metadata, reactions ...
+		return Event{}, false
+	}
+
+	return event, true
+}
+
+func statusAction(op *bug.SetStatusOperation) string {
+	status := op.Status.String()
+	if op.WorkflowStatus != "" {
+		status = op.WorkflowStatus
+	}
+	if op.Resolution != "" {
+		return fmt.Sprintf("changed the status to %s (%s)", status, op.Resolution)
+	}
+	return fmt.Sprintf("changed the status to %s", status)
+}
+
+func labelAction(op *bug.LabelChangeOperation) string {
+	join := func(labels []bug.Label) string {
+		names := make([]string, len(labels))
+		for i, label := range labels {
+			names[i] = label.String()
+		}
+		return strings.Join(names, ", ")
+	}
+
+	switch {
+	case len(op.Added) > 0 && len(op.Removed) > 0:
+		return fmt.Sprintf("added the labels %s and removed %s", join(op.Added), join(op.Removed))
+	case len(op.Removed) > 0:
+		return fmt.Sprintf("removed the labels %s", join(op.Removed))
+	default:
+		return fmt.Sprintf("added the labels %s", join(op.Added))
+	}
+}
@@ -0,0 +1,85 @@
+package notify
+
+import (
+	"strings"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+// MetaKeyWatch is the identity metadata holding the bugs watched by a user:
+// a comma separated list of bug id prefixes, or "*" for all the bugs. As
+// any identity metadata, it is shared with the identity when pushed.
+const MetaKeyWatch = "notify-watch"
+
+// WatchAll is the watched bug prefix matching all the bugs
+const WatchAll = "*"
+
+// Watch add a bug id prefix, or WatchAll, to the bugs watched by a user
+func Watch(user *cache.IdentityCache, prefix string) error {
+	prefixes := watchedPrefixes(user.MutableMetadata()[MetaKeyWatch])
+	for _, p := range prefixes {
+		if p == prefix {
+			return nil
+		}
+	}
+	return setWatched(user, append(prefixes, prefix))
+}
+
+// Unwatch remove a bug id prefix, or WatchAll, from the bugs watched by a
+// user. A bug is still watched if another prefix match it.
+func Unwatch(user *cache.IdentityCache, prefix string) error {
+	prefixes := watchedPrefixes(user.MutableMetadata()[MetaKeyWatch])
+	result := prefixes[:0]
+	for _, p := range prefixes {
+		if p != prefix {
+			result = append(result, p)
+		}
+	}
+	return setWatched(user, result)
+}
+
+func setWatched(user *cache.IdentityCache, prefixes []string) error {
+	user.SetMetadata(MetaKeyWatch, strings.Join(prefixes, ","))
+	return user.CommitAsNeeded()
+}
+
+func watchedPrefixes(value string) []string {
+	var result []string
+	for _, prefix := range strings.Split(value, ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			result = append(result, prefix)
+		}
+	}
+	return result
+}
+
+// watcher is an identity watching some bugs
+type watcher struct {
+	identity identity.Interface
+	prefixes []string
+}
+
+func (w watcher) watches(id entity.Id) bool {
+	for _, prefix := range w.prefixes {
+		if prefix == WatchAll || id.HasPrefix(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *Notifier) watchers() ([]watcher, error) {
+	var result []watcher
+	for _, id := range n.repo.AllIdentityIds() {
+		i, err := n.repo.ResolveIdentity(id)
+		if err != nil {
+			return nil, err
+		}
+		if prefixes := watchedPrefixes(i.MutableMetadata()[MetaKeyWatch]); len(prefixes) > 0 {
+			result = append(result, watcher{identity: i, prefixes: prefixes})
+		}
+	}
+	return result, nil
+}
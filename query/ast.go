@@ -0,0 +1,196 @@
+package query
+
+import "github.com/MichaelMure/git-bug/bug"
+
+// Node is a node of the abstract syntax tree of a query. It's either an
+// operator combining other nodes (And, Or, Not) or a filter (a leaf).
+type Node interface {
+	// sign-post method to restrict the implementations to this package
+	isNode()
+}
+
+// And match if all of its operands match
+type And struct {
+	Operands []Node
+}
+
+// Or match if any of its operands match
+type Or struct {
+	Operands []Node
+}
+
+// Not match if its operand doesn't match
+type Not struct {
+	Operand Node
+}
+
+// StatusFilter match the bugs with the given status. For the built-in
+// statuses, it's the category that is matched so that "status:open" also
+// match the bugs in any workflow status of the open category.
+type StatusFilter struct {
+	Status bug.Status
+	// name of a workflow status, empty for the built-in statuses
+	Name string
+}
+
+// ResolutionFilter match the closed bugs with the given resolution
+type ResolutionFilter struct {
+	Resolution bug.Resolution
+}
+
+// AuthorFilter match the bugs whose author match the given query
+type AuthorFilter struct {
+	Author string
+}
+
+// ActorFilter match the bugs with an actor matching the given query
+type ActorFilter struct {
+	Actor string
+}
+
+// ParticipantFilter match the bugs with a participant matching the given query
+type ParticipantFilter struct {
+	Participant string
+}
+
+// AssigneeFilter match the bugs with an assignee matching the given query
+type AssigneeFilter struct {
+	Assignee string
+}
+
+// MilestoneFilter match the bugs attached to a milestone matching the given query
+type MilestoneFilter struct {
+	Milestone string
+}
+
+// LabelFilter match the bugs with the given label
+type LabelFilter struct {
+	Label string
+}
+
+// TitleFilter match the bugs whose title contains the given query
+type TitleFilter struct {
+	Title string
+}
+
+// NoLabelFilter match the bugs without any label
+type NoLabelFilter struct{}
+
+// NoAssigneeFilter match the bugs without any assignee
+type NoAssigneeFilter struct{}
+
+// NoMilestoneFilter match the bugs not attached to a milestone
+type NoMilestoneFilter struct{}
+
+// CreatedFilter match the bugs created within the given time range
+type CreatedFilter struct {
+	Range TimeRange
+}
+
+// EditedFilter match the bugs last edited within the given time range
+type EditedFilter struct {
+	Range TimeRange
+}
+
+// ClosedFilter match the closed bugs, closed within the given time range
+type ClosedFilter struct {
+	Range TimeRange
+}
+
+// CommentsFilter match the bugs with a number of comments, not counting
+// the description, within the given range
+type CommentsFilter struct {
+	Range IntRange
+}
+
+// SearchFilter match the bugs mentioning the given full-text term in their
+// title or comments. A term made of multiple words is a phrase and match
+// only if those words appear in that order.
+type SearchFilter struct {
+	Term string
+}
+
+func (*And) isNode()               {}
+func (*Or) isNode()                {}
+func (*Not) isNode()               {}
+func (*StatusFilter) isNode()      {}
+func (*ResolutionFilter) isNode()  {}
+func (*AuthorFilter) isNode()      {}
+func (*ActorFilter) isNode()       {}
+func (*ParticipantFilter) isNode() {}
+func (*AssigneeFilter) isNode()    {}
+func (*MilestoneFilter) isNode()   {}
+func (*LabelFilter) isNode()       {}
+func (*TitleFilter) isNode()       {}
+func (*NoLabelFilter) isNode()     {}
+func (*NoAssigneeFilter) isNode()  {}
+func (*NoMilestoneFilter) isNode() {}
+func (*CreatedFilter) isNode()     {}
+func (*EditedFilter) isNode()      {}
+func (*ClosedFilter) isNode()      {}
+func (*CommentsFilter) isNode()    {}
+func (*SearchFilter) isNode()      {}
+
+// SearchTerms return the full-text terms that a bug need to mention to match,
+// that is all the SearchFilter that are not negated.
+func SearchTerms(node Node) []string {
+	var terms []string
+
+	var walk func(node Node, negated bool)
+	walk = func(node Node, negated bool) {
+		switch node := node.(type) {
+		case *And:
+			for _, operand := range node.Operands {
+				walk(operand, negated)
+			}
+		case *Or:
+			for _, operand := range node.Operands {
+				walk(operand, negated)
+			}
+		case *Not:
+			walk(node.Operand, !negated)
+		case *SearchFilter:
+			if !negated {
+				terms = append(terms, node.Term)
+			}
+		}
+	}
+
+	if node != nil {
+		walk(node, false)
+	}
+
+	return terms
+}
+
+// WorkflowStatuses return the names of the workflow statuses referenced in
+// the query
+func WorkflowStatuses(node Node) []string {
+	var names []string
+
+	var walk func(node Node)
+	walk = func(node Node) {
+		switch node := node.(type) {
+		case *And:
+			for _, operand := range node.Operands {
+				walk(operand)
+			}
+		case *Or:
+			for _, operand := range node.Operands {
+				walk(operand)
+			}
+		case *Not:
+			walk(node.Operand)
+		case *StatusFilter:
+			if node.Name != "" {
+				names = append(names, node.Name)
+			}
+		}
+	}
+
+	if node != nil {
+		walk(node)
+	}
+
+	return names
+}
@@ -6,9 +6,47 @@ import (
 	"unicode"
 )
 
+type tokenKind int
+
+const (
+	_ tokenKind = iota
+	tokenKindKV
+	tokenKindSearch
+	tokenKindAnd
+	tokenKindOr
+	tokenKindNot
+	tokenKindOpenParen
+	tokenKindCloseParen
+)
+
 type token struct {
+	kind tokenKind
+
+	// KV
 	qualifier string
 	value     string
+
+	// Search
+	term string
+}
+
+func newTokenKV(qualifier, value string) token {
+	return token{
+		kind:      tokenKindKV,
+		qualifier: qualifier,
+		value:     value,
+	}
+}
+
+func newTokenSearch(term string) token {
+	return token{
+		kind: tokenKindSearch,
+		term: term,
+	}
+}
+
+func newTokenOperator(kind tokenKind) token {
+	return token{kind: kind}
 }
 
 // tokenize parse and break a input into tokens ready to be
@@ -21,6 +59,40 @@ func tokenize(query string) ([]token, error) {
 
 	var tokens []token
 	for _, field := range fields {
+		switch field {
+		case "(":
+			tokens = append(tokens, newTokenOperator(tokenKindOpenParen))
+			continue
+		case ")":
+			tokens = append(tokens, newTokenOperator(tokenKindCloseParen))
+			continue
+		case "AND":
+			tokens = append(tokens, newTokenOperator(tokenKindAnd))
+			continue
+		case "OR":
+			tokens = append(tokens, newTokenOperator(tokenKindOr))
+			continue
+		case "NOT", "-":
+			tokens = append(tokens, newTokenOperator(tokenKindNot))
+			continue
+		}
+
+		// a leading dash negate what follows
+		if strings.HasPrefix(field, "-") && len(field) > 1 {
+			tokens = append(tokens, newTokenOperator(tokenKindNot))
+			field = field[1:]
+		}
+
+		// a quoted field or a field without qualifier is a full-text search term
+		if isQuoted(field) || !strings.Contains(field, ":") {
+			term := removeQuote(field)
+			if len(strings.TrimSpace(term)) == 0 {
+				return nil, fmt.Errorf("empty search term")
+			}
+			tokens = append(tokens, newTokenSearch(term))
+			continue
+		}
+
 		split := strings.Split(field, ":")
 		if len(split) != 2 {
 			return nil, fmt.Errorf("can't tokenize \"%s\"", field)
@@ -33,10 +105,7 @@ func tokenize(query string) ([]token, error) {
 			return nil, fmt.Errorf("empty value for qualifier \"%s\"", split[0])
 		}
 
-		tokens = append(tokens, token{
-			qualifier: split[0],
-			value:     removeQuote(split[1]),
-		})
+		tokens = append(tokens, newTokenKV(split[0], removeQuote(split[1])))
 	}
 	return tokens, nil
 }
@@ -62,14 +131,14 @@ func splitQuery(query string) ([]string, error) {
 		}
 	}
 
-	var result []string
+	var words []string
 	var token strings.Builder
 	for _, r := range query {
 		if isToken(r) {
 			token.WriteRune(r)
 		} else {
 			if token.Len() > 0 {
-				result = append(result, token.String())
+				words = append(words, token.String())
 				token.Reset()
 			}
 		}
@@ -80,25 +149,116 @@ func splitQuery(query string) ([]string, error) {
 	}
 
 	if token.Len() > 0 {
-		result = append(result, token.String())
+		words = append(words, token.String())
+	}
+
+	var result []string
+	for _, word := range words {
+		result = append(result, splitParens(word)...)
 	}
 
 	return result, nil
 }
 
+// splitParens split the grouping parenthesis out of a word. They are only
+// grouping at the start of the word, possibly after a negating dash, or at its
+// end when not closing a parenthesis of the word itself, so that
+// "title:foo(bar)" or "panic()" stay whole.
+func splitParens(word string) []string {
+	var result []string
+
+	for {
+		if strings.HasPrefix(word, "(") {
+			result = append(result, "(")
+			word = word[1:]
+			continue
+		}
+		if strings.HasPrefix(word, "-(") {
+			result = append(result, "-", "(")
+			word = word[2:]
+			continue
+		}
+		break
+	}
+
+	closing := 0
+	for strings.HasSuffix(word, ")") && parenBalance(word) < 0 {
+		word = word[:len(word)-1]
+		closing++
+	}
+
+	if word != "" {
+		result = append(result, word)
+	}
+	for i := 0; i < closing; i++ {
+		result = append(result, ")")
+	}
+
+	return result
+}
+
+// parenBalance return the count of opening parenthesis minus the count of
+// closing ones of a word, outside of its quotes
+func parenBalance(word string) int {
+	balance := 0
+	lastQuote := rune(0)
+	for _, r := range word {
+		switch {
+		case lastQuote != 0:
+			if r == lastQuote {
+				lastQuote = 0
+			}
+		case isQuote(r):
+			lastQuote = r
+		case r == '(':
+			balance++
+		case r == ')':
+			balance--
+		}
+	}
+	return balance
+}
+
 func isQuote(r rune) bool {
 	return r == '"' || r == '\''
 }
 
-func removeQuote(field string) string {
+func isQuoted(field string) bool {
 	runes := []rune(field)
 	if len(runes) >= 2 {
 		r1 := runes[0]
 		r2 := runes[len(runes)-1]
 
-		if r1 == r2 && isQuote(r1) {
-			return string(runes[1 : len(runes)-1])
-		}
+		return r1 == r2 && isQuote(r1)
+	}
+	return false
+}
+
+func removeQuote(field string) string {
+	if isQuoted(field) {
+		runes := []rune(field)
+		return string(runes[1 : len(runes)-1])
 	}
 	return field
 }
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenKindKV:
+		return "qualifier"
+	case tokenKindSearch:
+		return "search term"
+	case tokenKindAnd:
+		return "AND"
+	case tokenKindOr:
+		return "OR"
+	case tokenKindNot:
+		return "NOT"
+	case tokenKindOpenParen:
+		return "\"(\""
+	case tokenKindCloseParen:
+		return "\")\""
+	default:
+		return "unknown token"
+	}
+}
@@ -11,32 +11,90 @@ func TestTokenize(t *testing.T) {
 		input  string
 		tokens []token
 	}{
-		{"gibberish", nil},
 		{"status:", nil},
 		{":value", nil},
 
-		{"status:open", []token{{"status", "open"}}},
-		{"status:closed", []token{{"status", "closed"}}},
+		{"status:open", []token{newTokenKV("status", "open")}},
+		{"status:closed", []token{newTokenKV("status", "closed")}},
 
-		{"author:rene", []token{{"author", "rene"}}},
-		{`author:"René Descartes"`, []token{{"author", "René Descartes"}}},
+		{"author:rene", []token{newTokenKV("author", "rene")}},
+		{`author:"René Descartes"`, []token{newTokenKV("author", "René Descartes")}},
 
 		{
 			`status:open status:closed author:rene author:"René Descartes"`,
 			[]token{
-				{"status", "open"},
-				{"status", "closed"},
-				{"author", "rene"},
-				{"author", "René Descartes"},
+				newTokenKV("status", "open"),
+				newTokenKV("status", "closed"),
+				newTokenKV("author", "rene"),
+				newTokenKV("author", "René Descartes"),
 			},
 		},
 
 		// quotes
-		{`key:"value value"`, []token{{"key", "value value"}}},
-		{`key:'value value'`, []token{{"key", "value value"}}},
+		{`key:"value value"`, []token{newTokenKV("key", "value value")}},
+		{`key:'value value'`, []token{newTokenKV("key", "value value")}},
 		// unmatched quotes
 		{`key:'value value`, nil},
 		{`key:value value'`, nil},
+
+		// full text search
+		{"search", []token{newTokenSearch("search")}},
+		{"search more terms", []token{
+			newTokenSearch("search"),
+			newTokenSearch("more"),
+			newTokenSearch("terms"),
+		}},
+		{"search \"more terms\"", []token{
+			newTokenSearch("search"),
+			newTokenSearch("more terms"),
+		}},
+		{`"key:value in a phrase"`, []token{newTokenSearch("key:value in a phrase")}},
+		{`""`, nil},
+
+		// operators
+		{"label:a OR (label:b AND NOT label:c)", []token{
+			newTokenKV("label", "a"),
+			newTokenOperator(tokenKindOr),
+			newTokenOperator(tokenKindOpenParen),
+			newTokenKV("label", "b"),
+			newTokenOperator(tokenKindAnd),
+			newTokenOperator(tokenKindNot),
+			newTokenKV("label", "c"),
+			newTokenOperator(tokenKindCloseParen),
+		}},
+		{`-label:wontfix -"a phrase" -(or)`, []token{
+			newTokenOperator(tokenKindNot),
+			newTokenKV("label", "wontfix"),
+			newTokenOperator(tokenKindNot),
+			newTokenSearch("a phrase"),
+			newTokenOperator(tokenKindNot),
+			newTokenOperator(tokenKindOpenParen),
+			newTokenSearch("or"),
+			newTokenOperator(tokenKindCloseParen),
+		}},
+		{`label:"(not) a paren" "OR"`, []token{
+			newTokenKV("label", "(not) a paren"),
+			newTokenSearch("OR"),
+		}},
+		// parenthesis within a word don't group
+		{"title:foo(bar)", []token{newTokenKV("title", "foo(bar)")}},
+		{"panic()", []token{newTokenSearch("panic()")}},
+		{"((panic()))", []token{
+			newTokenOperator(tokenKindOpenParen),
+			newTokenOperator(tokenKindOpenParen),
+			newTokenSearch("panic()"),
+			newTokenOperator(tokenKindCloseParen),
+			newTokenOperator(tokenKindCloseParen),
+		}},
+		{`(label:"a)")`, []token{
+			newTokenOperator(tokenKindOpenParen),
+			newTokenKV("label", "a)"),
+			newTokenOperator(tokenKindCloseParen),
+		}},
+		{`status:open "panic in parser"`, []token{
+			newTokenKV("status", "open"),
+			newTokenSearch("panic in parser"),
+		}},
 	}
 
 	for _, tc := range tests {
@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/MichaelMure/git-bug/bug"
 )
@@ -10,6 +11,15 @@ import (
 //
 // Ex: "status:open author:descartes sort:edit-asc"
 //
+// Words or quoted phrases without a qualifier are full-text search terms.
+// If no sorting is explicitly requested, a query with search terms is sorted
+// by relevance.
+//
+// Filters can be combined with the AND, OR and NOT operators and grouped with
+// parenthesis. A leading dash is a shorthand for NOT.
+//
+// Ex: "status:open AND (label:bug OR label:crash) AND -label:needinfo"
+//
 // Supported filter qualifiers and syntax are described in docs/queries.md
 func Parse(query string) (*Query, error) {
 	tokens, err := tokenize(query)
@@ -17,52 +27,337 @@ func Parse(query string) (*Query, error) {
 		return nil, err
 	}
 
-	q := &Query{
-		OrderBy:        OrderByCreation,
-		OrderDirection: OrderDescending,
+	p := &parser{
+		tokens: tokens,
+		query: &Query{
+			OrderBy:        OrderByCreation,
+			OrderDirection: OrderDescending,
+		},
+	}
+
+	filter, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if t, ok := p.next(); ok {
+		return nil, fmt.Errorf("unexpected %s", t.kind)
+	}
+
+	p.query.Filter = filter
+
+	if !p.sortingDone && len(SearchTerms(filter)) > 0 {
+		p.query.OrderBy = OrderByRelevance
+		p.query.OrderDirection = OrderDescending
+	}
+
+	return p.query, nil
+}
+
+// parser is a recursive descent parser for the query language:
+//
+//	or      = and { "OR" and }
+//	and     = unary { [ "AND" ] unary }
+//	unary   = ( "NOT" | "-" ) unary | primary
+//	primary = "(" or ")" | qualifier ":" value | search term
+type parser struct {
+	tokens []token
+	pos    int
+
+	// how deep we are in parenthesis and negations
+	depth int
+
+	query       *Query
+	sortingDone bool
+}
+
+func (p *parser) next() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
 	}
-	sortingDone := false
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, true
+}
+
+func (p *parser) peek(kind tokenKind) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == kind
+}
 
-	for _, t := range tokens {
-		switch t.qualifier {
-		case "status", "state":
-			status, err := bug.StatusFromString(t.value)
-			if err != nil {
-				return nil, err
+func (p *parser) accept(kind tokenKind) bool {
+	if p.peek(kind) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// endOfOperand tell if there is no operand to read at this point
+func (p *parser) endOfOperand() bool {
+	return p.pos >= len(p.tokens) ||
+		p.peek(tokenKindOr) ||
+		p.peek(tokenKindAnd) ||
+		p.peek(tokenKindCloseParen)
+}
+
+func (p *parser) parseOr() (Node, error) {
+	var operands []Node
+
+	for {
+		node, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, node)
+
+		if !p.accept(tokenKindOr) {
+			break
+		}
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+
+	for _, operand := range operands {
+		if operand == nil {
+			return nil, fmt.Errorf("OR need an operand on each side")
+		}
+	}
+
+	return &Or{Operands: operands}, nil
+}
+
+func (p *parser) parseAnd() (Node, error) {
+	// runs of juxtaposed operands, separated by explicit ANDs
+	var runs [][]Node
+	var run []Node
+
+	for !p.endOfOperand() || p.peek(tokenKindAnd) {
+		if p.accept(tokenKindAnd) {
+			if len(run) == 0 || p.endOfOperand() {
+				return nil, fmt.Errorf("AND need an operand on each side")
 			}
-			q.Status = append(q.Status, status)
-		case "author":
-			q.Author = append(q.Author, t.value)
-		case "actor":
-			q.Actor = append(q.Actor, t.value)
-		case "participant":
-			q.Participant = append(q.Participant, t.value)
+			runs = append(runs, run)
+			run = nil
+			continue
+		}
+
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		// sorting doesn't produce a node
+		if node != nil {
+			run = append(run, node)
+		}
+	}
+	runs = append(runs, run)
+
+	var operands []Node
+	for _, run := range runs {
+		operands = append(operands, groupAlternatives(run)...)
+	}
+
+	switch len(operands) {
+	case 0:
+		return nil, nil
+	case 1:
+		return operands[0], nil
+	default:
+		return &And{Operands: operands}, nil
+	}
+}
+
+func (p *parser) parseUnary() (Node, error) {
+	if p.accept(tokenKindNot) {
+		if p.endOfOperand() {
+			return nil, fmt.Errorf("NOT need an operand")
+		}
+
+		p.depth++
+		operand, err := p.parseUnary()
+		p.depth--
+		if err != nil {
+			return nil, err
+		}
+
+		return &Not{Operand: operand}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Node, error) {
+	t, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of query")
+	}
+
+	switch t.kind {
+	case tokenKindOpenParen:
+		p.depth++
+		node, err := p.parseOr()
+		p.depth--
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(tokenKindCloseParen) {
+			return nil, fmt.Errorf("unmatched parenthesis")
+		}
+		if node == nil {
+			return nil, fmt.Errorf("empty parenthesis")
+		}
+		return node, nil
+
+	case tokenKindSearch:
+		return &SearchFilter{Term: t.term}, nil
+
+	case tokenKindKV:
+		return p.parseQualifier(t)
+
+	default:
+		return nil, fmt.Errorf("unexpected %s", t.kind)
+	}
+}
+
+func (p *parser) parseQualifier(t token) (Node, error) {
+	switch t.qualifier {
+	case "status", "state":
+		return ParseStatus(t.value)
+	case "resolution":
+		resolution, err := bug.ResolutionFromString(t.value)
+		if err != nil {
+			return nil, err
+		}
+		return &ResolutionFilter{Resolution: resolution}, nil
+	case "author":
+		return &AuthorFilter{Author: t.value}, nil
+	case "actor":
+		return &ActorFilter{Actor: t.value}, nil
+	case "participant":
+		return &ParticipantFilter{Participant: t.value}, nil
+	case "assignee":
+		return &AssigneeFilter{Assignee: t.value}, nil
+	case "milestone":
+		return &MilestoneFilter{Milestone: t.value}, nil
+	case "label":
+		return &LabelFilter{Label: t.value}, nil
+	case "title":
+		return &TitleFilter{Title: t.value}, nil
+	case "created":
+		r, err := parseTimeRange(t.value)
+		if err != nil {
+			return nil, err
+		}
+		return &CreatedFilter{Range: r}, nil
+	case "edited":
+		r, err := parseTimeRange(t.value)
+		if err != nil {
+			return nil, err
+		}
+		return &EditedFilter{Range: r}, nil
+	case "closed":
+		r, err := parseTimeRange(t.value)
+		if err != nil {
+			return nil, err
+		}
+		return &ClosedFilter{Range: r}, nil
+	case "comments":
+		r, err := parseIntRange(t.value)
+		if err != nil {
+			return nil, err
+		}
+		return &CommentsFilter{Range: r}, nil
+	case "no":
+		switch t.value {
 		case "label":
-			q.Label = append(q.Label, t.value)
-		case "title":
-			q.Title = append(q.Title, t.value)
-		case "no":
-			switch t.value {
-			case "label":
-				q.NoLabel = true
-			default:
-				return nil, fmt.Errorf("unknown \"no\" filter \"%s\"", t.value)
-			}
-		case "sort":
-			if sortingDone {
-				return nil, fmt.Errorf("multiple sorting")
-			}
-			err = parseSorting(q, t.value)
-			if err != nil {
-				return nil, err
-			}
-			sortingDone = true
+			return &NoLabelFilter{}, nil
+		case "assignee":
+			return &NoAssigneeFilter{}, nil
+		case "milestone":
+			return &NoMilestoneFilter{}, nil
+		default:
+			return nil, fmt.Errorf("unknown \"no\" filter \"%s\"", t.value)
+		}
+	case "sort":
+		if p.depth > 0 {
+			return nil, fmt.Errorf("sorting can't be negated or grouped")
+		}
+		if p.sortingDone {
+			return nil, fmt.Errorf("multiple sorting")
+		}
+		err := parseSorting(p.query, t.value)
+		if err != nil {
+			return nil, err
+		}
+		p.sortingDone = true
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("unknown qualifier \"%s\"", t.qualifier)
+	}
+}
+
+// ParseStatus parse a built-in status or the name of a workflow status. As
+// the workflow is defined in the repository config, the latter can only be
+// checked for existence later.
+func ParseStatus(value string) (*StatusFilter, error) {
+	if status, err := bug.StatusFromString(value); err == nil {
+		return &StatusFilter{Status: status}, nil
+	}
+
+	name := strings.ToLower(value)
+	if err := bug.ValidateWorkflowStatusName(name); err != nil {
+		return nil, fmt.Errorf("unknown status \"%s\"", value)
+	}
 
+	return &StatusFilter{Name: name}, nil
+}
+
+// groupAlternatives merge in an Or the juxtaposed filters on the status,
+// resolution, author, actor or participant, as a bug can only match one of
+// them at once for the first three, and as the historical meaning for the
+// others.
+//
+// Ex: "status:open status:closed" is "status:open OR status:closed"
+func groupAlternatives(nodes []Node) []Node {
+	var result []Node
+	index := make(map[string]int)
+
+	for _, node := range nodes {
+		var kind string
+		switch node.(type) {
+		case *StatusFilter:
+			kind = "status"
+		case *ResolutionFilter:
+			kind = "resolution"
+		case *AuthorFilter:
+			kind = "author"
+		case *ActorFilter:
+			kind = "actor"
+		case *ParticipantFilter:
+			kind = "participant"
 		default:
-			return nil, fmt.Errorf("unknown qualifier \"%s\"", t.qualifier)
+			result = append(result, node)
+			continue
+		}
+
+		i, ok := index[kind]
+		if !ok {
+			index[kind] = len(result)
+			result = append(result, node)
+			continue
+		}
+
+		if or, ok := result[i].(*Or); ok {
+			or.Operands = append(or.Operands, node)
+		} else {
+			result[i] = &Or{Operands: []Node{result[i], node}}
 		}
 	}
-	return q, nil
+
+	return result
 }
 
 func parseSorting(q *Query, value string) error {
@@ -91,6 +386,14 @@ func parseSorting(q *Query, value string) error {
 		q.OrderBy = OrderByEdit
 		q.OrderDirection = OrderAscending
 
+	// default DESC
+	case "relevance", "relevance-desc":
+		q.OrderBy = OrderByRelevance
+		q.OrderDirection = OrderDescending
+	case "relevance-asc":
+		q.OrderBy = OrderByRelevance
+		q.OrderDirection = OrderAscending
+
 	default:
 		return fmt.Errorf("unknown sorting %s", value)
 	}
@@ -1,7 +1,9 @@
 package query
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -9,71 +11,278 @@ import (
 )
 
 func TestParse(t *testing.T) {
+	now = func() time.Time { return date(2026, 10, 18) }
+	defer func() { now = time.Now }()
+
 	var tests = []struct {
 		input  string
 		output *Query
 	}{
-		{"gibberish", nil},
 		{"status:", nil},
 		{":value", nil},
 
 		{"status:open", &Query{
-			Filters: Filters{Status: []bug.Status{bug.OpenStatus}},
+			Filter: &StatusFilter{Status: bug.OpenStatus},
 		}},
 		{"status:closed", &Query{
-			Filters: Filters{Status: []bug.Status{bug.ClosedStatus}},
+			Filter: &StatusFilter{Status: bug.ClosedStatus},
+		}},
+		{"status:in-progress", &Query{
+			Filter: &StatusFilter{Name: "in-progress"},
 		}},
-		{"status:unknown", nil},
+		{"status:in_progress", nil},
+
+		{"resolution:wontfix", &Query{
+			Filter: &ResolutionFilter{Resolution: bug.ResolutionWontFix},
+		}},
+		{"resolution:WontFix", &Query{
+			Filter: &ResolutionFilter{Resolution: bug.ResolutionWontFix},
+		}},
+		{"resolution:unknown", nil},
 
 		{"author:rene", &Query{
-			Filters: Filters{Author: []string{"rene"}},
+			Filter: &AuthorFilter{Author: "rene"},
 		}},
 		{`author:"René Descartes"`, &Query{
-			Filters: Filters{Author: []string{"René Descartes"}},
+			Filter: &AuthorFilter{Author: "René Descartes"},
 		}},
 
 		{"actor:bernhard", &Query{
-			Filters: Filters{Actor: []string{"bernhard"}},
+			Filter: &ActorFilter{Actor: "bernhard"},
 		}},
 		{"participant:leonhard", &Query{
-			Filters: Filters{Participant: []string{"leonhard"}},
+			Filter: &ParticipantFilter{Participant: "leonhard"},
 		}},
 
 		{"label:hello", &Query{
-			Filters: Filters{Label: []string{"hello"}},
+			Filter: &LabelFilter{Label: "hello"},
 		}},
 		{`label:"Good first issue"`, &Query{
-			Filters: Filters{Label: []string{"Good first issue"}},
+			Filter: &LabelFilter{Label: "Good first issue"},
 		}},
 
 		{"title:titleOne", &Query{
-			Filters: Filters{Title: []string{"titleOne"}},
+			Filter: &TitleFilter{Title: "titleOne"},
 		}},
 		{`title:"Bug titleTwo"`, &Query{
-			Filters: Filters{Title: []string{"Bug titleTwo"}},
+			Filter: &TitleFilter{Title: "Bug titleTwo"},
 		}},
 
 		{"no:label", &Query{
-			Filters: Filters{NoLabel: true},
+			Filter: &NoLabelFilter{},
+		}},
+		{"assignee:isaac", &Query{
+			Filter: &AssigneeFilter{Assignee: "isaac"},
+		}},
+		{"milestone:v1.0", &Query{
+			Filter: &MilestoneFilter{Milestone: "v1.0"},
 		}},
+		{"no:milestone", &Query{
+			Filter: &NoMilestoneFilter{},
+		}},
+		{"no:assignee", &Query{
+			Filter: &NoAssigneeFilter{},
+		}},
+		{"no:unknown", nil},
 
 		{"sort:edit", &Query{
 			OrderBy: OrderByEdit,
 		}},
 		{"sort:unknown", nil},
+		{"sort:relevance-asc", &Query{
+			OrderBy:        OrderByRelevance,
+			OrderDirection: OrderAscending,
+		}},
+
+		{"gibberish", &Query{
+			Filter:         &SearchFilter{Term: "gibberish"},
+			OrderBy:        OrderByRelevance,
+			OrderDirection: OrderDescending,
+		}},
+		{`panic in "the parser"`, &Query{
+			Filter: &And{Operands: []Node{
+				&SearchFilter{Term: "panic"},
+				&SearchFilter{Term: "in"},
+				&SearchFilter{Term: "the parser"},
+			}},
+			OrderBy: OrderByRelevance,
+		}},
+		{`"panic in parser" status:open sort:edit`, &Query{
+			Filter: &And{Operands: []Node{
+				&SearchFilter{Term: "panic in parser"},
+				&StatusFilter{Status: bug.OpenStatus},
+			}},
+			OrderBy: OrderByEdit,
+		}},
 
 		{`status:open author:"René Descartes" participant:leonhard label:hello label:"Good first issue" sort:edit-desc`,
 			&Query{
-				Filters: Filters{
-					Status:      []bug.Status{bug.OpenStatus},
-					Author:      []string{"René Descartes"},
-					Participant: []string{"leonhard"},
-					Label:       []string{"hello", "Good first issue"},
-				},
+				Filter: &And{Operands: []Node{
+					&StatusFilter{Status: bug.OpenStatus},
+					&AuthorFilter{Author: "René Descartes"},
+					&ParticipantFilter{Participant: "leonhard"},
+					&LabelFilter{Label: "hello"},
+					&LabelFilter{Label: "Good first issue"},
+				}},
 				OrderBy:        OrderByEdit,
 				OrderDirection: OrderDescending,
 			},
 		},
+
+		// juxtaposed alternatives are grouped
+		{"status:open label:bug status:closed author:a author:b", &Query{
+			Filter: &And{Operands: []Node{
+				&Or{Operands: []Node{
+					&StatusFilter{Status: bug.OpenStatus},
+					&StatusFilter{Status: bug.ClosedStatus},
+				}},
+				&LabelFilter{Label: "bug"},
+				&Or{Operands: []Node{
+					&AuthorFilter{Author: "a"},
+					&AuthorFilter{Author: "b"},
+				}},
+			}},
+		}},
+		// ... unless separated by an explicit AND
+		{"actor:a AND actor:b", &Query{
+			Filter: &And{Operands: []Node{
+				&ActorFilter{Actor: "a"},
+				&ActorFilter{Actor: "b"},
+			}},
+		}},
+
+		// dates and numbers
+		{"created:>2026-01-01", &Query{
+			Filter: &CreatedFilter{Range: TimeRange{Start: date(2026, 1, 2)}},
+		}},
+		{"created:>=2026-01", &Query{
+			Filter: &CreatedFilter{Range: TimeRange{Start: date(2026, 1, 1)}},
+		}},
+		{"created:2026", &Query{
+			Filter: &CreatedFilter{Range: TimeRange{Start: date(2026, 1, 1), End: date(2027, 1, 1)}},
+		}},
+		{"edited:<7d", &Query{
+			Filter: &EditedFilter{Range: TimeRange{End: date(2026, 10, 11)}},
+		}},
+		{"edited:<=2w", &Query{
+			Filter: &EditedFilter{Range: TimeRange{End: date(2026, 10, 4)}},
+		}},
+		{"edited:>1m", &Query{
+			Filter: &EditedFilter{Range: TimeRange{Start: date(2026, 9, 18)}},
+		}},
+		{"closed:2026-09..2026-10", &Query{
+			Filter: &ClosedFilter{Range: TimeRange{Start: date(2026, 9, 1), End: date(2026, 11, 1)}},
+		}},
+		{"closed:2026-09-15..*", &Query{
+			Filter: &ClosedFilter{Range: TimeRange{Start: date(2026, 9, 15)}},
+		}},
+		{"closed:1y..24h", &Query{
+			Filter: &ClosedFilter{Range: TimeRange{Start: date(2025, 10, 18), End: date(2026, 10, 17)}},
+		}},
+		{"comments:>10", &Query{
+			Filter: &CommentsFilter{Range: IntRange{Min: 11, Max: math.MaxInt32}},
+		}},
+		{"comments:<=3", &Query{
+			Filter: &CommentsFilter{Range: IntRange{Min: 0, Max: 3}},
+		}},
+		{"comments:0", &Query{
+			Filter: &CommentsFilter{Range: IntRange{Min: 0, Max: 0}},
+		}},
+		{"comments:2..5", &Query{
+			Filter: &CommentsFilter{Range: IntRange{Min: 2, Max: 5}},
+		}},
+		{"created:7d", nil},
+		{"created:yesterday", nil},
+		{"created:2026-13-01", nil},
+		{"created:>", nil},
+		{"created:2026..", nil},
+		{"comments:-1", nil},
+		{"comments:many", nil},
+		{"comments:1..2..3", nil},
+		{"comments:10..5", nil},
+		{"closed:2026-10..2026-09", nil},
+		{"closed:24h..1y", nil},
+
+		// boolean operators
+		{"label:bug OR label:crash", &Query{
+			Filter: &Or{Operands: []Node{
+				&LabelFilter{Label: "bug"},
+				&LabelFilter{Label: "crash"},
+			}},
+		}},
+		{"-label:wontfix", &Query{
+			Filter: &Not{Operand: &LabelFilter{Label: "wontfix"}},
+		}},
+		{"NOT label:wontfix", &Query{
+			Filter: &Not{Operand: &LabelFilter{Label: "wontfix"}},
+		}},
+		{`-"some phrase"`, &Query{
+			Filter: &Not{Operand: &SearchFilter{Term: "some phrase"}},
+		}},
+		{"status:open label:a OR label:b", &Query{
+			Filter: &Or{Operands: []Node{
+				&And{Operands: []Node{
+					&StatusFilter{Status: bug.OpenStatus},
+					&LabelFilter{Label: "a"},
+				}},
+				&LabelFilter{Label: "b"},
+			}},
+		}},
+		{"status:open AND (label:bug OR label:crash) AND -label:needinfo", &Query{
+			Filter: &And{Operands: []Node{
+				&StatusFilter{Status: bug.OpenStatus},
+				&Or{Operands: []Node{
+					&LabelFilter{Label: "bug"},
+					&LabelFilter{Label: "crash"},
+				}},
+				&Not{Operand: &LabelFilter{Label: "needinfo"}},
+			}},
+		}},
+		{"-(label:a OR NOT (label:b))", &Query{
+			Filter: &Not{Operand: &Or{Operands: []Node{
+				&LabelFilter{Label: "a"},
+				&Not{Operand: &LabelFilter{Label: "b"}},
+			}}},
+		}},
+		{"(label:a) sort:id", &Query{
+			Filter:  &LabelFilter{Label: "a"},
+			OrderBy: OrderById,
+		}},
+		{"panic()", &Query{
+			Filter: &SearchFilter{Term: "panic()"},
+		}},
+		{"(panic() OR crash)", &Query{
+			Filter: &Or{Operands: []Node{
+				&SearchFilter{Term: "panic()"},
+				&SearchFilter{Term: "crash"},
+			}},
+		}},
+		{"-panic", &Query{
+			Filter:  &Not{Operand: &SearchFilter{Term: "panic"}},
+			OrderBy: OrderByCreation,
+		}},
+		{"panic OR crash", &Query{
+			Filter: &Or{Operands: []Node{
+				&SearchFilter{Term: "panic"},
+				&SearchFilter{Term: "crash"},
+			}},
+			OrderBy: OrderByRelevance,
+		}},
+
+		// operators syntax errors
+		{"OR label:a", nil},
+		{"label:a OR", nil},
+		{"label:a AND", nil},
+		{"AND label:a", nil},
+		{"label:a AND AND label:b", nil},
+		{"NOT", nil},
+		{"label:a -", nil},
+		{"(label:a", nil},
+		{"label:a)", nil},
+		{"()", nil},
+		{"-sort:edit", nil},
+		{"(sort:edit)", nil},
+		{"label:a OR sort:edit", nil},
 	}
 
 	for _, tc := range tests {
@@ -90,8 +299,18 @@ func TestParse(t *testing.T) {
 				if tc.output.OrderDirection != 0 {
 					assert.Equal(t, tc.output.OrderDirection, query.OrderDirection)
 				}
-				assert.Equal(t, tc.output.Filters, query.Filters)
+				assert.Equal(t, tc.output.Filter, query.Filter)
 			}
 		})
 	}
 }
+
+func TestSearchTerms(t *testing.T) {
+	q, err := Parse(`panic -crash (parser OR NOT (lexer OR -"the tokenizer"))`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"panic", "parser", "the tokenizer"}, SearchTerms(q.Filter))
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
@@ -1,13 +1,12 @@
 package query
 
-import "github.com/MichaelMure/git-bug/bug"
-
 // Query is the intermediary representation of a Bug's query. It is either
 // produced by parsing a query string (ex: "status:open author:rene") or created
 // manually. This query doesn't do anything by itself and need to be interpreted
 // for the specific domain of application.
 type Query struct {
-	Filters
+	// The root of the filtering expression. A nil Filter match every bugs.
+	Filter Node
 	OrderBy
 	OrderDirection
 }
@@ -20,17 +19,6 @@ func NewQuery() *Query {
 	}
 }
 
-// Filters is a collection of Filter that implement a complex filter
-type Filters struct {
-	Status      []bug.Status
-	Author      []string
-	Actor       []string
-	Participant []string
-	Label       []string
-	Title       []string
-	NoLabel     bool
-}
-
 type OrderBy int
 
 const (
@@ -38,6 +26,7 @@ const (
 	OrderById
 	OrderByCreation
 	OrderByEdit
+	OrderByRelevance
 )
 
 type OrderDirection int
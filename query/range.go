@@ -0,0 +1,231 @@
+package query
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// now is the reference time for relative dates, swappable for testing.
+var now = time.Now
+
+// TimeRange is a span of time, from Start (inclusive) to End (exclusive).
+// A zero Start or End means that the range is unbounded on that side.
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains tell if the given time is within the range
+func (r TimeRange) Contains(t time.Time) bool {
+	if !r.Start.IsZero() && t.Before(r.Start) {
+		return false
+	}
+	if !r.End.IsZero() && !t.Before(r.End) {
+		return false
+	}
+	return true
+}
+
+// IntRange is a span of integers, from Min to Max inclusive.
+type IntRange struct {
+	Min int
+	Max int
+}
+
+// Contains tell if the given number is within the range
+func (r IntRange) Contains(n int) bool {
+	return n >= r.Min && n <= r.Max
+}
+
+type comparison int
+
+const (
+	_ comparison = iota
+	comparisonEqual
+	comparisonGreater
+	comparisonGreaterOrEqual
+	comparisonLess
+	comparisonLessOrEqual
+	comparisonRange
+)
+
+// splitComparison break a value like ">=x", "x..y" or "x" into the requested
+// comparison and its operands.
+func splitComparison(value string) (comparison, string, string, error) {
+	var cmp comparison
+	var first, second string
+
+	switch {
+	case strings.HasPrefix(value, ">="):
+		cmp, first = comparisonGreaterOrEqual, value[2:]
+	case strings.HasPrefix(value, "<="):
+		cmp, first = comparisonLessOrEqual, value[2:]
+	case strings.HasPrefix(value, ">"):
+		cmp, first = comparisonGreater, value[1:]
+	case strings.HasPrefix(value, "<"):
+		cmp, first = comparisonLess, value[1:]
+	case strings.Contains(value, ".."):
+		split := strings.Split(value, "..")
+		if len(split) != 2 || len(split[0]) == 0 || len(split[1]) == 0 {
+			return 0, "", "", fmt.Errorf("invalid range \"%s\"", value)
+		}
+		cmp, first, second = comparisonRange, split[0], split[1]
+	default:
+		cmp, first = comparisonEqual, value
+	}
+
+	if len(first) == 0 {
+		return 0, "", "", fmt.Errorf("missing value in \"%s\"", value)
+	}
+
+	return cmp, first, second, nil
+}
+
+// parseTimeRange parse a date comparison or range. Dates are either absolute
+// (2006, 2006-01 or 2006-01-02, in local time) and cover the whole year,
+// month or day, or relative to now (12h, 7d, 2w, 3m or 1y ago).
+func parseTimeRange(value string) (TimeRange, error) {
+	cmp, first, second, err := splitComparison(value)
+	if err != nil {
+		return TimeRange{}, err
+	}
+
+	if cmp == comparisonRange {
+		var r TimeRange
+		if first != "*" {
+			r.Start, _, err = parseTimeSpan(first)
+			if err != nil {
+				return TimeRange{}, err
+			}
+		}
+		if second != "*" {
+			_, r.End, err = parseTimeSpan(second)
+			if err != nil {
+				return TimeRange{}, err
+			}
+		}
+		if !r.Start.IsZero() && !r.End.IsZero() && !r.Start.Before(r.End) {
+			return TimeRange{}, fmt.Errorf("inverted range \"%s\", the start need to be before the end", value)
+		}
+		return r, nil
+	}
+
+	start, end, err := parseTimeSpan(first)
+	if err != nil {
+		return TimeRange{}, err
+	}
+
+	switch cmp {
+	case comparisonGreater:
+		return TimeRange{Start: end}, nil
+	case comparisonGreaterOrEqual:
+		return TimeRange{Start: start}, nil
+	case comparisonLess:
+		return TimeRange{End: start}, nil
+	case comparisonLessOrEqual:
+		return TimeRange{End: end}, nil
+	default:
+		if start.Equal(end) {
+			return TimeRange{}, fmt.Errorf("relative date \"%s\" need a comparison or a range", first)
+		}
+		return TimeRange{Start: start, End: end}, nil
+	}
+}
+
+var relativeDateRegex = regexp.MustCompile(`^(\d+)([hdwmy])$`)
+
+// parseTimeSpan return the span of time covered by a date. A relative date
+// is a single instant, so start and end are the same.
+func parseTimeSpan(value string) (start time.Time, end time.Time, err error) {
+	if m := relativeDateRegex.FindStringSubmatch(value); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+
+		t := now()
+		switch m[2] {
+		case "h":
+			t = t.Add(-time.Duration(n) * time.Hour)
+		case "d":
+			t = t.AddDate(0, 0, -n)
+		case "w":
+			t = t.AddDate(0, 0, -7*n)
+		case "m":
+			t = t.AddDate(0, -n, 0)
+		case "y":
+			t = t.AddDate(-n, 0, 0)
+		}
+		return t, t, nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, t.AddDate(0, 0, 1), nil
+	}
+	if t, err := time.ParseInLocation("2006-01", value, time.Local); err == nil {
+		return t, t.AddDate(0, 1, 0), nil
+	}
+	if t, err := time.ParseInLocation("2006", value, time.Local); err == nil {
+		return t, t.AddDate(1, 0, 0), nil
+	}
+
+	return time.Time{}, time.Time{}, fmt.Errorf("invalid date \"%s\"", value)
+}
+
+// parseIntRange parse a numeric comparison or range of positive integers
+func parseIntRange(value string) (IntRange, error) {
+	cmp, first, second, err := splitComparison(value)
+	if err != nil {
+		return IntRange{}, err
+	}
+
+	if cmp == comparisonRange {
+		r := IntRange{Min: 0, Max: math.MaxInt32}
+		if first != "*" {
+			r.Min, err = parsePositiveInt(first)
+			if err != nil {
+				return IntRange{}, err
+			}
+		}
+		if second != "*" {
+			r.Max, err = parsePositiveInt(second)
+			if err != nil {
+				return IntRange{}, err
+			}
+		}
+		if r.Min > r.Max {
+			return IntRange{}, fmt.Errorf("inverted range \"%s\", the minimum need to be lower than the maximum", value)
+		}
+		return r, nil
+	}
+
+	n, err := parsePositiveInt(first)
+	if err != nil {
+		return IntRange{}, err
+	}
+
+	switch cmp {
+	case comparisonGreater:
+		return IntRange{Min: n + 1, Max: math.MaxInt32}, nil
+	case comparisonGreaterOrEqual:
+		return IntRange{Min: n, Max: math.MaxInt32}, nil
+	case comparisonLess:
+		return IntRange{Min: 0, Max: n - 1}, nil
+	case comparisonLessOrEqual:
+		return IntRange{Min: 0, Max: n}, nil
+	default:
+		return IntRange{Min: n, Max: n}, nil
+	}
+}
+
+func parsePositiveInt(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid number \"%s\"", value)
+	}
+	return n, nil
+}
@@ -0,0 +1,265 @@
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const savedQueryConfigKeyPrefix = "git-bug.query"
+
+// a saved query name need to be a valid git config key
+var savedQueryNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*$`)
+
+// SavedQuery is a query stored under a name in the repository config, to be
+// referenced later in other queries with "@name".
+type SavedQuery struct {
+	Name  string
+	Query string
+}
+
+// SavedQueries return the saved queries of the repository, sorted by name.
+// Queries from the global config are included, but are overridden by the
+// local ones with the same name.
+func SavedQueries(repo repository.RepoConfig) ([]SavedQuery, error) {
+	configs, err := repo.AnyConfig().ReadAll(savedQueryConfigKeyPrefix + ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "can't read saved queries")
+	}
+
+	result := make([]SavedQuery, 0, len(configs))
+	for key, value := range configs {
+		name := strings.TrimPrefix(key, savedQueryConfigKeyPrefix+".")
+		if name == key || !savedQueryNameRegex.MatchString(name) {
+			continue
+		}
+		result = append(result, SavedQuery{Name: strings.ToLower(name), Query: value})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// LoadSavedQuery return the query saved under the given name
+func LoadSavedQuery(repo repository.RepoConfig, name string) (string, error) {
+	queries, err := SavedQueries(repo)
+	if err != nil {
+		return "", err
+	}
+
+	name = strings.ToLower(name)
+	for _, saved := range queries {
+		if saved.Name == name {
+			return saved.Query, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown saved query \"%s\"", name)
+}
+
+// SaveQuery store a query under the given name in the local config of the
+// repository, replacing any existing query with the same name.
+func SaveQuery(repo repository.RepoConfig, name string, query string) error {
+	return saveQuery(repo, repo.LocalConfig(), name, query)
+}
+
+// SaveGlobalQuery store a query under the given name in the global config,
+// making it available in every repository unless shadowed by a local query.
+func SaveGlobalQuery(repo repository.RepoConfig, name string, query string) error {
+	return saveQuery(repo, repo.GlobalConfig(), name, query)
+}
+
+func saveQuery(repo repository.RepoConfig, config repository.Config, name string, query string) error {
+	if !savedQueryNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid query name \"%s\": only letters, digits and dashes are allowed, starting with a letter", name)
+	}
+
+	// make sure that the query is valid, including its references
+	expanded, err := expandQuery(repo, query, map[string]bool{strings.ToLower(name): true})
+	if err != nil {
+		return err
+	}
+	q, err := Parse(expanded)
+	if err != nil {
+		return errors.Wrap(err, "invalid query")
+	}
+	err = CheckWorkflowStatuses(repo, q)
+	if err != nil {
+		return errors.Wrap(err, "invalid query")
+	}
+
+	return config.StoreString(savedQueryKey(name), query)
+}
+
+// RemoveSavedQuery delete the query saved in the local config of the
+// repository under the given name
+func RemoveSavedQuery(repo repository.RepoConfig, name string) error {
+	return removeSavedQuery(repo.LocalConfig(), "local", name)
+}
+
+// RemoveGlobalSavedQuery delete the query saved in the global config under
+// the given name
+func RemoveGlobalSavedQuery(repo repository.RepoConfig, name string) error {
+	return removeSavedQuery(repo.GlobalConfig(), "global", name)
+}
+
+func removeSavedQuery(config repository.Config, scope string, name string) error {
+	if !savedQueryNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid query name \"%s\"", name)
+	}
+
+	_, err := config.ReadString(savedQueryKey(name))
+	if err == repository.ErrNoConfigEntry {
+		return fmt.Errorf("unknown saved query \"%s\" in the %s config", name, scope)
+	}
+	if err != nil {
+		return err
+	}
+
+	return config.RemoveAll(savedQueryKey(name))
+}
+
+func savedQueryKey(name string) string {
+	return fmt.Sprintf("%s.%s", savedQueryConfigKeyPrefix, strings.ToLower(name))
+}
+
+// ParseWithSaved parse a query DSL like Parse does, after replacing the
+// references to saved queries ("@name") by their content. The workflow
+// statuses used in the query are also checked against the repository config.
+//
+// Ex: "@triage label:crash" with triage saved as "status:open no:label"
+func ParseWithSaved(repo repository.RepoConfig, query string) (*Query, error) {
+	expanded, err := expandQuery(repo, query, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	q, err := Parse(expanded)
+	if err != nil {
+		return nil, err
+	}
+	err = CheckWorkflowStatuses(repo, q)
+	if err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// CheckWorkflowStatuses return an error if the query reference a workflow
+// status that is not defined in the repository config
+func CheckWorkflowStatuses(repo repository.RepoConfig, q *Query) error {
+	names := WorkflowStatuses(q.Filter)
+	if len(names) == 0 {
+		return nil
+	}
+
+	workflow, err := bug.LoadWorkflow(repo)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if _, ok := workflow.Get(name); !ok {
+			return fmt.Errorf("unknown status \"%s\", valid statuses are: %s", name, strings.Join(workflow.Names(), ", "))
+		}
+	}
+
+	return nil
+}
+
+// expandQuery replace the references to saved queries by their content. As
+// the sorting apply to the whole query, the one of the saved queries is moved
+// to the top level, unless the query has its own. The first one applies when
+// several saved queries are sorted.
+func expandQuery(repo repository.RepoConfig, query string, visiting map[string]bool) (string, error) {
+	expanded, sorting, err := expandSaved(repo, query, visiting)
+	if err != nil {
+		return "", err
+	}
+	if len(sorting) == 0 {
+		return expanded, nil
+	}
+
+	fields, err := splitQuery(expanded)
+	if err != nil {
+		return "", err
+	}
+	for _, field := range fields {
+		if strings.HasPrefix(field, "sort:") {
+			return expanded, nil
+		}
+	}
+
+	return expanded + " " + sorting[0], nil
+}
+
+// expandSaved replace the references to saved queries by their content
+// within parenthesis, so they combine with the rest of the query as a
+// single operand. The sorting of the saved queries is returned separately,
+// as it can't be grouped.
+func expandSaved(repo repository.RepoConfig, query string, visiting map[string]bool) (string, []string, error) {
+	fields, err := splitQuery(query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var sorting []string
+
+	for i, field := range fields {
+		negation := ""
+		if strings.HasPrefix(field, "-@") {
+			negation = "-"
+			field = field[1:]
+		}
+		if !strings.HasPrefix(field, "@") {
+			continue
+		}
+
+		name := strings.ToLower(field[1:])
+		if visiting[name] {
+			return "", nil, fmt.Errorf("saved query \"%s\" reference itself", name)
+		}
+
+		saved, err := LoadSavedQuery(repo, name)
+		if err != nil {
+			return "", nil, err
+		}
+
+		visiting[name] = true
+		saved, savedSorting, err := expandSaved(repo, saved, visiting)
+		delete(visiting, name)
+		if err != nil {
+			return "", nil, err
+		}
+
+		savedFields, err := splitQuery(saved)
+		if err != nil {
+			return "", nil, err
+		}
+
+		var filters []string
+		for _, f := range savedFields {
+			if strings.HasPrefix(f, "sort:") {
+				sorting = append(sorting, f)
+			} else {
+				filters = append(filters, f)
+			}
+		}
+		sorting = append(sorting, savedSorting...)
+
+		fields[i] = ""
+		if len(filters) > 0 {
+			fields[i] = strings.Join([]string{negation + "(", strings.Join(filters, " "), ")"}, " ")
+		}
+	}
+
+	return strings.Join(fields, " "), sorting, nil
+}
@@ -0,0 +1,111 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSavedQueries(t *testing.T) {
+	repo := repository.NewMockRepoConfig()
+
+	require.NoError(t, repo.GlobalConfig().StoreString("git-bug.query.mine", "author:rene"))
+	require.NoError(t, repo.GlobalConfig().StoreString("git-bug.query.triage", "status:closed"))
+
+	require.NoError(t, SaveQuery(repo, "triage", "status:open no:label sort:edit"))
+	require.NoError(t, SaveQuery(repo, "mine-triage", "@mine @triage"))
+
+	require.Error(t, SaveQuery(repo, "bad name", "status:open"))
+	require.Error(t, SaveQuery(repo, "invalid", "status:unknown"))
+	require.Error(t, SaveQuery(repo, "unknown", "@nope"))
+	require.Error(t, SaveQuery(repo, "loop", "@loop"))
+
+	queries, err := SavedQueries(repo)
+	require.NoError(t, err)
+	require.Equal(t, []SavedQuery{
+		{Name: "mine", Query: "author:rene"},
+		{Name: "mine-triage", Query: "@mine @triage"},
+		{Name: "triage", Query: "status:open no:label sort:edit"},
+	}, queries)
+
+	q, err := ParseWithSaved(repo, "@triage label:crash")
+	require.NoError(t, err)
+	require.Equal(t, &Query{
+		Filter: &And{Operands: []Node{
+			&And{Operands: []Node{
+				&StatusFilter{Status: bug.OpenStatus},
+				&NoLabelFilter{},
+			}},
+			&LabelFilter{Label: "crash"},
+		}},
+		OrderBy:        OrderByEdit,
+		OrderDirection: OrderDescending,
+	}, q)
+
+	q, err = ParseWithSaved(repo, "-@MINE")
+	require.NoError(t, err)
+	require.Equal(t, &Not{Operand: &AuthorFilter{Author: "rene"}}, q.Filter)
+
+	q, err = ParseWithSaved(repo, "@mine-triage")
+	require.NoError(t, err)
+	require.Equal(t, OrderByEdit, q.OrderBy)
+
+	// the sorting of a grouped or negated saved query apply to the whole query
+	q, err = ParseWithSaved(repo, "(@triage)")
+	require.NoError(t, err)
+	require.Equal(t, OrderByEdit, q.OrderBy)
+
+	q, err = ParseWithSaved(repo, "-@triage")
+	require.NoError(t, err)
+	require.Equal(t, &Not{Operand: &And{Operands: []Node{
+		&StatusFilter{Status: bug.OpenStatus},
+		&NoLabelFilter{},
+	}}}, q.Filter)
+	require.Equal(t, OrderByEdit, q.OrderBy)
+
+	q, err = ParseWithSaved(repo, "label:crash (@mine OR @mine-triage)")
+	require.NoError(t, err)
+	require.Equal(t, OrderByEdit, q.OrderBy)
+
+	// the sorting of the query itself takes precedence
+	q, err = ParseWithSaved(repo, "@triage sort:id")
+	require.NoError(t, err)
+	require.Equal(t, OrderById, q.OrderBy)
+
+	_, err = ParseWithSaved(repo, "@nope")
+	require.Error(t, err)
+
+	// the local query shadow the global one, only the local can be removed
+	require.NoError(t, RemoveSavedQuery(repo, "triage"))
+	require.Error(t, RemoveSavedQuery(repo, "triage"))
+	saved, err := LoadSavedQuery(repo, "triage")
+	require.NoError(t, err)
+	require.Equal(t, "status:closed", saved)
+
+	// saved in the global config, shadowed by a local query
+	require.NoError(t, SaveGlobalQuery(repo, "crash", "label:crash"))
+	saved, err = repo.GlobalConfig().ReadString("git-bug.query.crash")
+	require.NoError(t, err)
+	require.Equal(t, "label:crash", saved)
+
+	require.NoError(t, SaveQuery(repo, "crash", "label:crash status:open"))
+	q, err = ParseWithSaved(repo, "@crash")
+	require.NoError(t, err)
+	require.Equal(t, &And{Operands: []Node{
+		&LabelFilter{Label: "crash"},
+		&StatusFilter{Status: bug.OpenStatus},
+	}}, q.Filter)
+
+	require.NoError(t, RemoveSavedQuery(repo, "crash"))
+	q, err = ParseWithSaved(repo, "@crash")
+	require.NoError(t, err)
+	require.Equal(t, &LabelFilter{Label: "crash"}, q.Filter)
+
+	require.NoError(t, RemoveGlobalSavedQuery(repo, "crash"))
+	require.Error(t, RemoveGlobalSavedQuery(repo, "crash"))
+	_, err = ParseWithSaved(repo, "@crash")
+	require.Error(t, err)
+}
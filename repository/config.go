@@ -77,10 +77,14 @@ type mergedConfig struct {
 }
 
 func (m *mergedConfig) ReadAll(keyPrefix string) (map[string]string, error) {
-	values, err := m.global.ReadAll(keyPrefix)
+	values := make(map[string]string)
+	globals, err := m.global.ReadAll(keyPrefix)
 	if err != nil {
 		return nil, err
 	}
+	for k, val := range globals {
+		values[k] = val
+	}
 	locals, err := m.local.ReadAll(keyPrefix)
 	if err != nil {
 		return nil, err
@@ -113,4 +113,16 @@ func testConfig(t *testing.T, config Config) {
 		"section.subsection.subsection.opt1": "foo5",
 		"section.subsection.subsection.opt2": "foo6",
 	}, all)
+
+	val, err = config.ReadString("section.subsection.opt1")
+	require.NoError(t, err)
+	require.Equal(t, "foo3", val)
+
+	// remove a single option in a subsection
+	require.NoError(t, config.RemoveAll("section.subsection.opt1"))
+	_, err = config.ReadString("section.subsection.opt1")
+	require.Equal(t, ErrNoConfigEntry, err)
+	val, err = config.ReadString("section.subsection.opt2")
+	require.NoError(t, err)
+	require.Equal(t, "foo4", val)
 }
@@ -130,7 +130,7 @@ func (cr *goGitConfigReader) ReadString(key string) (string, error) {
 		}
 		return section.Option(optionName), nil
 	default:
-		subsectionName := strings.Join(split[1:len(split)-2], ".")
+		subsectionName := strings.Join(split[1:len(split)-1], ".")
 		optionName := split[len(split)-1]
 		if !section.HasSubsection(subsectionName) {
 			return "", ErrNoConfigEntry
@@ -227,6 +227,16 @@ func (cw *goGitConfigWriter) RemoveAll(keyPrefix string) error {
 			section.RemoveOption(rest)
 			ok = true
 		}
+		// a single option in a subsection
+		subsectionName := strings.Join(split[1:len(split)-1], ".")
+		optionName := split[len(split)-1]
+		if len(split) > 2 && section.HasSubsection(subsectionName) {
+			subsection := section.Subsection(subsectionName)
+			if subsection.HasOption(optionName) {
+				subsection.RemoveOption(optionName)
+				ok = true
+			}
+		}
 		if !ok {
 			return fmt.Errorf("invalid key prefix")
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/awesome-gocui/gocui"
 	"github.com/dustin/go-humanize"
 
+	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/query"
@@ -26,9 +27,11 @@ const defaultQuery = "status:open"
 var bugTableHelp = helpBar{
 	{"q", "Quit"},
 	{"s", "Search"},
+	{"v", "Saved queries"},
 	{"←↓↑→,hjkl", "Navigation"},
 	{"↵", "Open bug"},
 	{"n", "New bug"},
+	{"N", "New bug from template"},
 	{"i", "Pull"},
 	{"o", "Push"},
 }
@@ -192,6 +195,10 @@ func (bt *bugTable) keybindings(g *gocui.Gui) error {
 		bt.newBug); err != nil {
 		return err
 	}
+	if err := g.SetKeybinding(bugTableView, 'N', gocui.ModNone,
+		bt.newBugFromTemplate); err != nil {
+		return err
+	}
 
 	// Open bug
 	if err := g.SetKeybinding(bugTableView, gocui.KeyEnter, gocui.ModNone,
@@ -217,6 +224,12 @@ func (bt *bugTable) keybindings(g *gocui.Gui) error {
 		return err
 	}
 
+	// Saved queries
+	if err := g.SetKeybinding(bugTableView, 'v', gocui.ModNone,
+		bt.selectQuery); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -279,6 +292,9 @@ func (bt *bugTable) getColumnWidths(maxX int) map[string]int {
 	m := make(map[string]int)
 	m["id"] = 7
 	m["status"] = 6
+	for _, excerpt := range bt.excerpts {
+		m["status"] = maxInt(m["status"], len(excerpt.StatusName()))
+	}
 
 	left := maxX - 5 - m["id"] - m["status"]
 
@@ -320,7 +336,7 @@ func (bt *bugTable) render(v *gocui.View, maxX int) {
 		}
 
 		id := text.LeftPadMaxLine(excerpt.Id.Human(), columnWidths["id"], 0)
-		status := text.LeftPadMaxLine(excerpt.Status.String(), columnWidths["status"], 0)
+		status := text.LeftPadMaxLine(excerpt.StatusName(), columnWidths["status"], 0)
 		labels := text.TruncateMax(labelsTxt.String(), minInt(columnWidths["title"]-2, 10))
 		title := text.LeftPadMaxLine(strings.TrimSpace(excerpt.Title), columnWidths["title"]-text.Len(labels), 0)
 		authorTxt := text.LeftPadMaxLine(author.DisplayName(), columnWidths["author"], 0)
@@ -438,7 +454,44 @@ func (bt *bugTable) previousPage(g *gocui.Gui, v *gocui.View) error {
 }
 
 func (bt *bugTable) newBug(g *gocui.Gui, v *gocui.View) error {
-	return newBugWithEditor(bt.repo)
+	return newBugWithEditor(bt.repo, nil)
+}
+
+func (bt *bugTable) newBugFromTemplate(g *gocui.Gui, v *gocui.View) error {
+	templates, err := bug.Templates(bt.repo)
+	if err != nil {
+		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+		return nil
+	}
+	if len(templates) == 0 {
+		ui.msgPopup.Activate(msgPopupErrorTitle, "No bug template is defined in this repository.")
+		return nil
+	}
+
+	names := make([]string, len(templates))
+	for i, template := range templates {
+		names[i] = template.Name
+	}
+
+	c := ui.inputPopup.Activate(fmt.Sprintf("Template (%s)", strings.Join(names, ", ")))
+
+	go func() {
+		input := strings.TrimSpace(<-c)
+		if input == "" {
+			return
+		}
+
+		g.Update(func(g *gocui.Gui) error {
+			template, err := bug.LoadTemplate(bt.repo, input)
+			if err != nil {
+				ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+				return nil
+			}
+			return newBugWithEditor(bt.repo, &template)
+		})
+	}()
+
+	return nil
 }
 
 func (bt *bugTable) openBug(g *gocui.Gui, v *gocui.View) error {
@@ -538,3 +591,19 @@ func (bt *bugTable) push(g *gocui.Gui, v *gocui.View) error {
 func (bt *bugTable) changeQuery(g *gocui.Gui, v *gocui.View) error {
 	return editQueryWithEditor(bt)
 }
+
+func (bt *bugTable) selectQuery(g *gocui.Gui, v *gocui.View) error {
+	if err := ui.querySelect.refresh(); err != nil {
+		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+		return nil
+	}
+	return ui.activateWindow(ui.querySelect)
+}
+
+// setQuery change the query of the table and go back to the first page
+func (bt *bugTable) setQuery(queryStr string, q *query.Query) {
+	bt.queryStr = queryStr
+	bt.query = q
+	bt.pageCursor = 0
+	bt.selectCursor = 0
+}
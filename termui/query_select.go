@@ -0,0 +1,168 @@
+package termui
+
+import (
+	"fmt"
+
+	text "github.com/MichaelMure/go-term-text"
+	"github.com/awesome-gocui/gocui"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/query"
+	"github.com/MichaelMure/git-bug/util/colors"
+)
+
+const querySelectView = "querySelectView"
+const querySelectInstructionsView = "querySelectInstructionsView"
+
+var querySelectHelp = helpBar{
+	{"q", "Back"},
+	{"↓↑,jk", "Nav"},
+	{"↵", "Apply query"},
+}
+
+// querySelect is a picker for the queries saved in the repository config
+type querySelect struct {
+	cache    *cache.RepoCache
+	queries  []query.SavedQuery
+	selected int
+}
+
+func newQuerySelect(cache *cache.RepoCache) *querySelect {
+	return &querySelect{
+		cache: cache,
+	}
+}
+
+// refresh reload the saved queries from the config
+func (qs *querySelect) refresh() error {
+	queries, err := query.SavedQueries(qs.cache)
+	if err != nil {
+		return err
+	}
+
+	qs.queries = queries
+	qs.selected = 0
+
+	return nil
+}
+
+func (qs *querySelect) keybindings(g *gocui.Gui) error {
+	// Abort
+	if err := g.SetKeybinding(querySelectView, gocui.KeyEsc, gocui.ModNone, qs.abort); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(querySelectView, 'q', gocui.ModNone, qs.abort); err != nil {
+		return err
+	}
+	// Up
+	if err := g.SetKeybinding(querySelectView, gocui.KeyArrowUp, gocui.ModNone, qs.selectPrevious); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(querySelectView, 'k', gocui.ModNone, qs.selectPrevious); err != nil {
+		return err
+	}
+	// Down
+	if err := g.SetKeybinding(querySelectView, gocui.KeyArrowDown, gocui.ModNone, qs.selectNext); err != nil {
+		return err
+	}
+	if err := g.SetKeybinding(querySelectView, 'j', gocui.ModNone, qs.selectNext); err != nil {
+		return err
+	}
+	// Apply
+	if err := g.SetKeybinding(querySelectView, gocui.KeyEnter, gocui.ModNone, qs.apply); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (qs *querySelect) layout(g *gocui.Gui) error {
+	maxX, maxY := g.Size()
+
+	v, err := g.SetView(querySelectView, -1, -1, maxX, maxY-2, 0)
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+
+		v.Frame = false
+		v.SelBgColor = gocui.ColorWhite
+		v.SelFgColor = gocui.ColorBlack
+	}
+
+	v.Clear()
+
+	if len(qs.queries) == 0 {
+		_, _ = fmt.Fprint(v, "No saved query. Use \"git bug query save NAME QUERY\" to create one.")
+	}
+
+	nameWidth := 0
+	for _, saved := range qs.queries {
+		nameWidth = maxInt(nameWidth, text.Len(saved.Name)+1)
+	}
+
+	for _, saved := range qs.queries {
+		name := text.LeftPadMaxLine("@"+saved.Name, nameWidth, 0)
+		queryStr := text.LeftPadMaxLine(saved.Query, maxX-nameWidth-2, 1)
+		_, _ = fmt.Fprintf(v, "%s %s\n", colors.Cyan(name), queryStr)
+	}
+
+	_ = v.SetHighlight(qs.selected, len(qs.queries) > 0)
+
+	v, err = g.SetView(querySelectInstructionsView, -1, maxY-2, maxX, maxY, 0)
+	if err != nil {
+		if !gocui.IsUnknownView(err) {
+			return err
+		}
+		v.Frame = false
+		v.FgColor = gocui.ColorWhite
+	}
+	v.Clear()
+	_, _ = fmt.Fprint(v, querySelectHelp.Render(maxX))
+
+	if _, err := g.SetCurrentView(querySelectView); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (qs *querySelect) disable(g *gocui.Gui) error {
+	if err := g.DeleteView(querySelectView); err != nil && !gocui.IsUnknownView(err) {
+		return err
+	}
+	if err := g.DeleteView(querySelectInstructionsView); err != nil && !gocui.IsUnknownView(err) {
+		return err
+	}
+	return nil
+}
+
+func (qs *querySelect) selectPrevious(g *gocui.Gui, v *gocui.View) error {
+	qs.selected = maxInt(0, qs.selected-1)
+	return nil
+}
+
+func (qs *querySelect) selectNext(g *gocui.Gui, v *gocui.View) error {
+	qs.selected = maxInt(0, minInt(len(qs.queries)-1, qs.selected+1))
+	return nil
+}
+
+func (qs *querySelect) abort(g *gocui.Gui, v *gocui.View) error {
+	return ui.activateWindow(ui.bugTable)
+}
+
+func (qs *querySelect) apply(g *gocui.Gui, v *gocui.View) error {
+	if len(qs.queries) == 0 {
+		return ui.activateWindow(ui.bugTable)
+	}
+
+	queryStr := "@" + qs.queries[qs.selected].Name
+
+	q, err := query.ParseWithSaved(qs.cache, queryStr)
+	if err != nil {
+		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+		return nil
+	}
+
+	ui.bugTable.setQuery(queryStr, q)
+
+	return ui.activateWindow(ui.bugTable)
+}
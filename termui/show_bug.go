@@ -25,6 +25,7 @@ var showBugHelp = helpBar{
 	{"q", "Save and return"},
 	{"←↓↑→,hjkl", "Navigation"},
 	{"o", "Toggle open/close"},
+	{"s", "Change status"},
 	{"e", "Edit"},
 	{"c", "Comment"},
 	{"t", "Change title"},
@@ -182,6 +183,12 @@ func (sb *showBug) keybindings(g *gocui.Gui) error {
 		return err
 	}
 
+	// Status
+	if err := g.SetKeybinding(showBugView, 's', gocui.ModNone,
+		sb.setStatus); err != nil {
+		return err
+	}
+
 	// Title
 	if err := g.SetKeybinding(showBugView, 't', gocui.ModNone,
 		sb.setTitle); err != nil {
@@ -226,7 +233,7 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 	bugHeader := fmt.Sprintf("[%s] %s\n\n[%s] %s opened this bug on %s%s",
 		colors.Cyan(snap.Id().Human()),
 		colors.Bold(snap.Title),
-		colors.Yellow(snap.Status),
+		colors.Yellow(snap.StatusName()),
 		colors.Magenta(snap.Author.DisplayName()),
 		snap.CreateTime.Format(timeLayout),
 		edited,
@@ -253,7 +260,9 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			var content string
 			var lines int
 
-			if op.MessageIsEmpty() {
+			if op.Redacted {
+				content, lines = text.WrapLeftPadded(redactedMessagePlaceholder(), maxX-1, 4)
+			} else if op.MessageIsEmpty() {
 				content, lines = text.WrapLeftPadded(emptyMessagePlaceholder(), maxX-1, 4)
 			} else {
 				content, lines = text.WrapLeftPadded(op.Message, maxX-1, 4)
@@ -273,7 +282,9 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			}
 
 			var message string
-			if op.MessageIsEmpty() {
+			if op.Redacted {
+				message, _ = text.WrapLeftPadded(redactedMessagePlaceholder(), maxX-1, 4)
+			} else if op.MessageIsEmpty() {
 				message, _ = text.WrapLeftPadded(emptyMessagePlaceholder(), maxX-1, 4)
 			} else {
 				message, _ = text.WrapLeftPadded(op.Message, maxX-1, 4)
@@ -310,9 +321,129 @@ func (sb *showBug) renderMain(g *gocui.Gui, mainView *gocui.View) error {
 			y0 += lines + 2
 
 		case *bug.SetStatusTimelineItem:
-			content := fmt.Sprintf("%s %s the bug on %s",
+			action := fmt.Sprintf("%s the bug", colors.Bold(op.Status.Action()))
+			if op.WorkflowStatus != "" {
+				action = fmt.Sprintf("moved the bug to %s", colors.Bold(op.WorkflowStatus))
+			}
+			if op.Resolution != "" {
+				action += fmt.Sprintf(" as %s", colors.Bold(op.Resolution.Description()))
+			}
+			content := fmt.Sprintf("%s %s on %s",
+				colors.Magenta(op.Author.DisplayName()),
+				action,
+				op.UnixTime.Time().Format(timeLayout),
+			)
+			content, lines := text.Wrap(content, maxX)
+
+			v, err := sb.createOpView(g, viewName, x0, y0, maxX+1, lines, true)
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprint(v, content)
+			y0 += lines + 2
+
+		case *bug.SetMilestoneTimelineItem:
+			var action string
+			switch {
+			case op.Milestone == "":
+				action = fmt.Sprintf("removed this from the %s milestone",
+					colors.Bold(sb.milestoneTitle(op.Was)))
+			case op.Was == "":
+				action = fmt.Sprintf("added this to the %s milestone",
+					colors.Bold(sb.milestoneTitle(op.Milestone)))
+			default:
+				action = fmt.Sprintf("moved this from the %s milestone to %s",
+					colors.Bold(sb.milestoneTitle(op.Was)),
+					colors.Bold(sb.milestoneTitle(op.Milestone)))
+			}
+
+			content := fmt.Sprintf("%s %s on %s",
+				colors.Magenta(op.Author.DisplayName()),
+				action,
+				op.UnixTime.Time().Format(timeLayout),
+			)
+			content, lines := text.Wrap(content, maxX)
+
+			v, err := sb.createOpView(g, viewName, x0, y0, maxX+1, lines, true)
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprint(v, content)
+			y0 += lines + 2
+
+		case *bug.SetLinksTimelineItem:
+			var added []string
+			for _, link := range op.Added {
+				added = append(added, fmt.Sprintf("%s %s",
+					link.Type.Description(), colors.Bold(sb.bugTitle(link.Target))))
+			}
+
+			var removed []string
+			for _, link := range op.Removed {
+				removed = append(removed, fmt.Sprintf("%s %s",
+					link.Type.Description(), colors.Bold(sb.bugTitle(link.Target))))
+			}
+
+			var action bytes.Buffer
+
+			if len(added) > 0 {
+				action.WriteString("marked this as ")
+				action.WriteString(strings.Join(added, ", "))
+
+				if len(removed) > 0 {
+					action.WriteString(" and ")
+				}
+			}
+
+			if len(removed) > 0 {
+				action.WriteString("removed the links ")
+				action.WriteString(strings.Join(removed, ", "))
+			}
+
+			content := fmt.Sprintf("%s %s on %s",
+				colors.Magenta(op.Author.DisplayName()),
+				action.String(),
+				op.UnixTime.Time().Format(timeLayout),
+			)
+			content, lines := text.Wrap(content, maxX)
+
+			v, err := sb.createOpView(g, viewName, x0, y0, maxX+1, lines, true)
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprint(v, content)
+			y0 += lines + 2
+
+		case *bug.SetAssigneesTimelineItem:
+			var added []string
+			for _, assignee := range op.Added {
+				added = append(added, colors.Bold(assignee.DisplayName()))
+			}
+
+			var removed []string
+			for _, assignee := range op.Removed {
+				removed = append(removed, colors.Bold(assignee.DisplayName()))
+			}
+
+			var action bytes.Buffer
+
+			if len(added) > 0 {
+				action.WriteString("assigned ")
+				action.WriteString(strings.Join(added, ", "))
+
+				if len(removed) > 0 {
+					action.WriteString(" and ")
+				}
+			}
+
+			if len(removed) > 0 {
+				action.WriteString("unassigned ")
+				action.WriteString(strings.Join(removed, ", "))
+			}
+
+			content := fmt.Sprintf("%s %s on %s",
 				colors.Magenta(op.Author.DisplayName()),
-				colors.Bold(op.Status.Action()),
+				action.String(),
 				op.UnixTime.Time().Format(timeLayout),
 			)
 			content, lines := text.Wrap(content, maxX)
@@ -381,6 +512,11 @@ func emptyMessagePlaceholder() string {
 	return colors.BlackBold(colors.WhiteBg("No description provided."))
 }
 
+// redactedMessagePlaceholder return a formatted placeholder for a redacted message
+func redactedMessagePlaceholder() string {
+	return colors.BlackBold(colors.WhiteBg("This comment has been redacted."))
+}
+
 func (sb *showBug) createOpView(g *gocui.Gui, name string, x0 int, y0 int, maxX int, height int, selectable bool) (*gocui.View, error) {
 	v, err := g.SetView(name, x0, y0, maxX, y0+height+1, 0)
 
@@ -444,11 +580,90 @@ func (sb *showBug) renderSidebar(g *gocui.Gui, sideView *gocui.View) error {
 		return err
 	}
 
+	_, _ = fmt.Fprint(v, content)
+	y0 += lines + 4
+
+	assigneeStr := make([]string, len(snap.Assignees))
+	for i, a := range snap.Assignees {
+		assigneeStr[i] = a.DisplayName()
+	}
+
+	assignees := strings.Join(assigneeStr, "\n")
+	if len(assignees) == 0 {
+		assignees = "No one"
+	}
+	assignees, lines = text.WrapLeftPadded(assignees, maxX, 2)
+
+	content = fmt.Sprintf("%s\n\n%s", colors.Bold("  Assignees"), assignees)
+
+	v, err = sb.createSideView(g, "sideAssignees", x0, y0, maxX, lines+2)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprint(v, content)
+	y0 += lines + 4
+
+	milestone := "None"
+	if snap.Milestone != "" {
+		milestone = sb.milestoneTitle(snap.Milestone)
+	}
+	milestone, lines = text.WrapLeftPadded(milestone, maxX, 2)
+
+	content = fmt.Sprintf("%s\n\n%s", colors.Bold("  Milestone"), milestone)
+
+	v, err = sb.createSideView(g, "sideMilestone", x0, y0, maxX, lines+2)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprint(v, content)
+	y0 += lines + 4
+
+	allLinks := sb.bug.AllLinks()
+	linkStr := make([]string, len(allLinks))
+	for i, link := range allLinks {
+		linkStr[i] = fmt.Sprintf("%s %s", link.Type.Description(), sb.bugTitle(link.Target))
+	}
+
+	links := strings.Join(linkStr, "\n")
+	if len(links) == 0 {
+		links = "None"
+	}
+	links, lines = text.WrapLeftPadded(links, maxX, 2)
+
+	content = fmt.Sprintf("%s\n\n%s", colors.Bold("  Links"), links)
+
+	v, err = sb.createSideView(g, "sideLinks", x0, y0, maxX, lines+2)
+	if err != nil {
+		return err
+	}
+
 	_, _ = fmt.Fprint(v, content)
 
 	return nil
 }
 
+// bugTitle return the human id and title of a bug, or only its id if the bug
+// is not available locally
+func (sb *showBug) bugTitle(id entity.Id) string {
+	excerpt, err := sb.cache.ResolveBugExcerpt(id)
+	if err != nil {
+		return id.Human()
+	}
+	return fmt.Sprintf("%s %s", id.Human(), excerpt.Title)
+}
+
+// milestoneTitle return the title of a milestone, or its id if the milestone
+// is not available locally
+func (sb *showBug) milestoneTitle(id entity.Id) string {
+	excerpt, err := sb.cache.ResolveMilestoneExcerpt(id)
+	if err != nil {
+		return id.Human()
+	}
+	return excerpt.Title
+}
+
 func (sb *showBug) saveAndBack(g *gocui.Gui, v *gocui.View) error {
 	err := sb.bug.CommitAsNeeded()
 	if err != nil {
@@ -622,22 +837,66 @@ func (sb *showBug) setTitle(g *gocui.Gui, v *gocui.View) error {
 }
 
 func (sb *showBug) toggleOpenClose(g *gocui.Gui, v *gocui.View) error {
+	var target bug.Status
 	switch sb.bug.Snapshot().Status {
 	case bug.OpenStatus:
-		_, err := sb.bug.Close()
-		return err
+		target = bug.ClosedStatus
 	case bug.ClosedStatus:
-		_, err := sb.bug.Open()
-		return err
+		target = bug.OpenStatus
 	default:
 		return nil
 	}
+
+	_, err := sb.bug.SetStatus(target.String(), "")
+	if err != nil {
+		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+	}
+	return nil
+}
+
+func (sb *showBug) setStatus(g *gocui.Gui, v *gocui.View) error {
+	workflow, err := bug.LoadWorkflow(sb.cache)
+	if err != nil {
+		return err
+	}
+
+	title := fmt.Sprintf("New status (%s)", strings.Join(workflow.Names(), ", "))
+	c := ui.inputPopup.Activate(title)
+
+	go func() {
+		input := strings.TrimSpace(<-c)
+		if input == "" {
+			return
+		}
+
+		g.Update(func(g *gocui.Gui) error {
+			_, err := sb.bug.SetStatus(input, "")
+			if err != nil {
+				ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
+			}
+			return nil
+		})
+	}()
+
+	return nil
 }
 
 func (sb *showBug) edit(g *gocui.Gui, v *gocui.View) error {
 	snap := sb.bug.Snapshot()
 
 	if sb.isOnSide {
+		if sb.selected == "sideAssignees" {
+			ui.msgPopup.Activate(msgPopupErrorTitle, "Assignees can be changed with \"git bug assign\".")
+			return nil
+		}
+		if sb.selected == "sideMilestone" {
+			ui.msgPopup.Activate(msgPopupErrorTitle, "The milestone can be changed with \"git bug milestone set\".")
+			return nil
+		}
+		if sb.selected == "sideLinks" {
+			ui.msgPopup.Activate(msgPopupErrorTitle, "Links can be changed with \"git bug link\".")
+			return nil
+		}
 		return sb.editLabels(g, snap)
 	}
 
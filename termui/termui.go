@@ -7,6 +7,7 @@ import (
 	"github.com/awesome-gocui/gocui"
 	"github.com/pkg/errors"
 
+	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/input"
@@ -25,6 +26,7 @@ type termUI struct {
 	bugTable    *bugTable
 	showBug     *showBug
 	labelSelect *labelSelect
+	querySelect *querySelect
 	msgPopup    *msgPopup
 	inputPopup  *inputPopup
 }
@@ -55,6 +57,7 @@ func Run(cache *cache.RepoCache) error {
 		bugTable:    newBugTable(cache),
 		showBug:     newShowBug(cache),
 		labelSelect: newLabelSelect(),
+		querySelect: newQuerySelect(cache),
 		msgPopup:    newMsgPopup(),
 		inputPopup:  newInputPopup(),
 	}
@@ -158,6 +161,10 @@ func keybindings(g *gocui.Gui) error {
 		return err
 	}
 
+	if err := ui.querySelect.keybindings(g); err != nil {
+		return err
+	}
+
 	if err := ui.msgPopup.keybindings(g); err != nil {
 		return err
 	}
@@ -173,7 +180,9 @@ func quit(g *gocui.Gui, v *gocui.View) error {
 	return gocui.ErrQuit
 }
 
-func newBugWithEditor(repo *cache.RepoCache) error {
+// newBugWithEditor create a new bug from the editor input, pre-filled with the
+// given template if any
+func newBugWithEditor(repo *cache.RepoCache, template *bug.Template) error {
 	// This is somewhat hacky.
 	// As there is no way to pause gocui, run the editor and restart gocui,
 	// we have to stop it entirely and start a new one later.
@@ -186,7 +195,12 @@ func newBugWithEditor(repo *cache.RepoCache) error {
 	ui.g.Close()
 	ui.g = nil
 
-	title, message, err := input.BugCreateEditorInput(ui.cache, "", "")
+	var preTitle, preMessage string
+	if template != nil {
+		preTitle, preMessage = template.Title, template.Message
+	}
+
+	title, message, err := input.BugCreateEditorInput(ui.cache, preTitle, preMessage)
 
 	if err != nil && err != input.ErrEmptyTitle {
 		return err
@@ -199,7 +213,11 @@ func newBugWithEditor(repo *cache.RepoCache) error {
 
 		return errTerminateMainloop
 	} else {
-		b, _, err = repo.NewBug(title, message)
+		if template != nil {
+			b, _, err = repo.NewBugFromTemplate(*template, title, message)
+		} else {
+			b, _, err = repo.NewBug(title, message)
+		}
 		if err != nil {
 			return err
 		}
@@ -339,7 +357,7 @@ func editQueryWithEditor(bt *bugTable) error {
 
 	bt.queryStr = queryStr
 
-	q, err := query.Parse(queryStr)
+	q, err := query.ParseWithSaved(bt.repo, queryStr)
 
 	if err != nil {
 		ui.msgPopup.Activate(msgPopupErrorTitle, err.Error())
@@ -49,6 +49,10 @@ const useStyles = makeStyles((theme) => ({
     ...theme.typography.body2,
     padding: '0 1rem',
   },
+  redacted: {
+    color: '#888',
+    fontStyle: 'italic',
+  },
 }));
 
 type Props = {
@@ -68,9 +72,14 @@ function Message({ op }: Props) {
             <Date date={op.createdAt} />
           </div>
           {op.edited && <div className={classes.tag}>Edited</div>}
+          {op.redacted && <div className={classes.tag}>Redacted</div>}
         </header>
         <section className={classes.body}>
-          <Content markdown={op.message} />
+          {op.redacted ? (
+            <p className={classes.redacted}>This comment has been redacted.</p>
+          ) : (
+            <Content markdown={op.message} />
+          )}
         </section>
       </Paper>
     </article>